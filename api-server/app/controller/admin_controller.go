@@ -0,0 +1,30 @@
+package controller
+
+import (
+	"aitrics-vital-signs/api-server/domain/admin"
+	"aitrics-vital-signs/api-server/internal/output"
+
+	"github.com/gin-gonic/gin"
+)
+
+type adminController struct {
+	service admin.AdminService
+}
+
+// GetConfig
+// @Security Bearer
+// @Title GetConfig
+// @Description 현재 적용된 설정 조회 (기본값 < 설정 파일 < 환경 변수 < flag 적용 결과, secret 은 마스킹, admin scope 필요)
+// @Tags V1 - Admin
+// @Produce json
+// @Success 200 {object} output.Output{data=envs.Config}
+// @Failure 401 {object} output.Output "code: 400004 - Unauthorized"
+// @Failure 403 {object} output.Output "code: 400005 - Forbidden"
+// @Router /v1/admin/config [Get]
+func (a *adminController) GetConfig(ctx *gin.Context) {
+	output.Send(ctx, a.service.GetConfig(ctx))
+}
+
+func NewAdminController(service admin.AdminService) admin.AdminController {
+	return &adminController{service: service}
+}
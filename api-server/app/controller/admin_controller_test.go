@@ -0,0 +1,33 @@
+package controller
+
+import (
+	"aitrics-vital-signs/api-server/domain/mock"
+	"aitrics-vital-signs/library/envs"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_GetConfig(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	mockAdminService := mock.NewMockAdminService(ctrl)
+
+	config := envs.Default()
+	config.DB.Password = "******"
+	mockAdminService.EXPECT().GetConfig(gomock.Any()).Return(config)
+
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = httptest.NewRequest(http.MethodGet, "/api/v1/admin/config", nil)
+
+	NewAdminController(mockAdminService).GetConfig(ctx)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Contains(t, w.Body.String(), `"password":"******"`)
+	require.Contains(t, w.Body.String(), `"risk_time_window_hours":24`)
+}
@@ -0,0 +1,98 @@
+package controller
+
+import (
+	"aitrics-vital-signs/api-server/domain/apikey"
+	"aitrics-vital-signs/api-server/internal/output"
+	pkgError "aitrics-vital-signs/library/error"
+
+	"github.com/gin-gonic/gin"
+)
+
+type apiKeyController struct {
+	service apikey.APIKeyService
+}
+
+// CreateAPIKey
+// @Security Bearer
+// @Title CreateAPIKey
+// @Description API Key 발급 (admin scope 필요, 평문 키는 응답에서 한 번만 노출)
+// @Tags V1 - APIKey
+// @Accept json
+// @Produce json
+// @Param reqBody body apikey.CreateAPIKeyRequest true "API Key 발급 요청"
+// @Success 200 {object} output.Output{data=apikey.CreateAPIKeyResponse}
+// @Failure 400 {object} output.Output "code: 400001 - Wrong parameter"
+// @Failure 401 {object} output.Output "code: 400004 - Unauthorized"
+// @Failure 403 {object} output.Output "code: 400005 - Forbidden"
+// @Failure 500 {object} output.Output "code: 100001 - Fail to create data"
+// @Router /v1/api-keys [Post]
+func (a *apiKeyController) CreateAPIKey(ctx *gin.Context) {
+	var reqBody apikey.CreateAPIKeyRequest
+	if err := ctx.ShouldBindJSON(&reqBody); err != nil {
+		output.AppendErrorContext(ctx, pkgError.WrapWithCode(err, pkgError.WrongParam, err.Error(), "fail to parse request parameter"), nil)
+		return
+	}
+
+	result, err := a.service.CreateAPIKey(ctx, reqBody)
+	if err != nil {
+		output.AppendErrorContext(ctx, pkgError.Wrap(err), nil)
+		return
+	}
+
+	output.Send(ctx, result)
+}
+
+// ListAPIKeys
+// @Security Bearer
+// @Title ListAPIKeys
+// @Description API Key 목록 조회 (admin scope 필요)
+// @Tags V1 - APIKey
+// @Produce json
+// @Success 200 {object} output.Output{data=[]apikey.APIKeyResponse}
+// @Failure 401 {object} output.Output "code: 400004 - Unauthorized"
+// @Failure 403 {object} output.Output "code: 400005 - Forbidden"
+// @Failure 500 {object} output.Output "code: 100005 - Fail to get data"
+// @Router /v1/api-keys [Get]
+func (a *apiKeyController) ListAPIKeys(ctx *gin.Context) {
+	result, err := a.service.ListAPIKeys(ctx)
+	if err != nil {
+		output.AppendErrorContext(ctx, pkgError.Wrap(err), nil)
+		return
+	}
+
+	output.Send(ctx, result)
+}
+
+// RevokeAPIKey
+// @Security Bearer
+// @Title RevokeAPIKey
+// @Description API Key 폐기 (admin scope 필요)
+// @Tags V1 - APIKey
+// @Produce json
+// @Param key_id path string true "API Key ID"
+// @Success 200 {object} output.Output
+// @Failure 401 {object} output.Output "code: 400004 - Unauthorized"
+// @Failure 403 {object} output.Output "code: 400005 - Forbidden"
+// @Failure 404 {object} output.Output "code: 400003 - Not found or already revoked"
+// @Failure 500 {object} output.Output "code: 100002 - Fail to update data"
+// @Router /v1/api-keys/{key_id} [Delete]
+func (a *apiKeyController) RevokeAPIKey(ctx *gin.Context) {
+	keyID := ctx.Param("key_id")
+	if keyID == "" {
+		output.AppendErrorContext(ctx, pkgError.WrapWithCode(pkgError.EmptyBusinessError(), pkgError.WrongParam, "key_id is required"), nil)
+		return
+	}
+
+	if err := a.service.RevokeAPIKey(ctx, keyID); err != nil {
+		output.AppendErrorContext(ctx, pkgError.Wrap(err), nil)
+		return
+	}
+
+	output.Send(ctx, nil)
+}
+
+func NewAPIKeyController(service apikey.APIKeyService) apikey.APIKeyController {
+	return &apiKeyController{
+		service: service,
+	}
+}
@@ -0,0 +1,152 @@
+package controller
+
+import (
+	"aitrics-vital-signs/api-server/domain/apikey"
+	"aitrics-vital-signs/api-server/domain/mock"
+	pkgError "aitrics-vital-signs/library/error"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+var (
+	testAPIKeyController apikey.APIKeyController
+	mockAPIKeyService    *mock.MockAPIKeyService
+)
+
+func beforeEachAPIKey(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockAPIKeyService = mock.NewMockAPIKeyService(ctrl)
+	testAPIKeyController = NewAPIKeyController(mockAPIKeyService)
+}
+
+func Test_CreateAPIKey(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		body           string
+		mockSetup      func(svc *mock.MockAPIKeyService)
+		wantStatusCode int
+	}{
+		{
+			name: "성공",
+			body: `{"name": "bedside-gateway", "scopes": ["vitals:write"]}`,
+			mockSetup: func(svc *mock.MockAPIKeyService) {
+				svc.EXPECT().
+					CreateAPIKey(gomock.Any(), gomock.Any()).
+					Return(&apikey.CreateAPIKeyResponse{Key: "avs_test"}, nil)
+			},
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name:           "실패 - 알 수 없는 scope",
+			body:           `{"name": "bedside-gateway", "scopes": ["root"]}`,
+			mockSetup:      func(svc *mock.MockAPIKeyService) {},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "실패 - scope 누락",
+			body:           `{"name": "bedside-gateway"}`,
+			mockSetup:      func(svc *mock.MockAPIKeyService) {},
+			wantStatusCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			beforeEachAPIKey(t)
+			tt.mockSetup(mockAPIKeyService)
+
+			w := httptest.NewRecorder()
+			ctx, _ := gin.CreateTestContext(w)
+
+			req := httptest.NewRequest(
+				http.MethodPost,
+				"/api/v1/api-keys",
+				strings.NewReader(tt.body),
+			)
+			req.Header.Set("Content-Type", "application/json")
+			ctx.Request = req
+
+			testAPIKeyController.CreateAPIKey(ctx)
+
+			require.Equal(t, tt.wantStatusCode, w.Code)
+		})
+	}
+}
+
+func Test_ListAPIKeys(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	beforeEachAPIKey(t)
+
+	mockAPIKeyService.EXPECT().
+		ListAPIKeys(gomock.Any()).
+		Return([]apikey.APIKeyResponse{{ID: "key-1"}}, nil)
+
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = httptest.NewRequest(http.MethodGet, "/api/v1/api-keys", nil)
+
+	testAPIKeyController.ListAPIKeys(ctx)
+
+	require.Equal(t, http.StatusOK, w.Code)
+}
+
+func Test_RevokeAPIKey(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		keyID          string
+		mockSetup      func(svc *mock.MockAPIKeyService)
+		wantStatusCode int
+	}{
+		{
+			name:  "성공",
+			keyID: "key-1",
+			mockSetup: func(svc *mock.MockAPIKeyService) {
+				svc.EXPECT().RevokeAPIKey(gomock.Any(), "key-1").Return(nil)
+			},
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name:  "실패 - 없는 키",
+			keyID: "key-404",
+			mockSetup: func(svc *mock.MockAPIKeyService) {
+				svc.EXPECT().
+					RevokeAPIKey(gomock.Any(), "key-404").
+					Return(pkgError.WrapWithCode(pkgError.EmptyBusinessError(), pkgError.NotFound))
+			},
+			wantStatusCode: http.StatusNotFound,
+		},
+		{
+			name:           "실패 - key_id 없음",
+			mockSetup:      func(svc *mock.MockAPIKeyService) {},
+			wantStatusCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			beforeEachAPIKey(t)
+			tt.mockSetup(mockAPIKeyService)
+
+			w := httptest.NewRecorder()
+			ctx, _ := gin.CreateTestContext(w)
+			ctx.Request = httptest.NewRequest(http.MethodDelete, "/api/v1/api-keys/"+tt.keyID, nil)
+			if tt.keyID != "" {
+				ctx.Params = gin.Params{{Key: "key_id", Value: tt.keyID}}
+			}
+
+			testAPIKeyController.RevokeAPIKey(ctx)
+
+			require.Equal(t, tt.wantStatusCode, w.Code)
+		})
+	}
+}
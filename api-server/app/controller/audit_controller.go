@@ -0,0 +1,159 @@
+package controller
+
+import (
+	"aitrics-vital-signs/api-server/domain/audit"
+	"aitrics-vital-signs/api-server/internal/output"
+	pkgError "aitrics-vital-signs/library/error"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+var auditEventExportColumns = []output.Column{
+	{Name: "id", Kind: output.ColumnInt64},
+	{Name: "occurred_at", Kind: output.ColumnTime},
+	{Name: "request_id", Kind: output.ColumnString},
+	{Name: "principal_id", Kind: output.ColumnString},
+	{Name: "principal_name", Kind: output.ColumnString},
+	{Name: "principal_type", Kind: output.ColumnString},
+	{Name: "action", Kind: output.ColumnString},
+	{Name: "method", Kind: output.ColumnString},
+	{Name: "route", Kind: output.ColumnString},
+	{Name: "path", Kind: output.ColumnString},
+	{Name: "patient_id", Kind: output.ColumnString},
+	{Name: "resource_key", Kind: output.ColumnString},
+	{Name: "outcome", Kind: output.ColumnString},
+	{Name: "status_code", Kind: output.ColumnInt64},
+	{Name: "error_code", Kind: output.ColumnInt64},
+	{Name: "client_ip", Kind: output.ColumnString},
+}
+
+type auditEventExportRecord struct {
+	ID            int64     `json:"id"`
+	OccurredAt    time.Time `json:"occurred_at"`
+	RequestID     string    `json:"request_id"`
+	PrincipalID   string    `json:"principal_id"`
+	PrincipalName string    `json:"principal_name"`
+	PrincipalType string    `json:"principal_type"`
+	Action        string    `json:"action"`
+	Method        string    `json:"method"`
+	Route         string    `json:"route"`
+	Path          string    `json:"path"`
+	// 특정 환자와 무관한 요청은 빈 문자열
+	PatientID   string `json:"patient_id"`
+	ResourceKey string `json:"resource_key"`
+	Outcome     string `json:"outcome"`
+	StatusCode  int    `json:"status_code"`
+	ErrorCode   int    `json:"error_code"`
+	ClientIP    string `json:"client_ip"`
+}
+
+func (r auditEventExportRecord) Values() []interface{} {
+	return []interface{}{
+		r.ID, r.OccurredAt, r.RequestID, r.PrincipalID, r.PrincipalName, r.PrincipalType, r.Action, r.Method,
+		r.Route, r.Path, r.PatientID, r.ResourceKey, r.Outcome, r.StatusCode, r.ErrorCode, r.ClientIP,
+	}
+}
+
+func newAuditEventExportRecord(e *audit.AuditEvent) auditEventExportRecord {
+	record := auditEventExportRecord{
+		ID:            int64(e.ID),
+		OccurredAt:    e.OccurredAt.UTC(),
+		RequestID:     e.RequestID,
+		PrincipalID:   e.PrincipalID,
+		PrincipalName: e.PrincipalName,
+		PrincipalType: e.PrincipalType,
+		Action:        e.Action,
+		Method:        e.Method,
+		Route:         e.Route,
+		Path:          e.Path,
+		ResourceKey:   e.ResourceKey,
+		Outcome:       e.Outcome,
+		StatusCode:    e.StatusCode,
+		ErrorCode:     e.ErrorCode,
+		ClientIP:      e.ClientIP,
+	}
+	if e.PatientID != nil {
+		record.PatientID = *e.PatientID
+	}
+	return record
+}
+
+type auditController struct {
+	service audit.AuditService
+}
+
+// GetAuditEvents
+// @Security Bearer
+// @Title GetAuditEvents
+// @Description PHI 접근 감사 기록 조회 (최신순, cursor 기반 페이지네이션, admin scope 필요)
+// @Tags V1 - Audit
+// @Produce json
+// @Param patient_id query string false "환자 ID"
+// @Param principal_id query string false "인증 주체 ID"
+// @Param from query string false "조회 시작 시간 (RFC3339 format)"
+// @Param to query string false "조회 종료 시간 (RFC3339 format)"
+// @Param cursor query int false "이전 응답의 next_cursor"
+// @Param limit query int false "페이지 크기 (기본 100, 최대 1000)"
+// @Success 200 {object} output.Output{data=audit.GetAuditEventsResponse}
+// @Failure 400 {object} output.Output "code: 400001 - Wrong parameter"
+// @Failure 403 {object} output.Output "code: 400005 - Forbidden"
+// @Failure 500 {object} output.Output "code: 100005 - Fail to get data from db"
+// @Router /v1/audit-events [Get]
+func (a *auditController) GetAuditEvents(ctx *gin.Context) {
+	var queryParams audit.GetAuditEventsRequest
+	if err := ctx.ShouldBindQuery(&queryParams); err != nil {
+		output.AppendErrorContext(ctx, pkgError.WrapWithCode(err, pkgError.WrongParam, err.Error(), "fail to parse query parameters"), nil)
+		return
+	}
+
+	result, err := a.service.GetAuditEvents(ctx, queryParams)
+	if err != nil {
+		output.AppendErrorContext(ctx, pkgError.Wrap(err), nil)
+		return
+	}
+
+	output.Send(ctx, result)
+}
+
+// ExportAuditEvents
+// @Security Bearer
+// @Title ExportAuditEvents
+// @Description 컴플라이언스 검토용 감사 기록 export (Accept 헤더 또는 format query 로 csv / ndjson / parquet 선택, 기본값 csv, admin scope 필요)
+// @Tags V1 - Audit
+// @Produce text/csv,application/x-ndjson,application/vnd.apache.parquet
+// @Param patient_id query string false "환자 ID"
+// @Param principal_id query string false "인증 주체 ID"
+// @Param from query string true "조회 시작 시간 (RFC3339 format)"
+// @Param to query string true "조회 종료 시간 (RFC3339 format)"
+// @Param format query string false "응답 포맷 (csv, ndjson, parquet)"
+// @Success 200 {file} file
+// @Failure 400 {object} output.Output "code: 400001 - Wrong parameter"
+// @Failure 403 {object} output.Output "code: 400005 - Forbidden"
+// @Failure 500 {object} output.Output "code: 100005 - Fail to get data from db"
+// @Router /v1/audit-events/export [Get]
+func (a *auditController) ExportAuditEvents(ctx *gin.Context) {
+	format, err := output.NegotiateFormat(ctx, output.FormatCSV, output.FormatNDJSON, output.FormatParquet)
+	if err != nil {
+		output.AppendErrorContext(ctx, pkgError.Wrap(err), nil)
+		return
+	}
+
+	var queryParams audit.ExportAuditEventsRequest
+	if err := ctx.ShouldBindQuery(&queryParams); err != nil {
+		output.AppendErrorContext(ctx, pkgError.WrapWithCode(err, pkgError.WrongParam, err.Error(), "fail to parse query parameters"), nil)
+		return
+	}
+
+	output.Stream(ctx, format, "audit-events", auditEventExportColumns, func(write func(output.Record) error) error {
+		return a.service.ExportAuditEvents(ctx, queryParams, func(model *audit.AuditEvent) error {
+			return write(newAuditEventExportRecord(model))
+		})
+	})
+}
+
+func NewAuditController(service audit.AuditService) audit.AuditController {
+	return &auditController{
+		service: service,
+	}
+}
@@ -0,0 +1,85 @@
+package controller
+
+import (
+	"aitrics-vital-signs/api-server/domain/audit"
+	"aitrics-vital-signs/api-server/domain/mock"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+var (
+	testAuditController audit.AuditController
+	mockAuditService    *mock.MockAuditService
+)
+
+func beforeEachAudit(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockAuditService = mock.NewMockAuditService(ctrl)
+	testAuditController = NewAuditController(mockAuditService)
+}
+
+func Test_ExportAuditEvents(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	patientID := "P00001"
+	occurredAt := time.Date(2025, 12, 1, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		query          string
+		mockSetup      func(svc *mock.MockAuditService)
+		wantStatusCode int
+		wantBody       string
+	}{
+		{
+			name:  "성공 - 기본 csv",
+			query: "from=2025-12-01T00:00:00Z&to=2025-12-02T00:00:00Z&patient_id=P00001",
+			mockSetup: func(svc *mock.MockAuditService) {
+				svc.EXPECT().
+					ExportAuditEvents(gomock.Any(), audit.ExportAuditEventsRequest{PatientID: "P00001", From: "2025-12-01T00:00:00Z", To: "2025-12-02T00:00:00Z"}, gomock.Any()).
+					DoAndReturn(func(_ interface{}, _ audit.ExportAuditEventsRequest, fn func(*audit.AuditEvent) error) error {
+						return fn(&audit.AuditEvent{
+							ID: 1, RequestID: "req-1", PrincipalID: "key-1", PrincipalName: "ward-a", PrincipalType: "api_key",
+							Action: "READ", Method: "GET", Route: "/api/v1/patients/:patient_id/vitals", Path: "/api/v1/patients/P00001/vitals",
+							PatientID: &patientID, Outcome: "SUCCESS", StatusCode: 200, ClientIP: "10.0.0.1", OccurredAt: occurredAt,
+						})
+					})
+			},
+			wantStatusCode: http.StatusOK,
+			wantBody: "id,occurred_at,request_id,principal_id,principal_name,principal_type,action,method,route,path,patient_id,resource_key,outcome,status_code,error_code,client_ip\n" +
+				"1,2025-12-01T10:00:00Z,req-1,key-1,ward-a,api_key,READ,GET,/api/v1/patients/:patient_id/vitals,/api/v1/patients/P00001/vitals,P00001,,SUCCESS,200,0,10.0.0.1\n",
+		},
+		{
+			name:           "실패 - 기간 누락",
+			query:          "patient_id=P00001",
+			mockSetup:      func(svc *mock.MockAuditService) {},
+			wantStatusCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			beforeEachAudit(t)
+			tt.mockSetup(mockAuditService)
+
+			w := httptest.NewRecorder()
+			ctx, _ := gin.CreateTestContext(w)
+			ctx.Request = httptest.NewRequest(http.MethodGet, "/api/v1/audit-events/export?"+tt.query, nil)
+
+			testAuditController.ExportAuditEvents(ctx)
+
+			require.Equal(t, tt.wantStatusCode, w.Code)
+			if tt.wantBody != "" {
+				require.True(t, strings.HasPrefix(w.Header().Get("Content-Type"), "text/csv"))
+				require.Equal(t, tt.wantBody, w.Body.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package controller
+
+import (
+	"aitrics-vital-signs/api-server/domain/encounter"
+	"aitrics-vital-signs/api-server/internal/output"
+	pkgError "aitrics-vital-signs/library/error"
+
+	"github.com/gin-gonic/gin"
+)
+
+type encounterController struct {
+	service encounter.EncounterService
+}
+
+// GetPatientEncounters
+// @Security Bearer
+// @Title GetPatientEncounters
+// @Description 환자 encounter(내원) 이력 조회 (최신순)
+// @Tags V1 - Encounter
+// @Produce json
+// @Param patient_id path string true "환자 ID"
+// @Success 200 {object} output.Output{data=[]encounter.EncounterResponse}
+// @Failure 404 {object} output.Output "code: 400003 - Patient not found"
+// @Failure 500 {object} output.Output "code: 100005 - Fail to get data"
+// @Router /v1/patients/{patient_id}/encounters [Get]
+func (e *encounterController) GetPatientEncounters(ctx *gin.Context) {
+	patientID := ctx.Param("patient_id")
+	if patientID == "" {
+		output.AppendErrorContext(ctx, pkgError.WrapWithCode(pkgError.EmptyBusinessError(), pkgError.WrongParam, "patient_id is required"), nil)
+		return
+	}
+
+	result, err := e.service.GetPatientEncounters(ctx, patientID)
+	if err != nil {
+		output.AppendErrorContext(ctx, pkgError.Wrap(err), nil)
+		return
+	}
+
+	output.Send(ctx, result)
+}
+
+func NewEncounterController(service encounter.EncounterService) encounter.EncounterController {
+	return &encounterController{
+		service: service,
+	}
+}
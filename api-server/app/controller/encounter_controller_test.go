@@ -0,0 +1,70 @@
+package controller
+
+import (
+	"aitrics-vital-signs/api-server/domain/encounter"
+	"aitrics-vital-signs/api-server/domain/mock"
+	pkgError "aitrics-vital-signs/library/error"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+var (
+	testEncounterController encounter.EncounterController
+	mockEncounterService    *mock.MockEncounterService
+)
+
+func beforeEachEncounter(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockEncounterService = mock.NewMockEncounterService(ctrl)
+	testEncounterController = NewEncounterController(mockEncounterService)
+}
+
+func Test_GetPatientEncounters(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		mockSetup      func(svc *mock.MockEncounterService)
+		wantStatusCode int
+	}{
+		{
+			name: "성공",
+			mockSetup: func(svc *mock.MockEncounterService) {
+				svc.EXPECT().
+					GetPatientEncounters(gomock.Any(), "P00001").
+					Return([]encounter.EncounterResponse{{ID: "enc-1"}}, nil)
+			},
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name: "실패 - 존재하지 않는 환자",
+			mockSetup: func(svc *mock.MockEncounterService) {
+				svc.EXPECT().
+					GetPatientEncounters(gomock.Any(), "P00001").
+					Return(nil, pkgError.WrapWithCode(pkgError.EmptyBusinessError(), pkgError.NotFound))
+			},
+			wantStatusCode: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			beforeEachEncounter(t)
+			tt.mockSetup(mockEncounterService)
+
+			w := httptest.NewRecorder()
+			ctx, _ := gin.CreateTestContext(w)
+			ctx.Request = httptest.NewRequest(http.MethodGet, "/api/v1/patients/P00001/encounters", nil)
+			ctx.Params = gin.Params{{Key: "patient_id", Value: "P00001"}}
+
+			testEncounterController.GetPatientEncounters(ctx)
+
+			require.Equal(t, tt.wantStatusCode, w.Code)
+		})
+	}
+}
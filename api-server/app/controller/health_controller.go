@@ -0,0 +1,46 @@
+package controller
+
+import (
+	"aitrics-vital-signs/api-server/domain/health"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// healthController
+// probe(Kubernetes, 로드밸런서) 는 응답 코드로 판단하므로 output.Output 으로 감싸지 않고 상태를 그대로 응답합니다.
+type healthController struct {
+	service health.HealthService
+}
+
+// Healthz
+// @Title Healthz
+// @Description Liveness probe (프로세스 동작 여부, 의존성 확인 없음)
+// @Tags Health
+// @Produce json
+// @Success 200 {object} health.LivenessResponse
+// @Router /healthz [Get]
+func (h *healthController) Healthz(ctx *gin.Context) {
+	ctx.JSON(http.StatusOK, h.service.Liveness(ctx))
+}
+
+// Readyz
+// @Title Readyz
+// @Description Readiness probe (DB 연결, migration 적용 상태, background worker 확인. graceful shutdown 중에는 503)
+// @Tags Health
+// @Produce json
+// @Success 200 {object} health.ReadinessResponse
+// @Failure 503 {object} health.ReadinessResponse
+// @Router /readyz [Get]
+func (h *healthController) Readyz(ctx *gin.Context) {
+	response := h.service.Readiness(ctx)
+	if !response.Ready() {
+		ctx.JSON(http.StatusServiceUnavailable, response)
+		return
+	}
+	ctx.JSON(http.StatusOK, response)
+}
+
+func NewHealthController(service health.HealthService) health.HealthController {
+	return &healthController{service: service}
+}
@@ -0,0 +1,66 @@
+package controller
+
+import (
+	"aitrics-vital-signs/api-server/domain/health"
+	"aitrics-vital-signs/api-server/domain/mock"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_Readyz(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		response       health.ReadinessResponse
+		wantStatusCode int
+	}{
+		{
+			name:           "성공 - ready",
+			response:       health.ReadinessResponse{Status: health.StatusReady},
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name:           "실패 - not ready",
+			response:       health.ReadinessResponse{Status: health.StatusNotReady, ShuttingDown: true},
+			wantStatusCode: http.StatusServiceUnavailable,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockHealthService := mock.NewMockHealthService(ctrl)
+			mockHealthService.EXPECT().Readiness(gomock.Any()).Return(tt.response)
+
+			w := httptest.NewRecorder()
+			ctx, _ := gin.CreateTestContext(w)
+			ctx.Request = httptest.NewRequest(http.MethodGet, "/readyz", nil)
+
+			NewHealthController(mockHealthService).Readyz(ctx)
+
+			require.Equal(t, tt.wantStatusCode, w.Code)
+			require.Contains(t, w.Body.String(), `"status":"`+tt.response.Status+`"`)
+		})
+	}
+}
+
+func Test_Healthz(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	mockHealthService := mock.NewMockHealthService(ctrl)
+	mockHealthService.EXPECT().Liveness(gomock.Any()).Return(health.LivenessResponse{Status: health.StatusAlive})
+
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = httptest.NewRequest(http.MethodGet, "/healthz", nil)
+
+	NewHealthController(mockHealthService).Healthz(ctx)
+
+	require.Equal(t, http.StatusOK, w.Code)
+}
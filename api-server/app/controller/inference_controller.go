@@ -1,9 +1,11 @@
 package controller
 
 import (
+	"aitrics-vital-signs/api-server/domain/audit"
 	"aitrics-vital-signs/api-server/domain/inference"
 	"aitrics-vital-signs/api-server/internal/output"
 	pkgError "aitrics-vital-signs/library/error"
+	pkgLogger "aitrics-vital-signs/library/logger"
 
 	"github.com/gin-gonic/gin"
 )
@@ -15,7 +17,7 @@ type inferenceController struct {
 // CalculateVitalRisk
 // @Security Bearer
 // @Title CalculateVitalRisk
-// @Description Vital 데이터 기반 위험 스코어 계산
+// @Description Vital 데이터 기반 위험 스코어 계산 (encounter_id 생략 시 진행 중인 encounter 의 vital 만 사용)
 // @Tags V1 - Inference
 // @Accept json
 // @Produce json
@@ -30,6 +32,8 @@ func (i *inferenceController) CalculateVitalRisk(ctx *gin.Context) {
 		output.AppendErrorContext(ctx, pkgError.WrapWithCode(err, pkgError.WrongParam, err.Error(), "fail to parse request parameter"), nil)
 		return
 	}
+	audit.AddResources(ctx, audit.Resource{PatientID: reqBody.PatientID})
+	pkgLogger.SetPatientID(ctx.Request.Context(), reqBody.PatientID)
 
 	result, err := i.service.CalculateVitalRisk(ctx, reqBody)
 	if err != nil {
@@ -1,15 +1,23 @@
 package controller
 
 import (
+	"aitrics-vital-signs/api-server/domain/audit"
 	"aitrics-vital-signs/api-server/domain/patient"
+	"aitrics-vital-signs/api-server/domain/vital"
 	"aitrics-vital-signs/api-server/internal/output"
 	pkgError "aitrics-vital-signs/library/error"
+	pkgLogger "aitrics-vital-signs/library/logger"
+	"fmt"
+	"sort"
+	"time"
 
 	"github.com/gin-gonic/gin"
 )
 
 type patientController struct {
 	service patient.PatientService
+	// replaceOnPut version 없는 PUT 을 등록 / 교체로 처리 (EHR 동기화용, patient.replace_on_put)
+	replaceOnPut bool
 }
 
 // CreatePatient
@@ -22,6 +30,7 @@ type patientController struct {
 // @Param reqBody body patient.CreatePatientRequest true "execute hook request"
 // @Success 200 {object} output.Output
 // @Failure 400 {object} output.Output "code: 400001 - Wrong parameter"
+// @Failure 409 {object} output.Output "code: 400002 - Patient already exists (data: key, existing_id)"
 // @Failure 500 {object} output.Output "code: 100001 - Fail to create data from db"
 // @Router /v1/patients [Post]
 func (p *patientController) CreatePatient(ctx *gin.Context) {
@@ -30,6 +39,8 @@ func (p *patientController) CreatePatient(ctx *gin.Context) {
 		output.AppendErrorContext(ctx, pkgError.WrapWithCode(err, pkgError.WrongParam, err.Error(), "fail to parse request parameter"), nil)
 		return
 	}
+	audit.AddResources(ctx, audit.Resource{PatientID: reqBody.PatientID})
+	pkgLogger.SetPatientID(ctx.Request.Context(), reqBody.PatientID)
 
 	if err := p.service.CreatePatient(ctx, reqBody); err != nil {
 		output.AppendErrorContext(ctx, pkgError.Wrap(err), nil)
@@ -39,19 +50,55 @@ func (p *patientController) CreatePatient(ctx *gin.Context) {
 	output.Send(ctx, nil)
 }
 
+// GetPatient
+// @Security Bearer
+// @Title GetPatient
+// @Description 환자 정보 조회 (ETag 는 version, If-None-Match 가 일치하면 304)
+// @Tags V1 - Patient
+// @Produce json
+// @Param patient_id path string true "환자 ID"
+// @Param If-None-Match header string false "이전 응답의 ETag"
+// @Success 200 {object} output.Output{data=patient.PatientResponse}
+// @Success 304 "Not Modified"
+// @Failure 404 {object} output.Output "code: 400003 - Not found"
+// @Failure 500 {object} output.Output "code: 100005 - Fail to get data from db"
+// @Router /v1/patients/{patient_id} [Get]
+func (p *patientController) GetPatient(ctx *gin.Context) {
+	patientID := ctx.Param("patient_id")
+	if patientID == "" {
+		output.AppendErrorContext(ctx, pkgError.WrapWithCode(pkgError.EmptyBusinessError(), pkgError.WrongParam, "patient_id is required"), nil)
+		return
+	}
+
+	result, err := p.service.GetPatient(ctx, patientID)
+	if err != nil {
+		output.AppendErrorContext(ctx, pkgError.Wrap(err), nil)
+		return
+	}
+
+	if output.NotModified(ctx, output.VersionETag(result.Version)) {
+		return
+	}
+
+	output.Send(ctx, result)
+}
+
 // UpdatePatient
 // @Security Bearer
 // @Title UpdatePatient
-// @Description 환자 정보 수정
+// @Description 환자 정보 수정 (patient.replace_on_put 설정 시 version / If-Match 가 없으면 등록 또는 교체)
 // @Tags V1 - Patient
 // @Accept json
 // @Produce json
 // @Param patient_id path string true "환자 ID"
+// @Param If-Match header string false "GetPatient 응답의 ETag (body version 대신 사용)"
 // @Param reqBody body patient.UpdatePatientRequest true "환자 정보 수정 요청"
-// @Success 200 {object} output.Output
+// @Success 200 {object} output.Output{data=patient.ReplacePatientResponse} "version 없이 등록 / 교체한 경우에만 data 포함"
 // @Failure 400 {object} output.Output "code: 400001 - Wrong parameter"
-// @Failure 409 {object} output.Output "code: 400002 - Version conflict"
+// @Failure 409 {object} output.Output "code: 400002 - Version conflict (body version)"
+// @Failure 412 {object} output.Output "code: 400009 - Version conflict (If-Match)"
 // @Failure 500 {object} output.Output "code: 100002 - Fail to update data from db"
+// @Failure 500 {object} output.Output "code: 100006 - Fail to process transaction"
 // @Router /v1/patients/{patient_id} [Put]
 func (p *patientController) UpdatePatient(ctx *gin.Context) {
 	patientID := ctx.Param("patient_id")
@@ -66,10 +113,27 @@ func (p *patientController) UpdatePatient(ctx *gin.Context) {
 		return
 	}
 
-	if err := p.service.UpdatePatient(ctx, patientID, reqBody); err != nil {
+	if p.replaceOnPut && reqBody.Version == 0 && !output.HasIfMatch(ctx) {
+		result, err := p.service.ReplacePatient(ctx, patientID, reqBody)
+		if err != nil {
+			output.AppendErrorContext(ctx, pkgError.Wrap(err), nil)
+			return
+		}
+		output.Send(ctx, result)
+		return
+	}
+
+	version, fromHeader, err := output.ResolveVersion(ctx, reqBody.Version)
+	if err != nil {
 		output.AppendErrorContext(ctx, pkgError.Wrap(err), nil)
 		return
 	}
+	reqBody.Version = version
+
+	if err := p.service.UpdatePatient(ctx, patientID, reqBody); err != nil {
+		output.AppendErrorContext(ctx, output.PreconditionError(pkgError.Wrap(err), fromHeader), nil)
+		return
+	}
 
 	output.Send(ctx, nil)
 }
@@ -77,15 +141,19 @@ func (p *patientController) UpdatePatient(ctx *gin.Context) {
 // GetPatientVitals
 // @Security Bearer
 // @Title GetPatientVitals
-// @Description 환자 Vital 데이터 조회
+// @Description 환자 Vital 데이터 조회 (Accept 헤더 또는 format query 로 csv / ndjson / parquet 스트리밍 응답 가능)
 // @Tags V1 - Patient
 // @Accept json
-// @Produce json
+// @Produce json,text/csv,application/x-ndjson,application/vnd.apache.parquet
 // @Param patient_id path string true "환자 ID"
-// @Param from query string true "조회 시작 시간 (RFC3339 format)"
-// @Param to query string true "조회 종료 시간 (RFC3339 format)"
+// @Param from query string false "조회 시작 시간 (RFC3339 format, encounter_id 가 없으면 필수)"
+// @Param to query string false "조회 종료 시간 (RFC3339 format, encounter_id 가 없으면 필수)"
 // @Param vital_types query []string false "Vital 타입 (HR, RR, SBP, DBP, SpO2, BT)"
+// @Param encounter_id query string false "encounter ID (생략된 from / to 는 encounter 기간으로 대체)"
+// @Param format query string false "응답 포맷 (json, csv, ndjson, parquet)"
+// @Param If-None-Match header string false "이전 json 응답의 ETag"
 // @Success 200 {object} output.Output{data=patient.GetPatientVitalsResponse}
+// @Success 304 "Not Modified"
 // @Failure 400 {object} output.Output "code: 400001 - Wrong parameter"
 // @Failure 500 {object} output.Output "code: 100003 - Fail to get data from db"
 // @Router /v1/patients/{patient_id}/vitals [Get]
@@ -96,24 +164,205 @@ func (p *patientController) GetPatientVitals(ctx *gin.Context) {
 		return
 	}
 
+	format, err := output.NegotiateFormat(ctx, output.FormatJSON, output.FormatCSV, output.FormatNDJSON, output.FormatParquet)
+	if err != nil {
+		output.AppendErrorContext(ctx, pkgError.Wrap(err), nil)
+		return
+	}
+
 	var queryParams patient.GetPatientVitalsRequest
 	if err := ctx.ShouldBindQuery(&queryParams); err != nil {
 		output.AppendErrorContext(ctx, pkgError.WrapWithCode(err, pkgError.WrongParam, err.Error(), "fail to parse query parameters"), nil)
 		return
 	}
 
+	// 표 형식 포맷은 DB cursor 에서 바로 스트리밍
+	if format != output.FormatJSON {
+		output.Stream(ctx, format, patientID+"-vitals", VitalExportColumns, func(write func(output.Record) error) error {
+			return p.service.StreamPatientVitals(ctx, patientID, queryParams, func(model *vital.Vital) error {
+				return write(NewVitalExportRecord(model))
+			})
+		})
+		return
+	}
+
 	result, err := p.service.GetPatientVitals(ctx, patientID, queryParams)
 	if err != nil {
 		output.AppendErrorContext(ctx, pkgError.Wrap(err), nil)
 		return
 	}
 
+	if output.NotModified(ctx, patientVitalsETag(result)) {
+		return
+	}
+
+	output.Send(ctx, result)
+}
+
+// MergePatients
+// @Security Bearer
+// @Title MergePatients
+// @Description 중복 등록된 source 환자의 vital 을 target 환자로 병합하고 source 를 삭제 (admin, 되돌리기 가능)
+// @Tags V1 - Patient
+// @Accept json
+// @Produce json
+// @Param patient_id path string true "target 환자 ID"
+// @Param reqBody body patient.MergePatientsRequest true "병합 요청"
+// @Success 200 {object} output.Output{data=patient.PatientMergeResponse}
+// @Failure 400 {object} output.Output "code: 400001 - Wrong parameter"
+// @Failure 404 {object} output.Output "code: 400003 - Not found"
+// @Failure 409 {object} output.Output "code: 400002 - Vital collision (reject) / active encounter"
+// @Failure 500 {object} output.Output "code: 100002 - Fail to update data from db"
+// @Router /v1/patients/{patient_id}/merges [Post]
+func (p *patientController) MergePatients(ctx *gin.Context) {
+	patientID := ctx.Param("patient_id")
+	if patientID == "" {
+		output.AppendErrorContext(ctx, pkgError.WrapWithCode(pkgError.EmptyBusinessError(), pkgError.WrongParam, "patient_id is required"), nil)
+		return
+	}
+
+	var reqBody patient.MergePatientsRequest
+	if err := ctx.ShouldBindJSON(&reqBody); err != nil {
+		output.AppendErrorContext(ctx, pkgError.WrapWithCode(err, pkgError.WrongParam, err.Error(), "fail to parse request parameter"), nil)
+		return
+	}
+	audit.AddResources(ctx, audit.Resource{PatientID: reqBody.SourcePatientID})
+
+	result, err := p.service.MergePatients(ctx, patientID, reqBody)
+	if err != nil {
+		output.AppendErrorContext(ctx, pkgError.Wrap(err), nil)
+		return
+	}
+
 	output.Send(ctx, result)
 }
 
-func NewPatientController(service patient.PatientService) patient.PatientController {
+// RevertPatientMerge
+// @Security Bearer
+// @Title RevertPatientMerge
+// @Description 환자 병합 되돌리기 (admin, 같은 환자의 이후 병합부터 되돌려야 함)
+// @Tags V1 - Patient
+// @Produce json
+// @Param patient_id path string true "target 환자 ID"
+// @Param merge_id path string true "병합 ID"
+// @Success 200 {object} output.Output{data=patient.PatientMergeResponse}
+// @Failure 404 {object} output.Output "code: 400003 - Not found"
+// @Failure 409 {object} output.Output "code: 400002 - Already reverted / later merge exists"
+// @Failure 500 {object} output.Output "code: 100002 - Fail to update data from db"
+// @Router /v1/patients/{patient_id}/merges/{merge_id}/revert [Post]
+func (p *patientController) RevertPatientMerge(ctx *gin.Context) {
+	patientID := ctx.Param("patient_id")
+	mergeID := ctx.Param("merge_id")
+	if patientID == "" || mergeID == "" {
+		output.AppendErrorContext(ctx, pkgError.WrapWithCode(pkgError.EmptyBusinessError(), pkgError.WrongParam, "patient_id and merge_id are required"), nil)
+		return
+	}
+
+	result, err := p.service.RevertPatientMerge(ctx, patientID, mergeID)
+	if err != nil {
+		output.AppendErrorContext(ctx, pkgError.Wrap(err), nil)
+		return
+	}
+	audit.AddResources(ctx, audit.Resource{PatientID: result.SourcePatientID})
+
+	output.Send(ctx, result)
+}
+
+// GetPatientMerges
+// @Security Bearer
+// @Title GetPatientMerges
+// @Description 환자가 source 또는 target 인 병합 기록 조회 (최근 순)
+// @Tags V1 - Patient
+// @Produce json
+// @Param patient_id path string true "환자 ID"
+// @Success 200 {object} output.Output{data=patient.GetPatientMergesResponse}
+// @Failure 500 {object} output.Output "code: 100005 - Fail to get data from db"
+// @Router /v1/patients/{patient_id}/merges [Get]
+func (p *patientController) GetPatientMerges(ctx *gin.Context) {
+	patientID := ctx.Param("patient_id")
+	if patientID == "" {
+		output.AppendErrorContext(ctx, pkgError.WrapWithCode(pkgError.EmptyBusinessError(), pkgError.WrongParam, "patient_id is required"), nil)
+		return
+	}
+
+	result, err := p.service.GetPatientMerges(ctx, patientID)
+	if err != nil {
+		output.AppendErrorContext(ctx, pkgError.Wrap(err), nil)
+		return
+	}
+
+	output.Send(ctx, result)
+}
+
+// ChangePatientID
+// @Security Bearer
+// @Title ChangePatientID
+// @Description 잘못 등록된 외부 환자 ID 변경 (admin, vital / encounter / 병상 배정을 한 트랜잭션으로 변경)
+// @Tags V1 - Patient
+// @Accept json
+// @Produce json
+// @Param patient_id path string true "현재 환자 ID"
+// @Param If-Match header string false "GetPatient 응답의 ETag (body version 대신 사용)"
+// @Param reqBody body patient.ChangePatientIDRequest true "환자 ID 변경 요청"
+// @Success 200 {object} output.Output{data=patient.PatientResponse}
+// @Failure 400 {object} output.Output "code: 400001 - Wrong parameter"
+// @Failure 404 {object} output.Output "code: 400003 - Not found"
+// @Failure 409 {object} output.Output "code: 400002 - Version conflict / new patient_id already exists"
+// @Failure 412 {object} output.Output "code: 400009 - Version conflict (If-Match)"
+// @Failure 500 {object} output.Output "code: 100002 - Fail to update data from db"
+// @Failure 500 {object} output.Output "code: 100006 - Fail to process transaction"
+// @Router /v1/patients/{patient_id}/patient-id [Put]
+func (p *patientController) ChangePatientID(ctx *gin.Context) {
+	patientID := ctx.Param("patient_id")
+	if patientID == "" {
+		output.AppendErrorContext(ctx, pkgError.WrapWithCode(pkgError.EmptyBusinessError(), pkgError.WrongParam, "patient_id is required"), nil)
+		return
+	}
+
+	var reqBody patient.ChangePatientIDRequest
+	if err := ctx.ShouldBindJSON(&reqBody); err != nil {
+		output.AppendErrorContext(ctx, pkgError.WrapWithCode(err, pkgError.WrongParam, err.Error(), "fail to parse request parameter"), nil)
+		return
+	}
+	audit.AddResources(ctx, audit.Resource{PatientID: reqBody.NewPatientID})
+
+	version, fromHeader, err := output.ResolveVersion(ctx, reqBody.Version)
+	if err != nil {
+		output.AppendErrorContext(ctx, pkgError.Wrap(err), nil)
+		return
+	}
+	reqBody.Version = version
+
+	result, err := p.service.ChangePatientID(ctx, patientID, reqBody)
+	if err != nil {
+		output.AppendErrorContext(ctx, output.PreconditionError(pkgError.Wrap(err), fromHeader), nil)
+		return
+	}
+
+	output.Send(ctx, result)
+}
+
+// patientVitalsETag 조회된 vital 의 key 와 version 으로 목록 ETag 를 계산합니다.
+func patientVitalsETag(result *patient.GetPatientVitalsResponse) string {
+	vitalTypes := make([]string, 0, len(result.Items))
+	for vitalType := range result.Items {
+		vitalTypes = append(vitalTypes, vitalType)
+	}
+	sort.Strings(vitalTypes)
+
+	parts := []string{result.PatientID}
+	for _, vitalType := range vitalTypes {
+		for _, item := range result.Items[vitalType] {
+			parts = append(parts, fmt.Sprintf("%s|%s|%d", vitalType, item.RecordedAt.UTC().Format(time.RFC3339Nano), item.Version))
+		}
+	}
+	return output.CollectionETag(parts...)
+}
+
+func NewPatientController(service patient.PatientService, replaceOnPut bool) patient.PatientController {
 	p := &patientController{
-		service: service,
+		service:      service,
+		replaceOnPut: replaceOnPut,
 	}
 
 	return p
@@ -1,9 +1,12 @@
 package controller
 
 import (
+	"aitrics-vital-signs/api-server/domain"
 	"aitrics-vital-signs/api-server/domain/mock"
 	"aitrics-vital-signs/api-server/domain/patient"
+	"aitrics-vital-signs/api-server/domain/vital"
 	pkgError "aitrics-vital-signs/library/error"
+	"context"
 	"net/http"
 	"net/http/httptest"
 	"strings"
@@ -23,7 +26,7 @@ var (
 func beforeEach(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	mockService = mock.NewMockPatientService(ctrl)
-	controller = NewPatientController(mockService)
+	controller = NewPatientController(mockService, false)
 }
 
 func Test_CreatePatient(t *testing.T) {
@@ -35,6 +38,7 @@ func Test_CreatePatient(t *testing.T) {
 		body           string
 		mockSetup      func(svc *mock.MockPatientService)
 		wantStatusCode int
+		wantBody       string
 	}{
 		{
 			name: "성공",
@@ -59,6 +63,25 @@ func Test_CreatePatient(t *testing.T) {
 			mockSetup:      func(svc *mock.MockPatientService) {},
 			wantStatusCode: http.StatusBadRequest,
 		},
+		{
+			name: "실패 - 이미 등록된 환자 (409)",
+			body: `{
+				"patientId": "test",
+				"name": "test",
+				"gender": "M",
+				"birthDate": "1990-01-01"
+			}`,
+			mockSetup: func(svc *mock.MockPatientService) {
+				svc.EXPECT().
+					CreatePatient(gomock.Any(), gomock.Any()).
+					Return(pkgError.WithData(
+						pkgError.WrapWithCode(pkgError.EmptyBusinessError(), pkgError.Conflict, "duplicate key"),
+						domain.DuplicateResource{Key: "idx_patients_patient_id", ExistingID: "test"},
+					))
+			},
+			wantStatusCode: http.StatusConflict,
+			wantBody:       `"data":{"key":"idx_patients_patient_id","existing_id":"test"}`,
+		},
 		{
 			name: "실패 - 비즈니스 로직 에러 (500)",
 			body: `{
@@ -94,6 +117,92 @@ func Test_CreatePatient(t *testing.T) {
 			controller.CreatePatient(ctx)
 
 			require.Equal(t, tt.wantStatusCode, w.Code)
+			if tt.wantBody != "" {
+				require.Contains(t, w.Body.String(), tt.wantBody)
+			}
+		})
+	}
+}
+
+func Test_GetPatient(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		patientID      string
+		ifNoneMatch    string
+		mockSetup      func(svc *mock.MockPatientService)
+		wantStatusCode int
+		wantETag       string
+	}{
+		{
+			name:      "성공 - version 으로 ETag 응답",
+			patientID: "P00001234",
+			mockSetup: func(svc *mock.MockPatientService) {
+				svc.EXPECT().
+					GetPatient(gomock.Any(), "P00001234").
+					Return(&patient.PatientResponse{PatientID: "P00001234", Version: 3}, nil)
+			},
+			wantStatusCode: http.StatusOK,
+			wantETag:       `"3"`,
+		},
+		{
+			name:        "성공 - If-None-Match 가 일치하면 304",
+			patientID:   "P00001234",
+			ifNoneMatch: `"1", "3"`,
+			mockSetup: func(svc *mock.MockPatientService) {
+				svc.EXPECT().
+					GetPatient(gomock.Any(), "P00001234").
+					Return(&patient.PatientResponse{PatientID: "P00001234", Version: 3}, nil)
+			},
+			wantStatusCode: http.StatusNotModified,
+			wantETag:       `"3"`,
+		},
+		{
+			name:        "성공 - If-None-Match 가 다르면 200",
+			patientID:   "P00001234",
+			ifNoneMatch: `"2"`,
+			mockSetup: func(svc *mock.MockPatientService) {
+				svc.EXPECT().
+					GetPatient(gomock.Any(), "P00001234").
+					Return(&patient.PatientResponse{PatientID: "P00001234", Version: 3}, nil)
+			},
+			wantStatusCode: http.StatusOK,
+			wantETag:       `"3"`,
+		},
+		{
+			name:      "실패 - 환자 없음",
+			patientID: "P00001234",
+			mockSetup: func(svc *mock.MockPatientService) {
+				svc.EXPECT().
+					GetPatient(gomock.Any(), "P00001234").
+					Return(nil, pkgError.WrapWithCode(pkgError.EmptyBusinessError(), pkgError.NotFound))
+			},
+			wantStatusCode: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			beforeEach(t)
+			tt.mockSetup(mockService)
+
+			w := httptest.NewRecorder()
+			ctx, _ := gin.CreateTestContext(w)
+
+			req := httptest.NewRequest(http.MethodGet, "/v1/patients/"+tt.patientID, nil)
+			if tt.ifNoneMatch != "" {
+				req.Header.Set("If-None-Match", tt.ifNoneMatch)
+			}
+			ctx.Request = req
+			ctx.Params = gin.Params{
+				{Key: "patient_id", Value: tt.patientID},
+			}
+
+			controller.GetPatient(ctx)
+
+			require.Equal(t, tt.wantStatusCode, ctx.Writer.Status())
+			require.Equal(t, tt.wantETag, w.Header().Get("ETag"))
 		})
 	}
 }
@@ -103,6 +212,8 @@ func Test_UpdatePatient(t *testing.T) {
 	tests := []struct {
 		name           string
 		patientID      string
+		ifMatch        string
+		replaceOnPut   bool
 		body           string
 		mockSetup      func(svc *mock.MockPatientService)
 		wantStatusCode int
@@ -150,6 +261,54 @@ func Test_UpdatePatient(t *testing.T) {
 			},
 			wantStatusCode: http.StatusConflict,
 		},
+		{
+			name:      "성공 - If-Match 로 version 전달",
+			patientID: "P00001234",
+			ifMatch:   `"3"`,
+			body: `{
+				"name": "홍길동수정",
+				"gender": "F",
+				"birthDate": "1975-03-01"
+			}`,
+			mockSetup: func(svc *mock.MockPatientService) {
+				svc.EXPECT().
+					UpdatePatient(gomock.Any(), "P00001234", gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ string, req patient.UpdatePatientRequest) error {
+						require.Equal(t, 3, req.Version)
+						return nil
+					})
+			},
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name:      "실패 - If-Match version 불일치는 412",
+			patientID: "P00001234",
+			ifMatch:   `"2"`,
+			body: `{
+				"name": "홍길동수정",
+				"gender": "F",
+				"birthDate": "1975-03-01"
+			}`,
+			mockSetup: func(svc *mock.MockPatientService) {
+				svc.EXPECT().
+					UpdatePatient(gomock.Any(), "P00001234", gomock.Any()).
+					Return(pkgError.WrapWithCode(pkgError.EmptyBusinessError(), pkgError.Conflict, "version mismatch"))
+			},
+			wantStatusCode: http.StatusPreconditionFailed,
+		},
+		{
+			name:      "실패 - If-Match 와 body version 이 다름",
+			patientID: "P00001234",
+			ifMatch:   `"2"`,
+			body: `{
+				"name": "홍길동수정",
+				"gender": "F",
+				"birthDate": "1975-03-01",
+				"version": 1
+			}`,
+			mockSetup:      func(svc *mock.MockPatientService) {},
+			wantStatusCode: http.StatusBadRequest,
+		},
 		{
 			name:      "실패 - 잘못된 Gender 값",
 			patientID: "P00001234",
@@ -202,11 +361,48 @@ func Test_UpdatePatient(t *testing.T) {
 			},
 			wantStatusCode: http.StatusInternalServerError,
 		},
+		{
+			name:         "성공 - replace_on_put 이면 version 없이 등록 / 교체",
+			patientID:    "P00001234",
+			replaceOnPut: true,
+			body: `{
+				"name": "홍길동",
+				"gender": "M",
+				"birthDate": "1975-03-01"
+			}`,
+			mockSetup: func(svc *mock.MockPatientService) {
+				svc.EXPECT().
+					ReplacePatient(gomock.Any(), "P00001234", gomock.Any()).
+					Return(&patient.ReplacePatientResponse{
+						PatientResponse: patient.PatientResponse{PatientID: "P00001234", Version: 1},
+						Created:         true,
+					}, nil)
+			},
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name:         "성공 - replace_on_put 이어도 version 이 있으면 Optimistic Lock 수정",
+			patientID:    "P00001234",
+			replaceOnPut: true,
+			body: `{
+				"name": "홍길동수정",
+				"gender": "F",
+				"birthDate": "1975-03-01",
+				"version": 1
+			}`,
+			mockSetup: func(svc *mock.MockPatientService) {
+				svc.EXPECT().
+					UpdatePatient(gomock.Any(), "P00001234", gomock.Any()).
+					Return(nil)
+			},
+			wantStatusCode: http.StatusOK,
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			beforeEach(t)
+			controller = NewPatientController(mockService, tt.replaceOnPut)
 			tt.mockSetup(mockService)
 
 			w := httptest.NewRecorder()
@@ -218,6 +414,9 @@ func Test_UpdatePatient(t *testing.T) {
 				strings.NewReader(tt.body),
 			)
 			req.Header.Set("Content-Type", "application/json")
+			if tt.ifMatch != "" {
+				req.Header.Set("If-Match", tt.ifMatch)
+			}
 			ctx.Request = req
 			ctx.Params = gin.Params{
 				{Key: "patient_id", Value: tt.patientID},
@@ -230,6 +429,127 @@ func Test_UpdatePatient(t *testing.T) {
 	}
 }
 
+func Test_MergePatients(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	tests := []struct {
+		name           string
+		body           string
+		mockSetup      func(svc *mock.MockPatientService)
+		wantStatusCode int
+	}{
+		{
+			name: "성공 - 환자 병합",
+			body: `{"sourcePatientId": "P00000002", "collisionPolicy": "keep_target"}`,
+			mockSetup: func(svc *mock.MockPatientService) {
+				svc.EXPECT().
+					MergePatients(gomock.Any(), "P00000001", gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ string, req patient.MergePatientsRequest) (*patient.PatientMergeResponse, error) {
+						require.Equal(t, "P00000002", req.SourcePatientID)
+						require.Equal(t, "keep_target", req.CollisionPolicy)
+						return &patient.PatientMergeResponse{MergeID: "merge-1"}, nil
+					})
+			},
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name:           "실패 - 알 수 없는 충돌 정책",
+			body:           `{"sourcePatientId": "P00000002", "collisionPolicy": "max_of"}`,
+			mockSetup:      func(svc *mock.MockPatientService) {},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "실패 - vital 충돌 (409)",
+			body: `{"sourcePatientId": "P00000002"}`,
+			mockSetup: func(svc *mock.MockPatientService) {
+				svc.EXPECT().
+					MergePatients(gomock.Any(), "P00000001", gomock.Any()).
+					Return(nil, pkgError.WrapWithCode(pkgError.EmptyBusinessError(), pkgError.Conflict))
+			},
+			wantStatusCode: http.StatusConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			beforeEach(t)
+			tt.mockSetup(mockService)
+
+			w := httptest.NewRecorder()
+			ctx, _ := gin.CreateTestContext(w)
+
+			req := httptest.NewRequest(http.MethodPost, "/v1/patients/P00000001/merges", strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			ctx.Request = req
+			ctx.Params = gin.Params{{Key: "patient_id", Value: "P00000001"}}
+
+			controller.MergePatients(ctx)
+
+			require.Equal(t, tt.wantStatusCode, w.Code)
+		})
+	}
+}
+
+func Test_ChangePatientID(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	tests := []struct {
+		name           string
+		ifMatch        string
+		body           string
+		mockSetup      func(svc *mock.MockPatientService)
+		wantStatusCode int
+	}{
+		{
+			name: "성공 - 외부 환자 ID 변경",
+			body: `{"newPatientId": "P00000009", "version": 2}`,
+			mockSetup: func(svc *mock.MockPatientService) {
+				svc.EXPECT().
+					ChangePatientID(gomock.Any(), "P00000001", gomock.Any()).
+					Return(&patient.PatientResponse{PatientID: "P00000009", Version: 3}, nil)
+			},
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name:           "실패 - version 없음",
+			body:           `{"newPatientId": "P00000009"}`,
+			mockSetup:      func(svc *mock.MockPatientService) {},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:    "실패 - If-Match version 불일치는 412",
+			ifMatch: `"2"`,
+			body:    `{"newPatientId": "P00000009"}`,
+			mockSetup: func(svc *mock.MockPatientService) {
+				svc.EXPECT().
+					ChangePatientID(gomock.Any(), "P00000001", gomock.Any()).
+					Return(nil, pkgError.WrapWithCode(pkgError.EmptyBusinessError(), pkgError.Conflict, "version conflict in db update"))
+			},
+			wantStatusCode: http.StatusPreconditionFailed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			beforeEach(t)
+			tt.mockSetup(mockService)
+
+			w := httptest.NewRecorder()
+			ctx, _ := gin.CreateTestContext(w)
+
+			req := httptest.NewRequest(http.MethodPut, "/v1/patients/P00000001/patient-id", strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			if tt.ifMatch != "" {
+				req.Header.Set("If-Match", tt.ifMatch)
+			}
+			ctx.Request = req
+			ctx.Params = gin.Params{{Key: "patient_id", Value: "P00000001"}}
+
+			controller.ChangePatientID(ctx)
+
+			require.Equal(t, tt.wantStatusCode, w.Code)
+		})
+	}
+}
+
 func Test_GetPatientVitals(t *testing.T) {
 	gin.SetMode(gin.TestMode)
 
@@ -280,6 +600,17 @@ func Test_GetPatientVitals(t *testing.T) {
 			},
 			wantStatusCode: http.StatusOK,
 		},
+		{
+			name:        "성공 - encounter_id 만 지정 (from / to 생략)",
+			patientID:   "P00001234",
+			queryString: "encounter_id=enc-1",
+			mockSetup: func(svc *mock.MockPatientService) {
+				svc.EXPECT().
+					GetPatientVitals(gomock.Any(), "P00001234", patient.GetPatientVitalsRequest{EncounterID: "enc-1"}).
+					Return(&patient.GetPatientVitalsResponse{PatientID: "P00001234"}, nil)
+			},
+			wantStatusCode: http.StatusOK,
+		},
 		{
 			name:           "실패 - patient_id 파라미터 없음",
 			patientID:      "",
@@ -358,3 +689,108 @@ func Test_GetPatientVitals(t *testing.T) {
 		})
 	}
 }
+
+func Test_GetPatientVitals_Stream(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	recordedAt := time.Date(2025, 12, 1, 10, 15, 0, 0, time.UTC)
+	encounterID := "enc-1"
+	streamTwo := func(svc *mock.MockPatientService) {
+		svc.EXPECT().
+			StreamPatientVitals(gomock.Any(), "P00001234", gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ string, _ patient.GetPatientVitalsRequest, fn func(*vital.Vital) error) error {
+				require.NoError(t, fn(&vital.Vital{PatientID: "P00001234", VitalType: "HR", RecordedAt: recordedAt, Value: 110.5, Version: 1, EncounterID: &encounterID}))
+				return fn(&vital.Vital{PatientID: "P00001234", VitalType: "SBP", RecordedAt: recordedAt, Value: 85, Version: 2})
+			})
+	}
+
+	tests := []struct {
+		name            string
+		accept          string
+		queryString     string
+		mockSetup       func(svc *mock.MockPatientService)
+		wantStatusCode  int
+		wantContentType string
+		wantBody        string
+	}{
+		{
+			name:            "성공 - Accept text/csv",
+			accept:          "text/csv",
+			queryString:     "from=2025-12-01T10:00:00Z&to=2025-12-01T12:00:00Z",
+			mockSetup:       streamTwo,
+			wantStatusCode:  http.StatusOK,
+			wantContentType: "text/csv",
+			wantBody:        "patient_id,vital_type,recorded_at,value,version,encounter_id\nP00001234,HR,2025-12-01T10:15:00Z,110.5,1,enc-1\nP00001234,SBP,2025-12-01T10:15:00Z,85,2,\n",
+		},
+		{
+			name:            "성공 - Accept application/x-ndjson",
+			accept:          "application/x-ndjson",
+			queryString:     "from=2025-12-01T10:00:00Z&to=2025-12-01T12:00:00Z",
+			mockSetup:       streamTwo,
+			wantStatusCode:  http.StatusOK,
+			wantContentType: "application/x-ndjson",
+			wantBody: `{"patient_id":"P00001234","vital_type":"HR","recorded_at":"2025-12-01T10:15:00Z","value":110.5,"version":1,"encounter_id":"enc-1"}` + "\n" +
+				`{"patient_id":"P00001234","vital_type":"SBP","recorded_at":"2025-12-01T10:15:00Z","value":85,"version":2,"encounter_id":""}` + "\n",
+		},
+		{
+			name:            "성공 - format=parquet query",
+			queryString:     "from=2025-12-01T10:00:00Z&to=2025-12-01T12:00:00Z&format=parquet",
+			mockSetup:       streamTwo,
+			wantStatusCode:  http.StatusOK,
+			wantContentType: "application/vnd.apache.parquet",
+		},
+		{
+			name:           "실패 - 지원하지 않는 format",
+			queryString:    "from=2025-12-01T10:00:00Z&to=2025-12-01T12:00:00Z&format=xml",
+			mockSetup:      func(svc *mock.MockPatientService) {},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:        "실패 - 첫 row 이전 Service 에러",
+			accept:      "text/csv",
+			queryString: "from=2025-12-01T10:00:00Z&to=2025-12-01T12:00:00Z",
+			mockSetup: func(svc *mock.MockPatientService) {
+				svc.EXPECT().
+					StreamPatientVitals(gomock.Any(), "P00001234", gomock.Any(), gomock.Any()).
+					Return(pkgError.WrapWithCode(pkgError.EmptyBusinessError(), pkgError.WrongParam, "invalid from date format"))
+			},
+			wantStatusCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			beforeEach(t)
+			tt.mockSetup(mockService)
+
+			w := httptest.NewRecorder()
+			ctx, _ := gin.CreateTestContext(w)
+
+			req := httptest.NewRequest(
+				http.MethodGet,
+				"/v1/patients/P00001234/vitals?"+tt.queryString,
+				nil,
+			)
+			if tt.accept != "" {
+				req.Header.Set("Accept", tt.accept)
+			}
+			ctx.Request = req
+			ctx.Params = gin.Params{
+				{Key: "patient_id", Value: "P00001234"},
+			}
+
+			controller.GetPatientVitals(ctx)
+
+			require.Equal(t, tt.wantStatusCode, w.Code)
+			if tt.wantContentType != "" {
+				require.Equal(t, tt.wantContentType, w.Header().Get("Content-Type"))
+			}
+			if tt.wantBody != "" {
+				require.Equal(t, tt.wantBody, w.Body.String())
+			}
+			if tt.wantContentType == "application/vnd.apache.parquet" {
+				require.True(t, strings.HasPrefix(w.Body.String(), "PAR1"))
+			}
+		})
+	}
+}
@@ -1,13 +1,56 @@
 package controller
 
 import (
+	"aitrics-vital-signs/api-server/domain/audit"
+	"aitrics-vital-signs/api-server/domain/auth"
 	"aitrics-vital-signs/api-server/domain/vital"
 	"aitrics-vital-signs/api-server/internal/output"
+	"aitrics-vital-signs/api-server/pkg/constant"
 	pkgError "aitrics-vital-signs/library/error"
+	pkgLogger "aitrics-vital-signs/library/logger"
+	"time"
 
 	"github.com/gin-gonic/gin"
 )
 
+// VitalExportColumns vital export (csv / ndjson / parquet) 컬럼 정의 (HTTP / CLI 공용)
+var VitalExportColumns = []output.Column{
+	{Name: "patient_id", Kind: output.ColumnString},
+	{Name: "vital_type", Kind: output.ColumnString},
+	{Name: "recorded_at", Kind: output.ColumnTime},
+	{Name: "value", Kind: output.ColumnFloat64},
+	{Name: "version", Kind: output.ColumnInt64},
+	{Name: "encounter_id", Kind: output.ColumnString},
+}
+
+type vitalExportRecord struct {
+	PatientID  string    `json:"patient_id"`
+	VitalType  string    `json:"vital_type"`
+	RecordedAt time.Time `json:"recorded_at"`
+	Value      float64   `json:"value"`
+	Version    int       `json:"version"`
+	// encounter 미연결 vital 은 빈 문자열
+	EncounterID string `json:"encounter_id"`
+}
+
+func (r vitalExportRecord) Values() []interface{} {
+	return []interface{}{r.PatientID, r.VitalType, r.RecordedAt, r.Value, r.Version, r.EncounterID}
+}
+
+func NewVitalExportRecord(v *vital.Vital) output.Record {
+	record := vitalExportRecord{
+		PatientID:  v.PatientID,
+		VitalType:  v.VitalType,
+		RecordedAt: v.RecordedAt.UTC(),
+		Value:      v.Value,
+		Version:    v.Version,
+	}
+	if v.EncounterID != nil {
+		record.EncounterID = *v.EncounterID
+	}
+	return record
+}
+
 type vitalController struct {
 	service vital.VitalService
 }
@@ -15,14 +58,16 @@ type vitalController struct {
 // UpsertVital
 // @Security Bearer
 // @Title UpsertVital
-// @Description Vital 데이터 저장/수정 (UPSERT, Optimistic Lock 적용)
+// @Description Vital 데이터 저장/수정 (UPSERT, Optimistic Lock 적용, conflict_policy 가 strict 가 아니면 version 없이 서버에서 충돌 해결)
 // @Tags V1 - Vital
 // @Accept json
 // @Produce json
+// @Param If-Match header string false "GetVital 응답의 ETag (body version 대신 사용, 신규 저장은 \"1\")"
 // @Param reqBody body vital.UpsertVitalRequest true "Vital 데이터 저장/수정 요청"
-// @Success 200 {object} output.Output
+// @Success 200 {object} output.Output{data=vital.UpsertVitalResponse}
 // @Failure 400 {object} output.Output "code: 400001 - Wrong parameter"
-// @Failure 409 {object} output.Output "code: 400002 - Version conflict"
+// @Failure 409 {object} output.Output "code: 400002 - Version conflict (body version) / conflict_policy 재시도 초과"
+// @Failure 412 {object} output.Output "code: 400009 - Version conflict (If-Match)"
 // @Failure 500 {object} output.Output "code: 100001 - Fail to create data / code: 100002 - Fail to update data"
 // @Router /v1/vitals [Post]
 func (v *vitalController) UpsertVital(ctx *gin.Context) {
@@ -31,13 +76,112 @@ func (v *vitalController) UpsertVital(ctx *gin.Context) {
 		output.AppendErrorContext(ctx, pkgError.WrapWithCode(err, pkgError.WrongParam, err.Error(), "fail to parse request parameter"), nil)
 		return
 	}
+	audit.AddResources(ctx, audit.Resource{PatientID: reqBody.PatientID, Key: audit.VitalResourceKey(reqBody.VitalType, reqBody.RecordedAt)})
+	pkgLogger.SetPatientID(ctx.Request.Context(), reqBody.PatientID)
+
+	// 요청에 정책이 없으면 API key 의 기본 정책을 사용
+	if reqBody.ConflictPolicy == "" {
+		if principal, ok := auth.PrincipalFromContext(ctx.Request.Context()); ok {
+			reqBody.ConflictPolicy = principal.ConflictPolicy
+		}
+	}
+
+	fromHeader := false
+	if reqBody.ConflictPolicy == "" || reqBody.ConflictPolicy == constant.ConflictPolicyStrict.String() {
+		version, isHeader, err := output.ResolveVersion(ctx, reqBody.Version)
+		if err != nil {
+			output.AppendErrorContext(ctx, pkgError.Wrap(err), nil)
+			return
+		}
+		reqBody.Version, fromHeader = version, isHeader
+	} else if output.HasIfMatch(ctx) {
+		output.AppendErrorContext(ctx, pkgError.WrapWithCode(pkgError.EmptyBusinessError(), pkgError.WrongParam, "If-Match can only be used with strict conflict policy"), nil)
+		return
+	}
+
+	result, err := v.service.UpsertVital(ctx, reqBody)
+	if err != nil {
+		output.AppendErrorContext(ctx, output.PreconditionError(pkgError.Wrap(err), fromHeader), nil)
+		return
+	}
+
+	output.Send(ctx, result)
+}
+
+// GetVital
+// @Security Bearer
+// @Title GetVital
+// @Description Vital 단건 조회 (ETag 는 version, If-None-Match 가 일치하면 304)
+// @Tags V1 - Vital
+// @Produce json
+// @Param patient_id path string true "환자 ID"
+// @Param vital_type path string true "Vital 타입 (HR, RR, SBP, DBP, SpO2, BT)"
+// @Param recorded_at path string true "기록 시각 (RFC3339 format)"
+// @Param If-None-Match header string false "이전 응답의 ETag"
+// @Success 200 {object} output.Output{data=vital.VitalResponse}
+// @Success 304 "Not Modified"
+// @Failure 400 {object} output.Output "code: 400001 - Wrong parameter"
+// @Failure 404 {object} output.Output "code: 400003 - Not found"
+// @Failure 500 {object} output.Output "code: 100005 - Fail to get data from db"
+// @Router /v1/vitals/{patient_id}/{vital_type}/{recorded_at} [Get]
+func (v *vitalController) GetVital(ctx *gin.Context) {
+	var uriParams vital.GetVitalRequest
+	if err := ctx.ShouldBindUri(&uriParams); err != nil {
+		output.AppendErrorContext(ctx, pkgError.WrapWithCode(err, pkgError.WrongParam, err.Error(), "fail to parse path parameters"), nil)
+		return
+	}
+	audit.AddResources(ctx, audit.Resource{PatientID: uriParams.PatientID, Key: audit.VitalResourceKey(uriParams.VitalType, uriParams.RecordedAt)})
+
+	result, err := v.service.GetVital(ctx, uriParams)
+	if err != nil {
+		output.AppendErrorContext(ctx, pkgError.Wrap(err), nil)
+		return
+	}
+
+	if output.NotModified(ctx, output.VersionETag(result.Version)) {
+		return
+	}
+
+	output.Send(ctx, result)
+}
 
-	if err := v.service.UpsertVital(ctx, reqBody); err != nil {
+// ExportVitals
+// @Security Bearer
+// @Title ExportVitals
+// @Description 다수 환자의 Vital 데이터 export (Accept 헤더 또는 format query 로 csv / ndjson / parquet 선택, 기본값 ndjson)
+// @Tags V1 - Vital
+// @Produce application/x-ndjson,text/csv,application/vnd.apache.parquet
+// @Param patient_ids query []string true "환자 ID 목록 (최대 100)"
+// @Param from query string true "조회 시작 시간 (RFC3339 format)"
+// @Param to query string true "조회 종료 시간 (RFC3339 format)"
+// @Param vital_types query []string false "Vital 타입 (HR, RR, SBP, DBP, SpO2, BT)"
+// @Param format query string false "응답 포맷 (csv, ndjson, parquet)"
+// @Success 200 {file} file
+// @Failure 400 {object} output.Output "code: 400001 - Wrong parameter"
+// @Failure 500 {object} output.Output "code: 100005 - Fail to get data from db"
+// @Router /v1/vitals/export [Get]
+func (v *vitalController) ExportVitals(ctx *gin.Context) {
+	format, err := output.NegotiateFormat(ctx, output.FormatNDJSON, output.FormatCSV, output.FormatParquet)
+	if err != nil {
 		output.AppendErrorContext(ctx, pkgError.Wrap(err), nil)
 		return
 	}
 
-	output.Send(ctx, nil)
+	var queryParams vital.ExportVitalsRequest
+	if err := ctx.ShouldBindQuery(&queryParams); err != nil {
+		output.AppendErrorContext(ctx, pkgError.WrapWithCode(err, pkgError.WrongParam, err.Error(), "fail to parse query parameters"), nil)
+		return
+	}
+
+	for _, patientID := range queryParams.PatientIDs {
+		audit.AddResources(ctx, audit.Resource{PatientID: patientID})
+	}
+
+	output.Stream(ctx, format, "vitals", VitalExportColumns, func(write func(output.Record) error) error {
+		return v.service.ExportVitals(ctx, queryParams, func(model *vital.Vital) error {
+			return write(NewVitalExportRecord(model))
+		})
+	})
 }
 
 func NewVitalController(service vital.VitalService) vital.VitalController {
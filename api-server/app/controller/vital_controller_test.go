@@ -1,13 +1,17 @@
 package controller
 
 import (
+	"aitrics-vital-signs/api-server/domain/auth"
 	"aitrics-vital-signs/api-server/domain/mock"
 	"aitrics-vital-signs/api-server/domain/vital"
 	pkgError "aitrics-vital-signs/library/error"
+	"context"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/require"
@@ -30,6 +34,8 @@ func Test_UpsertVital(t *testing.T) {
 
 	tests := []struct {
 		name           string
+		ifMatch        string
+		principal      *auth.Principal
 		body           string
 		mockSetup      func(svc *mock.MockVitalService)
 		wantStatusCode int
@@ -46,7 +52,7 @@ func Test_UpsertVital(t *testing.T) {
 			mockSetup: func(svc *mock.MockVitalService) {
 				svc.EXPECT().
 					UpsertVital(gomock.Any(), gomock.Any()).
-					Return(nil)
+					Return(&vital.UpsertVitalResponse{ConflictPolicy: "strict", Result: "inserted", Value: 110.0, Attempts: 1}, nil)
 			},
 			wantStatusCode: http.StatusOK,
 		},
@@ -62,7 +68,7 @@ func Test_UpsertVital(t *testing.T) {
 			mockSetup: func(svc *mock.MockVitalService) {
 				svc.EXPECT().
 					UpsertVital(gomock.Any(), gomock.Any()).
-					Return(nil)
+					Return(&vital.UpsertVitalResponse{ConflictPolicy: "strict", Result: "inserted", Value: 110.0, Attempts: 1}, nil)
 			},
 			wantStatusCode: http.StatusOK,
 		},
@@ -124,10 +130,57 @@ func Test_UpsertVital(t *testing.T) {
 			mockSetup: func(svc *mock.MockVitalService) {
 				svc.EXPECT().
 					UpsertVital(gomock.Any(), gomock.Any()).
-					Return(pkgError.WrapWithCode(pkgError.EmptyBusinessError(), pkgError.Conflict, "version mismatch"))
+					Return(nil, pkgError.WrapWithCode(pkgError.EmptyBusinessError(), pkgError.Conflict, "version mismatch"))
 			},
 			wantStatusCode: http.StatusConflict,
 		},
+		{
+			name:    "성공 - If-Match 로 version 전달",
+			ifMatch: `"2"`,
+			body: `{
+				"patient_id": "P00001234",
+				"recorded_at": "2025-12-01T10:15:00Z",
+				"vital_type": "HR",
+				"value": 120.0
+			}`,
+			mockSetup: func(svc *mock.MockVitalService) {
+				svc.EXPECT().
+					UpsertVital(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, req vital.UpsertVitalRequest) (*vital.UpsertVitalResponse, error) {
+						require.Equal(t, 2, req.Version)
+						return &vital.UpsertVitalResponse{ConflictPolicy: "strict", Result: "updated", Value: 120.0, Attempts: 1}, nil
+					})
+			},
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name:    "실패 - If-Match version 불일치는 412",
+			ifMatch: `"2"`,
+			body: `{
+				"patient_id": "P00001234",
+				"recorded_at": "2025-12-01T10:15:00Z",
+				"vital_type": "HR",
+				"value": 120.0
+			}`,
+			mockSetup: func(svc *mock.MockVitalService) {
+				svc.EXPECT().
+					UpsertVital(gomock.Any(), gomock.Any()).
+					Return(nil, pkgError.WrapWithCode(pkgError.EmptyBusinessError(), pkgError.Conflict, "version mismatch"))
+			},
+			wantStatusCode: http.StatusPreconditionFailed,
+		},
+		{
+			name:    "실패 - weak ETag 는 If-Match 에 사용할 수 없음",
+			ifMatch: `W/"2"`,
+			body: `{
+				"patient_id": "P00001234",
+				"recorded_at": "2025-12-01T10:15:00Z",
+				"vital_type": "HR",
+				"value": 120.0
+			}`,
+			mockSetup:      func(svc *mock.MockVitalService) {},
+			wantStatusCode: http.StatusBadRequest,
+		},
 		{
 			name: "실패 - INSERT 시 잘못된 version",
 			body: `{
@@ -140,7 +193,7 @@ func Test_UpsertVital(t *testing.T) {
 			mockSetup: func(svc *mock.MockVitalService) {
 				svc.EXPECT().
 					UpsertVital(gomock.Any(), gomock.Any()).
-					Return(pkgError.WrapWithCode(pkgError.EmptyBusinessError(), pkgError.WrongParam, "version must be 1 for new record"))
+					Return(nil, pkgError.WrapWithCode(pkgError.EmptyBusinessError(), pkgError.WrongParam, "version must be 1 for new record"))
 			},
 			wantStatusCode: http.StatusBadRequest,
 		},
@@ -156,10 +209,87 @@ func Test_UpsertVital(t *testing.T) {
 			mockSetup: func(svc *mock.MockVitalService) {
 				svc.EXPECT().
 					UpsertVital(gomock.Any(), gomock.Any()).
-					Return(pkgError.WrapWithCode(pkgError.EmptyBusinessError(), pkgError.Create))
+					Return(nil, pkgError.WrapWithCode(pkgError.EmptyBusinessError(), pkgError.Create))
 			},
 			wantStatusCode: http.StatusInternalServerError,
 		},
+		{
+			name: "성공 - conflict_policy 사용 시 version 생략",
+			body: `{
+				"patient_id": "P00001234",
+				"recorded_at": "2025-12-01T10:15:00Z",
+				"vital_type": "HR",
+				"value": 110.0,
+				"conflict_policy": "max_of"
+			}`,
+			mockSetup: func(svc *mock.MockVitalService) {
+				svc.EXPECT().
+					UpsertVital(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, req vital.UpsertVitalRequest) (*vital.UpsertVitalResponse, error) {
+						require.Equal(t, "max_of", req.ConflictPolicy)
+						require.Equal(t, 0, req.Version)
+						return &vital.UpsertVitalResponse{ConflictPolicy: "max_of", Result: "unchanged", Value: 120.0, Attempts: 1}, nil
+					})
+			},
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name:      "성공 - API key 의 기본 conflict_policy 사용",
+			principal: &auth.Principal{ID: "key-1", ConflictPolicy: "last_write_wins"},
+			body: `{
+				"patient_id": "P00001234",
+				"recorded_at": "2025-12-01T10:15:00Z",
+				"vital_type": "HR",
+				"value": 110.0
+			}`,
+			mockSetup: func(svc *mock.MockVitalService) {
+				svc.EXPECT().
+					UpsertVital(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, req vital.UpsertVitalRequest) (*vital.UpsertVitalResponse, error) {
+						require.Equal(t, "last_write_wins", req.ConflictPolicy)
+						return &vital.UpsertVitalResponse{ConflictPolicy: "last_write_wins", Result: "updated", Value: 110.0, Attempts: 2}, nil
+					})
+			},
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name:      "실패 - 요청의 strict 정책은 API key 기본 정책보다 우선",
+			principal: &auth.Principal{ID: "key-1", ConflictPolicy: "last_write_wins"},
+			body: `{
+				"patient_id": "P00001234",
+				"recorded_at": "2025-12-01T10:15:00Z",
+				"vital_type": "HR",
+				"value": 110.0,
+				"conflict_policy": "strict"
+			}`,
+			mockSetup:      func(svc *mock.MockVitalService) {},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:    "실패 - strict 가 아닌 정책에는 If-Match 사용 불가",
+			ifMatch: `"2"`,
+			body: `{
+				"patient_id": "P00001234",
+				"recorded_at": "2025-12-01T10:15:00Z",
+				"vital_type": "HR",
+				"value": 110.0,
+				"conflict_policy": "keep_first"
+			}`,
+			mockSetup:      func(svc *mock.MockVitalService) {},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "실패 - 잘못된 conflict_policy",
+			body: `{
+				"patient_id": "P00001234",
+				"recorded_at": "2025-12-01T10:15:00Z",
+				"vital_type": "HR",
+				"value": 110.0,
+				"conflict_policy": "newest"
+			}`,
+			mockSetup:      func(svc *mock.MockVitalService) {},
+			wantStatusCode: http.StatusBadRequest,
+		},
 	}
 
 	for _, tt := range tests {
@@ -176,7 +306,13 @@ func Test_UpsertVital(t *testing.T) {
 				strings.NewReader(tt.body),
 			)
 			req.Header.Set("Content-Type", "application/json")
+			if tt.ifMatch != "" {
+				req.Header.Set("If-Match", tt.ifMatch)
+			}
 			ctx.Request = req
+			if tt.principal != nil {
+				ctx.Request = ctx.Request.WithContext(auth.WithPrincipal(ctx.Request.Context(), tt.principal))
+			}
 
 			testVitalController.UpsertVital(ctx)
 
@@ -184,3 +320,184 @@ func Test_UpsertVital(t *testing.T) {
 		})
 	}
 }
+
+func Test_GetVital(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		recordedAt     string
+		ifNoneMatch    string
+		mockSetup      func(svc *mock.MockVitalService)
+		wantStatusCode int
+		wantETag       string
+	}{
+		{
+			name:       "성공 - version 으로 ETag 응답",
+			recordedAt: "2025-12-01T10:15:00Z",
+			mockSetup: func(svc *mock.MockVitalService) {
+				svc.EXPECT().
+					GetVital(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, req vital.GetVitalRequest) (*vital.VitalResponse, error) {
+						require.Equal(t, "P00001234", req.PatientID)
+						require.Equal(t, "HR", req.VitalType)
+						require.Equal(t, time.Date(2025, 12, 1, 10, 15, 0, 0, time.UTC), req.RecordedAt.UTC())
+						return &vital.VitalResponse{PatientID: "P00001234", VitalType: "HR", Version: 2}, nil
+					})
+			},
+			wantStatusCode: http.StatusOK,
+			wantETag:       `"2"`,
+		},
+		{
+			name:        "성공 - If-None-Match 가 일치하면 304",
+			recordedAt:  "2025-12-01T10:15:00Z",
+			ifNoneMatch: `W/"2"`,
+			mockSetup: func(svc *mock.MockVitalService) {
+				svc.EXPECT().
+					GetVital(gomock.Any(), gomock.Any()).
+					Return(&vital.VitalResponse{PatientID: "P00001234", VitalType: "HR", Version: 2}, nil)
+			},
+			wantStatusCode: http.StatusNotModified,
+			wantETag:       `"2"`,
+		},
+		{
+			name:           "실패 - 잘못된 날짜 형식",
+			recordedAt:     "2025-12-01 10:15:00",
+			mockSetup:      func(svc *mock.MockVitalService) {},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:       "실패 - 데이터 없음",
+			recordedAt: "2025-12-01T10:15:00Z",
+			mockSetup: func(svc *mock.MockVitalService) {
+				svc.EXPECT().
+					GetVital(gomock.Any(), gomock.Any()).
+					Return(nil, pkgError.WrapWithCode(pkgError.EmptyBusinessError(), pkgError.NotFound))
+			},
+			wantStatusCode: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			beforeEachVital(t)
+			tt.mockSetup(mockVitalService)
+
+			w := httptest.NewRecorder()
+			ctx, _ := gin.CreateTestContext(w)
+
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/vitals/P00001234/HR/"+url.PathEscape(tt.recordedAt), nil)
+			if tt.ifNoneMatch != "" {
+				req.Header.Set("If-None-Match", tt.ifNoneMatch)
+			}
+			ctx.Request = req
+			ctx.Params = gin.Params{
+				{Key: "patient_id", Value: "P00001234"},
+				{Key: "vital_type", Value: "HR"},
+				{Key: "recorded_at", Value: tt.recordedAt},
+			}
+
+			testVitalController.GetVital(ctx)
+
+			require.Equal(t, tt.wantStatusCode, ctx.Writer.Status())
+			require.Equal(t, tt.wantETag, w.Header().Get("ETag"))
+		})
+	}
+}
+
+func Test_ExportVitals(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name            string
+		queryString     string
+		accept          string
+		mockSetup       func(svc *mock.MockVitalService)
+		wantStatusCode  int
+		wantContentType string
+		wantLines       int
+	}{
+		{
+			name:        "성공 - 기본 ndjson",
+			queryString: "patient_ids=P00001234&patient_ids=P00005678&from=2025-12-01T10:00:00Z&to=2025-12-01T12:00:00Z",
+			mockSetup: func(svc *mock.MockVitalService) {
+				svc.EXPECT().
+					ExportVitals(gomock.Any(), vital.ExportVitalsRequest{
+						PatientIDs: []string{"P00001234", "P00005678"},
+						From:       "2025-12-01T10:00:00Z",
+						To:         "2025-12-01T12:00:00Z",
+					}, gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ vital.ExportVitalsRequest, fn func(*vital.Vital) error) error {
+						require.NoError(t, fn(&vital.Vital{PatientID: "P00001234", VitalType: "HR", Value: 110}))
+						return fn(&vital.Vital{PatientID: "P00005678", VitalType: "HR", Value: 90})
+					})
+			},
+			wantStatusCode:  http.StatusOK,
+			wantContentType: "application/x-ndjson",
+			wantLines:       2,
+		},
+		{
+			name:        "성공 - 결과 없을 때 csv header 만 응답",
+			queryString: "patient_ids=P00001234&from=2025-12-01T10:00:00Z&to=2025-12-01T12:00:00Z",
+			accept:      "text/csv",
+			mockSetup: func(svc *mock.MockVitalService) {
+				svc.EXPECT().
+					ExportVitals(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil)
+			},
+			wantStatusCode:  http.StatusOK,
+			wantContentType: "text/csv",
+			wantLines:       1,
+		},
+		{
+			name:           "실패 - patient_ids 누락",
+			queryString:    "from=2025-12-01T10:00:00Z&to=2025-12-01T12:00:00Z",
+			mockSetup:      func(svc *mock.MockVitalService) {},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "실패 - json 포맷은 export 미지원",
+			queryString:    "patient_ids=P00001234&from=2025-12-01T10:00:00Z&to=2025-12-01T12:00:00Z&format=json",
+			mockSetup:      func(svc *mock.MockVitalService) {},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:        "실패 - Service 에러 (DB 조회 실패)",
+			queryString: "patient_ids=P00001234&from=2025-12-01T10:00:00Z&to=2025-12-01T12:00:00Z",
+			mockSetup: func(svc *mock.MockVitalService) {
+				svc.EXPECT().
+					ExportVitals(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(pkgError.WrapWithCode(pkgError.EmptyBusinessError(), pkgError.Get))
+			},
+			wantStatusCode: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			beforeEachVital(t)
+			tt.mockSetup(mockVitalService)
+
+			w := httptest.NewRecorder()
+			ctx, _ := gin.CreateTestContext(w)
+
+			req := httptest.NewRequest(
+				http.MethodGet,
+				"/api/v1/vitals/export?"+tt.queryString,
+				nil,
+			)
+			if tt.accept != "" {
+				req.Header.Set("Accept", tt.accept)
+			}
+			ctx.Request = req
+
+			testVitalController.ExportVitals(ctx)
+
+			require.Equal(t, tt.wantStatusCode, w.Code)
+			if tt.wantContentType != "" {
+				require.Equal(t, tt.wantContentType, w.Header().Get("Content-Type"))
+				require.Equal(t, tt.wantLines, strings.Count(w.Body.String(), "\n"))
+			}
+		})
+	}
+}
@@ -0,0 +1,251 @@
+package controller
+
+import (
+	"aitrics-vital-signs/api-server/domain/audit"
+	"aitrics-vital-signs/api-server/domain/ward"
+	"aitrics-vital-signs/api-server/internal/output"
+	pkgError "aitrics-vital-signs/library/error"
+	"context"
+
+	"github.com/gin-gonic/gin"
+)
+
+type wardController struct {
+	service ward.WardService
+}
+
+// CreateWard
+// @Security Bearer
+// @Title CreateWard
+// @Description 병동 등록 (admin scope 필요)
+// @Tags V1 - Ward
+// @Accept json
+// @Produce json
+// @Param reqBody body ward.CreateWardRequest true "병동 등록 요청"
+// @Success 200 {object} output.Output{data=ward.WardResponse}
+// @Failure 400 {object} output.Output "code: 400001 - Wrong parameter"
+// @Failure 403 {object} output.Output "code: 400005 - Forbidden"
+// @Failure 500 {object} output.Output "code: 100001 - Fail to create data"
+// @Router /v1/wards [Post]
+func (w *wardController) CreateWard(ctx *gin.Context) {
+	var reqBody ward.CreateWardRequest
+	if err := ctx.ShouldBindJSON(&reqBody); err != nil {
+		output.AppendErrorContext(ctx, pkgError.WrapWithCode(err, pkgError.WrongParam, err.Error(), "fail to parse request parameter"), nil)
+		return
+	}
+
+	result, err := w.service.CreateWard(ctx, reqBody)
+	if err != nil {
+		output.AppendErrorContext(ctx, pkgError.Wrap(err), nil)
+		return
+	}
+
+	output.Send(ctx, result)
+}
+
+// ListWards
+// @Security Bearer
+// @Title ListWards
+// @Description 병동 목록 조회
+// @Tags V1 - Ward
+// @Produce json
+// @Success 200 {object} output.Output{data=[]ward.WardResponse}
+// @Failure 500 {object} output.Output "code: 100005 - Fail to get data"
+// @Router /v1/wards [Get]
+func (w *wardController) ListWards(ctx *gin.Context) {
+	result, err := w.service.ListWards(ctx)
+	if err != nil {
+		output.AppendErrorContext(ctx, pkgError.Wrap(err), nil)
+		return
+	}
+
+	output.Send(ctx, result)
+}
+
+// CreateBed
+// @Security Bearer
+// @Title CreateBed
+// @Description 병상 등록 (admin scope 필요)
+// @Tags V1 - Ward
+// @Accept json
+// @Produce json
+// @Param ward_id path string true "병동 ID"
+// @Param reqBody body ward.CreateBedRequest true "병상 등록 요청"
+// @Success 200 {object} output.Output{data=ward.BedResponse}
+// @Failure 400 {object} output.Output "code: 400001 - Wrong parameter"
+// @Failure 404 {object} output.Output "code: 400003 - Ward not found"
+// @Failure 500 {object} output.Output "code: 100001 - Fail to create data"
+// @Router /v1/wards/{ward_id}/beds [Post]
+func (w *wardController) CreateBed(ctx *gin.Context) {
+	wardID := ctx.Param("ward_id")
+	if wardID == "" {
+		output.AppendErrorContext(ctx, pkgError.WrapWithCode(pkgError.EmptyBusinessError(), pkgError.WrongParam, "ward_id is required"), nil)
+		return
+	}
+
+	var reqBody ward.CreateBedRequest
+	if err := ctx.ShouldBindJSON(&reqBody); err != nil {
+		output.AppendErrorContext(ctx, pkgError.WrapWithCode(err, pkgError.WrongParam, err.Error(), "fail to parse request parameter"), nil)
+		return
+	}
+
+	result, err := w.service.CreateBed(ctx, wardID, reqBody)
+	if err != nil {
+		output.AppendErrorContext(ctx, pkgError.Wrap(err), nil)
+		return
+	}
+
+	output.Send(ctx, result)
+}
+
+// GetWardPatients
+// @Security Bearer
+// @Title GetWardPatients
+// @Description 병동 재원 환자 조회 (최신 위험도 / 최신 vital 포함, 위험도 높은 순 정렬)
+// @Tags V1 - Ward
+// @Produce json
+// @Param ward_id path string true "병동 ID"
+// @Success 200 {object} output.Output{data=ward.GetWardPatientsResponse}
+// @Failure 404 {object} output.Output "code: 400003 - Ward not found"
+// @Failure 500 {object} output.Output "code: 100005 - Fail to get data"
+// @Router /v1/wards/{ward_id}/patients [Get]
+func (w *wardController) GetWardPatients(ctx *gin.Context) {
+	wardID := ctx.Param("ward_id")
+	if wardID == "" {
+		output.AppendErrorContext(ctx, pkgError.WrapWithCode(pkgError.EmptyBusinessError(), pkgError.WrongParam, "ward_id is required"), nil)
+		return
+	}
+
+	result, err := w.service.GetWardPatients(ctx, wardID)
+	if err != nil {
+		output.AppendErrorContext(ctx, pkgError.Wrap(err), nil)
+		return
+	}
+
+	for _, item := range result.Items {
+		audit.AddResources(ctx, audit.Resource{PatientID: item.PatientID})
+	}
+
+	output.Send(ctx, result)
+}
+
+// AdmitPatient
+// @Security Bearer
+// @Title AdmitPatient
+// @Description 환자 입원 (병상 배정)
+// @Tags V1 - Ward
+// @Accept json
+// @Produce json
+// @Param patient_id path string true "환자 ID"
+// @Param reqBody body ward.AssignBedRequest true "병상 배정 요청"
+// @Success 200 {object} output.Output{data=ward.BedAssignmentResponse}
+// @Failure 400 {object} output.Output "code: 400001 - Wrong parameter"
+// @Failure 404 {object} output.Output "code: 400003 - Patient or bed not found"
+// @Failure 409 {object} output.Output "code: 400002 - Already admitted or bed occupied"
+// @Failure 500 {object} output.Output "code: 100001 - Fail to create data"
+// @Router /v1/patients/{patient_id}/admission [Post]
+func (w *wardController) AdmitPatient(ctx *gin.Context) {
+	w.assignBed(ctx, w.service.AdmitPatient)
+}
+
+// TransferPatient
+// @Security Bearer
+// @Title TransferPatient
+// @Description 환자 전동 / 전실 (기존 배정 해제 후 신규 병상 배정)
+// @Tags V1 - Ward
+// @Accept json
+// @Produce json
+// @Param patient_id path string true "환자 ID"
+// @Param reqBody body ward.AssignBedRequest true "병상 배정 요청"
+// @Success 200 {object} output.Output{data=ward.BedAssignmentResponse}
+// @Failure 400 {object} output.Output "code: 400001 - Wrong parameter"
+// @Failure 404 {object} output.Output "code: 400003 - Bed not found"
+// @Failure 409 {object} output.Output "code: 400002 - Not admitted or bed occupied"
+// @Failure 500 {object} output.Output "code: 100002 - Fail to update data"
+// @Router /v1/patients/{patient_id}/transfer [Post]
+func (w *wardController) TransferPatient(ctx *gin.Context) {
+	w.assignBed(ctx, w.service.TransferPatient)
+}
+
+// DischargePatient
+// @Security Bearer
+// @Title DischargePatient
+// @Description 환자 퇴원 (병상 배정 해제)
+// @Tags V1 - Ward
+// @Produce json
+// @Param patient_id path string true "환자 ID"
+// @Success 200 {object} output.Output{data=ward.BedAssignmentResponse}
+// @Failure 409 {object} output.Output "code: 400002 - Not admitted"
+// @Failure 500 {object} output.Output "code: 100002 - Fail to update data"
+// @Router /v1/patients/{patient_id}/discharge [Post]
+func (w *wardController) DischargePatient(ctx *gin.Context) {
+	patientID := ctx.Param("patient_id")
+	if patientID == "" {
+		output.AppendErrorContext(ctx, pkgError.WrapWithCode(pkgError.EmptyBusinessError(), pkgError.WrongParam, "patient_id is required"), nil)
+		return
+	}
+
+	result, err := w.service.DischargePatient(ctx, patientID)
+	if err != nil {
+		output.AppendErrorContext(ctx, pkgError.Wrap(err), nil)
+		return
+	}
+
+	output.Send(ctx, result)
+}
+
+// GetPatientBedAssignments
+// @Security Bearer
+// @Title GetPatientBedAssignments
+// @Description 환자 병상 배정 이력 조회 (최신순)
+// @Tags V1 - Ward
+// @Produce json
+// @Param patient_id path string true "환자 ID"
+// @Success 200 {object} output.Output{data=[]ward.BedAssignmentResponse}
+// @Failure 404 {object} output.Output "code: 400003 - Patient not found"
+// @Failure 500 {object} output.Output "code: 100005 - Fail to get data"
+// @Router /v1/patients/{patient_id}/bed-assignments [Get]
+func (w *wardController) GetPatientBedAssignments(ctx *gin.Context) {
+	patientID := ctx.Param("patient_id")
+	if patientID == "" {
+		output.AppendErrorContext(ctx, pkgError.WrapWithCode(pkgError.EmptyBusinessError(), pkgError.WrongParam, "patient_id is required"), nil)
+		return
+	}
+
+	result, err := w.service.GetPatientBedAssignments(ctx, patientID)
+	if err != nil {
+		output.AppendErrorContext(ctx, pkgError.Wrap(err), nil)
+		return
+	}
+
+	output.Send(ctx, result)
+}
+
+// assignBed 입원 / 전동 공통 요청 처리
+func (w *wardController) assignBed(ctx *gin.Context, assign func(ctx context.Context, patientID string, request ward.AssignBedRequest) (*ward.BedAssignmentResponse, error)) {
+	patientID := ctx.Param("patient_id")
+	if patientID == "" {
+		output.AppendErrorContext(ctx, pkgError.WrapWithCode(pkgError.EmptyBusinessError(), pkgError.WrongParam, "patient_id is required"), nil)
+		return
+	}
+
+	var reqBody ward.AssignBedRequest
+	if err := ctx.ShouldBindJSON(&reqBody); err != nil {
+		output.AppendErrorContext(ctx, pkgError.WrapWithCode(err, pkgError.WrongParam, err.Error(), "fail to parse request parameter"), nil)
+		return
+	}
+
+	result, err := assign(ctx, patientID, reqBody)
+	if err != nil {
+		output.AppendErrorContext(ctx, pkgError.Wrap(err), nil)
+		return
+	}
+
+	output.Send(ctx, result)
+}
+
+func NewWardController(service ward.WardService) ward.WardController {
+	return &wardController{
+		service: service,
+	}
+}
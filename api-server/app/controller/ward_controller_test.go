@@ -0,0 +1,132 @@
+package controller
+
+import (
+	"aitrics-vital-signs/api-server/domain/mock"
+	"aitrics-vital-signs/api-server/domain/ward"
+	pkgError "aitrics-vital-signs/library/error"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+var (
+	testWardController ward.WardController
+	mockWardService    *mock.MockWardService
+)
+
+func beforeEachWard(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockWardService = mock.NewMockWardService(ctrl)
+	testWardController = NewWardController(mockWardService)
+}
+
+func Test_AdmitPatient(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		body           string
+		mockSetup      func(svc *mock.MockWardService)
+		wantStatusCode int
+	}{
+		{
+			name: "성공",
+			body: `{"bed_id": "bed-1"}`,
+			mockSetup: func(svc *mock.MockWardService) {
+				svc.EXPECT().
+					AdmitPatient(gomock.Any(), "P00001", ward.AssignBedRequest{BedID: "bed-1"}).
+					Return(&ward.BedAssignmentResponse{ID: "a-1"}, nil)
+			},
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name:           "실패 - bed_id 누락",
+			body:           `{}`,
+			mockSetup:      func(svc *mock.MockWardService) {},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "실패 - 사용 중인 병상",
+			body: `{"bed_id": "bed-1"}`,
+			mockSetup: func(svc *mock.MockWardService) {
+				svc.EXPECT().
+					AdmitPatient(gomock.Any(), "P00001", gomock.Any()).
+					Return(nil, pkgError.WrapWithCode(pkgError.EmptyBusinessError(), pkgError.Conflict, "bed is occupied"))
+			},
+			wantStatusCode: http.StatusConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			beforeEachWard(t)
+			tt.mockSetup(mockWardService)
+
+			w := httptest.NewRecorder()
+			ctx, _ := gin.CreateTestContext(w)
+
+			req := httptest.NewRequest(
+				http.MethodPost,
+				"/api/v1/patients/P00001/admission",
+				strings.NewReader(tt.body),
+			)
+			req.Header.Set("Content-Type", "application/json")
+			ctx.Request = req
+			ctx.Params = gin.Params{{Key: "patient_id", Value: "P00001"}}
+
+			testWardController.AdmitPatient(ctx)
+
+			require.Equal(t, tt.wantStatusCode, w.Code)
+		})
+	}
+}
+
+func Test_GetWardPatients(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		mockSetup      func(svc *mock.MockWardService)
+		wantStatusCode int
+	}{
+		{
+			name: "성공",
+			mockSetup: func(svc *mock.MockWardService) {
+				svc.EXPECT().
+					GetWardPatients(gomock.Any(), "ward-1").
+					Return(&ward.GetWardPatientsResponse{WardID: "ward-1", Items: []ward.WardPatientResponse{}}, nil)
+			},
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name: "실패 - 존재하지 않는 병동",
+			mockSetup: func(svc *mock.MockWardService) {
+				svc.EXPECT().
+					GetWardPatients(gomock.Any(), "ward-1").
+					Return(nil, pkgError.WrapWithCode(pkgError.EmptyBusinessError(), pkgError.NotFound))
+			},
+			wantStatusCode: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			beforeEachWard(t)
+			tt.mockSetup(mockWardService)
+
+			w := httptest.NewRecorder()
+			ctx, _ := gin.CreateTestContext(w)
+			ctx.Request = httptest.NewRequest(http.MethodGet, "/api/v1/wards/ward-1/patients", nil)
+			ctx.Params = gin.Params{{Key: "ward_id", Value: "ward-1"}}
+
+			testWardController.GetWardPatients(ctx)
+
+			require.Equal(t, tt.wantStatusCode, w.Code)
+		})
+	}
+}
@@ -2,22 +2,31 @@ package external
 
 import (
 	"aitrics-vital-signs/api-server/domain"
+	"aitrics-vital-signs/api-server/domain/apikey"
+	"aitrics-vital-signs/api-server/domain/audit"
+	"aitrics-vital-signs/api-server/domain/encounter"
+	"aitrics-vital-signs/api-server/domain/idempotency"
 	"aitrics-vital-signs/api-server/domain/patient"
 	"aitrics-vital-signs/api-server/domain/vital"
+	"aitrics-vital-signs/api-server/domain/ward"
+	"aitrics-vital-signs/api-server/internal/metrics"
+	"aitrics-vital-signs/api-server/internal/tracing"
 	"aitrics-vital-signs/library/envs"
 	pkgLogger "aitrics-vital-signs/library/logger"
+	"context"
+	"database/sql"
 	"fmt"
+	"net"
 	"time"
 
+	mysqlDriver "github.com/go-sql-driver/mysql"
 	"gorm.io/driver/mysql"
 	"gorm.io/gorm"
+	"gorm.io/plugin/dbresolver"
 )
 
-const (
-	maxOpenConnNum  = 25
-	maxIdleConnNum  = 20
-	connMaxLifetime = 5 * time.Minute
-)
+// replicaPingTimeout replica health check 의 최대 대기 시간 (interval 이 더 짧으면 interval 사용)
+const replicaPingTimeout = 2 * time.Second
 
 type externalDB struct {
 	mysql *gorm.DB
@@ -27,13 +36,45 @@ func (e *externalDB) MySQL() *gorm.DB {
 	return e.mysql
 }
 
-func MustExternalDB() domain.ExternalDBClient {
-	// Create the DSN (Data Source Name)
-	dsn := fmt.Sprintf("%s:%s@tcp(%s:%s)/%s?charset=utf8mb4&parseTime=True&loc=UTC",
-		envs.DBUser, envs.DBPassword, envs.DBHost, envs.DBPort, envs.DBName, // Database Info
+// dsn 추가 옵션은 "&key=value" 형식으로 전달합니다.
+func dsn(options string) string {
+	return fmt.Sprintf("%s:%s@tcp(%s)/%s?charset=utf8mb4&parseTime=True&loc=UTC%s",
+		envs.DBUser, envs.DBPassword, net.JoinHostPort(envs.DBHost, envs.DBPort), envs.DBName, // Database Info
+		options,
 	)
+}
+
+// replicaConfig
+// replica DSN 을 파싱하고 primary 와 같은 charset / parseTime / loc 를 강제합니다.
+// 계정은 DSN 에 적힌 값을 사용하므로 replica 에는 읽기 전용 계정을 사용할 수 있습니다.
+func replicaConfig(dsn string) (*mysqlDriver.Config, error) {
+	cfg, err := mysqlDriver.ParseDSN(dsn)
+	if err != nil {
+		return nil, err
+	}
+	cfg.ParseTime = true
+	cfg.Loc = time.UTC
+	if cfg.Params == nil {
+		cfg.Params = map[string]string{}
+	}
+	if _, ok := cfg.Params["charset"]; !ok {
+		cfg.Params["charset"] = "utf8mb4"
+	}
+	return cfg, nil
+}
+
+// configurePool db.max_open_conns 등 connection pool 설정을 적용합니다. (primary / replica 공통)
+func configurePool(sqlDB *sql.DB) {
+	sqlDB.SetMaxOpenConns(envs.DBMaxOpenConns)
+	sqlDB.SetMaxIdleConns(envs.DBMaxIdleConns)
+	sqlDB.SetConnMaxLifetime(time.Duration(envs.DBConnMaxLifetimeSeconds) * time.Second)
+	sqlDB.SetConnMaxIdleTime(time.Duration(envs.DBConnMaxIdleTimeSeconds) * time.Second)
+}
+
+// MustExternalDB ctx 가 취소되면 replica health check 도 중단됩니다.
+func MustExternalDB(ctx context.Context) domain.ExternalDBClient {
 	// Initialize GORM DB connection
-	db, err := gorm.Open(mysql.Open(dsn), &gorm.Config{
+	db, err := gorm.Open(mysql.Open(dsn("")), &gorm.Config{
 		SkipDefaultTransaction: true,
 		Logger:                 pkgLogger.ZapLogger.GormLogger,
 	})
@@ -41,20 +82,119 @@ func MustExternalDB() domain.ExternalDBClient {
 		pkgLogger.ZapLogger.Logger.Sugar().Fatalf("failed to connect to database: %v", err)
 	}
 
+	// query 실행 시간 metric / span 수집
+	if err := db.Use(metrics.GormPlugin{}); err != nil {
+		pkgLogger.ZapLogger.Logger.Sugar().Fatalf("failed to register metrics plugin: %v", err)
+	}
+	if err := db.Use(tracing.GormPlugin{}); err != nil {
+		pkgLogger.ZapLogger.Logger.Sugar().Fatalf("failed to register tracing plugin: %v", err)
+	}
+
 	// Configure connection pool
 	sqlDB, err := db.DB()
 	if err != nil {
 		pkgLogger.ZapLogger.Logger.Sugar().Fatalf("failed to get database object: %v", err)
 	}
-	sqlDB.SetMaxOpenConns(maxOpenConnNum)
-	sqlDB.SetMaxIdleConns(maxIdleConnNum)
-	sqlDB.SetConnMaxLifetime(connMaxLifetime)
+	configurePool(sqlDB)
+
+	if len(envs.DBReplicas) > 0 {
+		if err := useReplicas(ctx, db, sqlDB, envs.DBReplicas); err != nil {
+			pkgLogger.ZapLogger.Logger.Sugar().Fatalf("failed to register db replicas: %v", err)
+		}
+	}
 
-	if err := db.AutoMigrate(patient.Patient{}, vital.Vital{}); err != nil {
-		pkgLogger.ZapLogger.Logger.Sugar().Fatalf("failed to migrate database: %v", err)
+	// 스키마는 migrate 명령으로 관리하며, AutoMigrate 는 개발 환경에서만 opt-in 으로 사용합니다.
+	if envs.DBAutoMigrate {
+		pkgLogger.ZapLogger.Logger.Warn("DB_AUTO_MIGRATE is enabled, do not use in production")
+		if err := db.AutoMigrate(patient.Patient{}, patient.PatientMerge{}, patient.PatientMergeVital{}, vital.Vital{}, apikey.APIKey{}, ward.Ward{}, ward.Bed{}, ward.BedAssignment{}, encounter.Encounter{}, audit.AuditEvent{}, idempotency.IdempotencyKey{}); err != nil {
+			pkgLogger.ZapLogger.Logger.Sugar().Fatalf("failed to migrate database: %v", err)
+		}
 	}
 
 	pkgLogger.ZapLogger.Logger.Info("MySQL connection established successfully!")
 
 	return &externalDB{mysql: db}
 }
+
+// useReplicas
+// 트랜잭션 밖의 읽기를 replica 로 보내고, 쓰기 / 잠금 읽기 / 트랜잭션은 primary 에서 처리합니다.
+// replica 는 health check 에 실패하면 읽기 대상에서 제외되며, 모두 제외되면 primary 에서 읽습니다.
+// DSN 에는 password 가 포함되므로 로그 / metric 에는 주소만 남깁니다.
+func useReplicas(ctx context.Context, db *gorm.DB, primary *sql.DB, dsns []string) error {
+	interval := time.Duration(envs.DBReplicaHealthCheckIntervalSeconds) * time.Second
+	timeout := min(interval, replicaPingTimeout)
+
+	replicas := make([]*replica, 0, len(dsns))
+	addrs := make([]string, 0, len(dsns))
+	for idx, replicaDSN := range dsns {
+		cfg, err := replicaConfig(replicaDSN)
+		if err != nil {
+			return fmt.Errorf("db.replicas[%d]: invalid dsn", idx)
+		}
+		sqlDB, err := sql.Open("mysql", cfg.FormatDSN())
+		if err != nil {
+			return err
+		}
+		configurePool(sqlDB)
+		if err := metrics.RegisterDBStats(sqlDB, cfg.DBName+"@"+cfg.Addr); err != nil {
+			pkgLogger.ZapLogger.Logger.Warn("fail to register replica db stats metrics: " + err.Error())
+		}
+
+		r := &replica{addr: cfg.Addr, db: sqlDB}
+		// 기동 시점에 내려가 있는 replica 는 처음부터 제외합니다.
+		r.check(ctx, timeout)
+		replicas = append(replicas, r)
+		addrs = append(addrs, cfg.Addr)
+	}
+
+	if err := registerReplicas(db, primary, replicas); err != nil {
+		return err
+	}
+	go monitorReplicas(ctx, replicas, interval, timeout)
+
+	pkgLogger.ZapLogger.Logger.Sugar().Infof("db replicas registered: %v", addrs)
+	return nil
+}
+
+// registerReplicas dbresolver 와 read-your-writes 기록 plugin 을 등록합니다.
+func registerReplicas(db *gorm.DB, primary *sql.DB, replicas []*replica) error {
+	policy := &replicaPolicy{primary: primary, replicas: make(map[*sql.DB]*replica, len(replicas))}
+	dialectors := make([]gorm.Dialector, 0, len(replicas)+1)
+	for _, r := range replicas {
+		policy.replicas[r.db] = r
+		// version 조회를 생략해 replica 가 내려가 있어도 기동할 수 있도록 합니다.
+		dialectors = append(dialectors, mysql.New(mysql.Config{Conn: r.db, SkipInitializeWithVersion: true}))
+	}
+	dialectors = append(dialectors, mysql.New(mysql.Config{Conn: primary, SkipInitializeWithVersion: true}))
+
+	if err := db.Use(dbresolver.Register(dbresolver.Config{Replicas: dialectors, Policy: policy})); err != nil {
+		return err
+	}
+	return db.Use(markWrittenPlugin{})
+}
+
+// MustMigrationDB
+// migration 파일은 여러 statement 로 구성되므로 multiStatements 옵션을 켠 별도 연결을 사용합니다.
+func MustMigrationDB() *sql.DB {
+	db, err := sql.Open("mysql", dsn("&multiStatements=true"))
+	if err != nil {
+		pkgLogger.ZapLogger.Logger.Sugar().Fatalf("failed to open migration database: %v", err)
+	}
+
+	if err := db.Ping(); err != nil {
+		pkgLogger.ZapLogger.Logger.Sugar().Fatalf("failed to connect to migration database: %v", err)
+	}
+
+	return db
+}
+
+// PingDB 연결 가능 여부만 확인합니다. (check-config 등 실패 시 종료하지 않아야 하는 곳에서 사용)
+func PingDB(ctx context.Context) error {
+	db, err := sql.Open("mysql", dsn(""))
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	return db.PingContext(ctx)
+}
@@ -0,0 +1,118 @@
+package external
+
+import (
+	"aitrics-vital-signs/api-server/domain"
+	"aitrics-vital-signs/api-server/internal/metrics"
+	pkgLogger "aitrics-vital-signs/library/logger"
+	"context"
+	"database/sql"
+	"math/rand/v2"
+	"sync/atomic"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// replica 읽기 전용 연결과 마지막 health check 결과
+type replica struct {
+	addr    string
+	db      *sql.DB
+	healthy atomic.Bool
+}
+
+// replicaPolicy
+// dbresolver 가 고른 읽기 연결 중 정상 replica 하나를 무작위로 사용하고, 모두 비정상이면 primary 로 failover 합니다.
+// dbresolver 는 replica 가 하나면 policy 를 거치지 않으므로 primary 를 마지막 replica 로 함께 등록합니다.
+type replicaPolicy struct {
+	primary  *sql.DB
+	replicas map[*sql.DB]*replica
+}
+
+func (p *replicaPolicy) Resolve(connPools []gorm.ConnPool) gorm.ConnPool {
+	healthy := make([]gorm.ConnPool, 0, len(connPools))
+	for _, connPool := range connPools {
+		sqlDB, ok := connPool.(*sql.DB)
+		if !ok || sqlDB == p.primary {
+			continue
+		}
+		if r, ok := p.replicas[sqlDB]; ok && r.healthy.Load() {
+			healthy = append(healthy, connPool)
+		}
+	}
+
+	if len(healthy) == 0 {
+		return p.primary
+	}
+	return healthy[rand.IntN(len(healthy))]
+}
+
+// check replica 에 ping 하고 상태가 바뀐 경우에만 로그를 남깁니다.
+func (r *replica) check(ctx context.Context, timeout time.Duration) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	err := r.db.PingContext(ctx)
+	healthy := err == nil
+	if healthy {
+		metrics.DBReplicaUp.WithLabelValues(r.addr).Set(1)
+	} else {
+		metrics.DBReplicaUp.WithLabelValues(r.addr).Set(0)
+	}
+
+	if r.healthy.Swap(healthy) == healthy {
+		return
+	}
+	if healthy {
+		pkgLogger.ZapLogger.Logger.Info("db replica is healthy, routing reads to it: " + r.addr)
+	} else {
+		pkgLogger.ZapLogger.Logger.Warn("db replica is unhealthy, excluded from reads: " + r.addr + ": " + err.Error())
+	}
+}
+
+// monitorReplicas interval 마다 모든 replica 를 확인합니다. (ctx 가 취소되면 종료)
+// replica 를 순서대로 확인하므로 응답 없는 replica 가 다른 replica 의 상태 갱신을 늦추지 않도록 timeout 을 짧게 유지합니다.
+func monitorReplicas(ctx context.Context, replicas []*replica, interval, timeout time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			for _, r := range replicas {
+				r.check(ctx, timeout)
+			}
+		}
+	}
+}
+
+// markWrittenPlugin 쓰기 이후 같은 요청의 읽기가 replica 지연으로 이전 값을 보지 않도록 context 에 기록합니다.
+type markWrittenPlugin struct{}
+
+func (markWrittenPlugin) Name() string {
+	return "mark_written"
+}
+
+func (markWrittenPlugin) Initialize(db *gorm.DB) error {
+	callback := db.Callback()
+	registrations := []func(string, func(*gorm.DB)) error{
+		callback.Create().Before("gorm:create").Register,
+		callback.Update().Before("gorm:update").Register,
+		callback.Delete().Before("gorm:delete").Register,
+		callback.Raw().Before("gorm:raw").Register, // Exec
+	}
+
+	for _, register := range registrations {
+		if err := register("mark_written", markWritten); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func markWritten(db *gorm.DB) {
+	if db.Statement.Context != nil {
+		domain.MarkWritten(db.Statement.Context)
+	}
+}
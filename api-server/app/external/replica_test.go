@@ -0,0 +1,223 @@
+package external
+
+import (
+	"aitrics-vital-signs/api-server/domain"
+	pkgLogger "aitrics-vital-signs/library/logger"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+	"gorm.io/plugin/dbresolver"
+)
+
+func newReplica(t *testing.T, addr string, healthy bool) *replica {
+	sqlDB, _, err := sqlmock.New()
+	require.NoError(t, err)
+
+	r := &replica{addr: addr, db: sqlDB}
+	r.healthy.Store(healthy)
+	return r
+}
+
+func Test_replicaPolicy_Resolve(t *testing.T) {
+	primary, _, err := sqlmock.New()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		healthy []bool
+		want    []int // 선택될 수 있는 replica index (비어 있으면 primary)
+	}{
+		{name: "성공 - 정상 replica 중에서 선택", healthy: []bool{true, true}, want: []int{0, 1}},
+		{name: "성공 - 비정상 replica 는 제외", healthy: []bool{false, true}, want: []int{1}},
+		{name: "성공 - 모두 비정상이면 primary 로 failover", healthy: []bool{false, false}},
+		{name: "성공 - 단일 replica 가 비정상이면 primary 로 failover", healthy: []bool{false}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policy := &replicaPolicy{primary: primary, replicas: map[*sql.DB]*replica{}}
+			connPools := make([]gorm.ConnPool, 0, len(tt.healthy)+1)
+			for i, healthy := range tt.healthy {
+				r := newReplica(t, fmt.Sprintf("replica-%d:3306", i+1), healthy)
+				policy.replicas[r.db] = r
+				connPools = append(connPools, r.db)
+			}
+			// useReplicas 와 같이 primary 를 마지막에 등록
+			connPools = append(connPools, primary)
+
+			for range 10 {
+				got := policy.Resolve(connPools)
+				if len(tt.want) == 0 {
+					require.Equal(t, primary, got)
+					continue
+				}
+
+				var candidates []gorm.ConnPool
+				for _, i := range tt.want {
+					candidates = append(candidates, connPools[i])
+				}
+				require.Contains(t, candidates, got)
+			}
+		})
+	}
+}
+
+func Test_replica_check(t *testing.T) {
+	pkgLogger.MustInitStderrZapLogger()
+
+	sqlDB, mockSQL, err := sqlmock.New(sqlmock.MonitorPingsOption(true))
+	require.NoError(t, err)
+	r := &replica{addr: "replica-1:3306", db: sqlDB}
+
+	mockSQL.ExpectPing()
+	r.check(context.Background(), time.Second)
+	require.True(t, r.healthy.Load())
+
+	mockSQL.ExpectPing().WillReturnError(errors.New("connection refused"))
+	r.check(context.Background(), time.Second)
+	require.False(t, r.healthy.Load())
+
+	mockSQL.ExpectPing()
+	r.check(context.Background(), time.Second)
+	require.True(t, r.healthy.Load())
+	require.NoError(t, mockSQL.ExpectationsWereMet())
+}
+
+func Test_registerReplicas(t *testing.T) {
+	primaryDB, primarySQL, err := sqlmock.New()
+	require.NoError(t, err)
+	replicaDB, replicaSQL, err := sqlmock.New()
+	require.NoError(t, err)
+
+	db, err := gorm.Open(mysql.New(mysql.Config{Conn: primaryDB, SkipInitializeWithVersion: true}), &gorm.Config{SkipDefaultTransaction: true})
+	require.NoError(t, err)
+
+	r := &replica{addr: "replica-1:3306", db: replicaDB}
+	r.healthy.Store(true)
+	require.NoError(t, registerReplicas(db, primaryDB, []*replica{r}))
+
+	rows := func() *sqlmock.Rows { return sqlmock.NewRows([]string{"name"}).AddRow("홍길동") }
+	var name string
+
+	t.Run("성공 - 읽기는 replica", func(t *testing.T) {
+		replicaSQL.ExpectQuery("SELECT name FROM patients").WillReturnRows(rows())
+		require.NoError(t, db.WithContext(context.Background()).Raw("SELECT name FROM patients").Scan(&name).Error)
+	})
+
+	t.Run("성공 - read-your-writes 요청의 읽기는 primary", func(t *testing.T) {
+		ctx := domain.WithReadRoute(context.Background(), true)
+		primarySQL.ExpectQuery("SELECT name FROM patients").WillReturnRows(rows())
+		require.NoError(t, db.WithContext(ctx).Clauses(dbresolver.Write).Raw("SELECT name FROM patients").Scan(&name).Error)
+	})
+
+	t.Run("성공 - 쓰기는 primary 이고 이후 읽기 경로를 primary 로 기록", func(t *testing.T) {
+		ctx := domain.WithReadRoute(context.Background(), false)
+		primarySQL.ExpectExec("UPDATE patients").WillReturnResult(sqlmock.NewResult(0, 1))
+		require.NoError(t, db.WithContext(ctx).Exec("UPDATE patients SET name = ?", "홍길동").Error)
+		require.True(t, domain.ReadFromPrimary(ctx))
+	})
+
+	t.Run("성공 - replica 가 비정상이면 primary 로 failover", func(t *testing.T) {
+		r.healthy.Store(false)
+		defer r.healthy.Store(true)
+
+		primarySQL.ExpectQuery("SELECT name FROM patients").WillReturnRows(rows())
+		require.NoError(t, db.WithContext(context.Background()).Raw("SELECT name FROM patients").Scan(&name).Error)
+	})
+
+	require.NoError(t, primarySQL.ExpectationsWereMet())
+	require.NoError(t, replicaSQL.ExpectationsWereMet())
+}
+
+func Test_monitorReplicas(t *testing.T) {
+	t.Run("성공 - context 취소 시 종료", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		done := make(chan struct{})
+		go func() {
+			monitorReplicas(ctx, []*replica{newReplica(t, "replica-1:3306", true)}, time.Hour, time.Second)
+			close(done)
+		}()
+
+		cancel()
+		select {
+		case <-done:
+		case <-time.After(time.Second):
+			t.Fatal("monitorReplicas did not stop after context cancel")
+		}
+	})
+}
+
+func Test_monitorReplicas_PingTimeout(t *testing.T) {
+	pkgLogger.MustInitStderrZapLogger()
+
+	// 응답 없는 replica 는 timeout 후 제외되고, 다음 replica 의 상태 갱신은 interval 만큼 지연되지 않음
+	hungDB, hungSQL, err := sqlmock.New(sqlmock.MonitorPingsOption(true))
+	require.NoError(t, err)
+	hungSQL.ExpectPing().WillDelayFor(5 * time.Second)
+	hung := &replica{addr: "replica-1:3306", db: hungDB}
+	hung.healthy.Store(true)
+
+	okDB, okSQL, err := sqlmock.New(sqlmock.MonitorPingsOption(true))
+	require.NoError(t, err)
+	okSQL.ExpectPing()
+	ok := &replica{addr: "replica-2:3306", db: okDB}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	interval := 200 * time.Millisecond
+	go monitorReplicas(ctx, []*replica{hung, ok}, interval, 10*time.Millisecond)
+
+	require.Eventually(t, ok.healthy.Load, interval+interval*9/10, 5*time.Millisecond)
+	require.False(t, hung.healthy.Load())
+}
+
+func Test_replicaConfig(t *testing.T) {
+	tests := []struct {
+		name    string
+		dsn     string
+		charset string
+		wantErr bool
+	}{
+		{
+			name:    "성공 - 읽기 전용 계정 / 옵션 강제",
+			dsn:     "reader:secret@tcp(replica-1:3306)/aitrics_db?parseTime=false&loc=Asia%2FSeoul",
+			charset: "utf8mb4",
+		},
+		{
+			name:    "성공 - 지정한 charset 유지",
+			dsn:     "reader:secret@tcp(replica-1:3306)/aitrics_db?charset=utf8",
+			charset: "utf8",
+		},
+		{
+			name:    "실패 - 잘못된 DSN",
+			dsn:     "replica-1:3306",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := replicaConfig(tt.dsn)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, "reader", cfg.User)
+			require.Equal(t, "replica-1:3306", cfg.Addr)
+			require.Equal(t, "aitrics_db", cfg.DBName)
+			require.True(t, cfg.ParseTime)
+			require.Equal(t, time.UTC, cfg.Loc)
+			require.Equal(t, tt.charset, cfg.Params["charset"])
+		})
+	}
+}
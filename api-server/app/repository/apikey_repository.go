@@ -0,0 +1,81 @@
+package repository
+
+import (
+	"aitrics-vital-signs/api-server/domain"
+	"aitrics-vital-signs/api-server/domain/apikey"
+	pkgError "aitrics-vital-signs/library/error"
+	"context"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type apiKeyRepository struct {
+	externalGormClient domain.ExternalDBClient
+}
+
+func (a *apiKeyRepository) CreateAPIKey(ctx context.Context, model *apikey.APIKey) error {
+	return wrapWriteError(conn(ctx, a.externalGormClient).Create(model).Error, pkgError.Create)
+}
+
+func (a *apiKeyRepository) FindAPIKeyByID(ctx context.Context, id string) (*apikey.APIKey, error) {
+	var result apikey.APIKey
+	if err := conn(ctx, a.externalGormClient).
+		Where("id = ?", id).
+		First(&result).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, pkgError.WrapWithCode(err, pkgError.NotFound)
+		}
+		return nil, pkgError.WrapWithCode(err, pkgError.Get)
+	}
+	return &result, nil
+}
+
+func (a *apiKeyRepository) FindAPIKeyByHash(ctx context.Context, keyHash string) (*apikey.APIKey, error) {
+	var result apikey.APIKey
+	if err := conn(ctx, a.externalGormClient).
+		Where("key_hash = ?", keyHash).
+		First(&result).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, pkgError.WrapWithCode(err, pkgError.NotFound)
+		}
+		return nil, pkgError.WrapWithCode(err, pkgError.Get)
+	}
+	return &result, nil
+}
+
+func (a *apiKeyRepository) FindAPIKeys(ctx context.Context) ([]apikey.APIKey, error) {
+	var results []apikey.APIKey
+	if err := conn(ctx, a.externalGormClient).
+		Order("created_at DESC").
+		Find(&results).Error; err != nil {
+		return nil, pkgError.WrapWithCode(err, pkgError.Get)
+	}
+	return results, nil
+}
+
+func (a *apiKeyRepository) RevokeAPIKey(ctx context.Context, id string, revokedAt time.Time) error {
+	result := conn(ctx, a.externalGormClient).
+		Model(&apikey.APIKey{}).
+		Where("id = ? AND revoked_at IS NULL", id).
+		Updates(map[string]interface{}{
+			"revoked_at": revokedAt,
+			"updated_at": revokedAt,
+		})
+
+	if result.Error != nil {
+		return wrapWriteError(result.Error, pkgError.Update)
+	}
+
+	// 이미 폐기되었거나 존재하지 않는 키
+	if result.RowsAffected == 0 {
+		return pkgError.WrapWithCode(pkgError.EmptyBusinessError(), pkgError.NotFound, "api key not found or already revoked")
+	}
+
+	return nil
+}
+
+func NewAPIKeyRepository(externalGormClient domain.ExternalDBClient) apikey.APIKeyRepository {
+	return &apiKeyRepository{externalGormClient: externalGormClient}
+}
@@ -0,0 +1,144 @@
+package repository
+
+import (
+	"aitrics-vital-signs/api-server/domain/apikey"
+	"aitrics-vital-signs/api-server/domain/mock"
+	pkgError "aitrics-vital-signs/library/error"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+)
+
+var apiKeyRepo apikey.APIKeyRepository
+var apiKeySQLMock sqlmock.Sqlmock
+
+func beforeEachAPIKey(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockExternalDBClient := mock.NewMockExternalDBClient(ctrl)
+
+	sqlDB, mockSQL, err := sqlmock.New()
+	require.NoError(t, err)
+
+	dial := mysql.New(mysql.Config{
+		Conn:                      sqlDB,
+		SkipInitializeWithVersion: true,
+	})
+	db, err := gorm.Open(dial, &gorm.Config{})
+	require.NoError(t, err)
+
+	mockExternalDBClient.EXPECT().MySQL().Return(db).AnyTimes()
+	apiKeyRepo = NewAPIKeyRepository(mockExternalDBClient)
+	apiKeySQLMock = mockSQL
+}
+
+func Test_CreateAPIKey(t *testing.T) {
+	beforeEachAPIKey(t)
+
+	apiKeySQLMock.ExpectBegin()
+	apiKeySQLMock.ExpectExec("INSERT INTO .*api_keys.*").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	apiKeySQLMock.ExpectCommit()
+
+	err := apiKeyRepo.CreateAPIKey(context.Background(), &apikey.APIKey{
+		ID:        "key-1",
+		Name:      "bedside-gateway",
+		Prefix:    "avs_12345678",
+		KeyHash:   "hash",
+		Scopes:    "vitals:write",
+		CreatedAt: time.Now().UTC(),
+	})
+	require.NoError(t, err)
+}
+
+func Test_FindAPIKeyByHash(t *testing.T) {
+	tests := []struct {
+		name        string
+		setupMock   func()
+		wantErr     bool
+		expectedErr error
+	}{
+		{
+			name: "성공 - API Key 조회",
+			setupMock: func() {
+				rows := sqlmock.NewRows([]string{"id", "name", "prefix", "key_hash", "scopes", "created_at"}).
+					AddRow("key-1", "bedside-gateway", "avs_12345678", "hash", "vitals:write,patients:read", time.Now().UTC())
+				apiKeySQLMock.ExpectQuery("SELECT .* FROM .*api_keys.*").
+					WithArgs("hash", 1).
+					WillReturnRows(rows)
+			},
+		},
+		{
+			name: "실패 - API Key 없음",
+			setupMock: func() {
+				apiKeySQLMock.ExpectQuery("SELECT .* FROM .*api_keys.*").
+					WithArgs("hash", 1).
+					WillReturnError(gorm.ErrRecordNotFound)
+			},
+			wantErr:     true,
+			expectedErr: pkgError.WrapWithCode(gorm.ErrRecordNotFound, pkgError.NotFound),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			beforeEachAPIKey(t)
+			tt.setupMock()
+
+			result, err := apiKeyRepo.FindAPIKeyByHash(context.Background(), "hash")
+
+			if tt.wantErr {
+				require.Error(t, err)
+				require.Nil(t, result)
+				expectedBE, _ := pkgError.CastBusinessError(tt.expectedErr)
+				actualBE, _ := pkgError.CastBusinessError(err)
+				require.Equal(t, expectedBE.Status.Code, actualBE.Status.Code)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, []string{"vitals:write", "patients:read"}, result.ScopeList())
+			}
+		})
+	}
+}
+
+func Test_RevokeAPIKey(t *testing.T) {
+	tests := []struct {
+		name         string
+		rowsAffected int64
+		wantErr      bool
+	}{
+		{
+			name:         "성공 - 폐기",
+			rowsAffected: 1,
+		},
+		{
+			name:         "실패 - 이미 폐기되었거나 없는 키",
+			rowsAffected: 0,
+			wantErr:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			beforeEachAPIKey(t)
+
+			apiKeySQLMock.ExpectBegin()
+			apiKeySQLMock.ExpectExec("UPDATE .*api_keys.* WHERE id = .* AND revoked_at IS NULL").
+				WillReturnResult(sqlmock.NewResult(0, tt.rowsAffected))
+			apiKeySQLMock.ExpectCommit()
+
+			err := apiKeyRepo.RevokeAPIKey(context.Background(), "key-1", time.Now().UTC())
+
+			if tt.wantErr {
+				require.True(t, pkgError.CompareBusinessError(err, pkgError.NotFound))
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,88 @@
+package repository
+
+import (
+	"aitrics-vital-signs/api-server/domain"
+	"aitrics-vital-signs/api-server/domain/audit"
+	pkgError "aitrics-vital-signs/library/error"
+	"context"
+
+	"gorm.io/gorm"
+)
+
+type auditRepository struct {
+	externalGormClient domain.ExternalDBClient
+}
+
+func (a *auditRepository) CreateAuditEvents(ctx context.Context, models []audit.AuditEvent) error {
+	if len(models) == 0 {
+		return nil
+	}
+	return wrapWriteError(conn(ctx, a.externalGormClient).Create(&models).Error, pkgError.Create)
+}
+
+func (a *auditRepository) FindAuditEvents(ctx context.Context, param audit.FindAuditEventsParam) ([]audit.AuditEvent, error) {
+	query := applyAuditEventFilter(conn(ctx, a.externalGormClient).Model(&audit.AuditEvent{}), param.PatientID, param.PrincipalID)
+
+	if param.From != nil {
+		query = query.Where("occurred_at >= ?", *param.From)
+	}
+
+	if param.To != nil {
+		query = query.Where("occurred_at <= ?", *param.To)
+	}
+
+	if param.BeforeID > 0 {
+		query = query.Where("id < ?", param.BeforeID)
+	}
+
+	var results []audit.AuditEvent
+	if err := query.Order("id DESC").Limit(param.Limit).Find(&results).Error; err != nil {
+		return nil, pkgError.WrapWithCode(err, pkgError.Get)
+	}
+	return results, nil
+}
+
+func (a *auditRepository) StreamAuditEvents(ctx context.Context, param audit.StreamAuditEventsParam, fn func(*audit.AuditEvent) error) error {
+	db := conn(ctx, a.externalGormClient)
+	query := applyAuditEventFilter(db.Model(&audit.AuditEvent{}), param.PatientID, param.PrincipalID).
+		Where("occurred_at >= ? AND occurred_at <= ?", param.From, param.To)
+
+	rows, err := query.Order("id ASC").Rows()
+	if err != nil {
+		return pkgError.WrapWithCode(err, pkgError.Get)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var result audit.AuditEvent
+		if err := db.ScanRows(rows, &result); err != nil {
+			return pkgError.WrapWithCode(err, pkgError.Get)
+		}
+
+		if err := fn(&result); err != nil {
+			return pkgError.Wrap(err)
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return pkgError.WrapWithCode(err, pkgError.Get)
+	}
+
+	return nil
+}
+
+func applyAuditEventFilter(query *gorm.DB, patientID, principalID string) *gorm.DB {
+	if patientID != "" {
+		query = query.Where("patient_id = ?", patientID)
+	}
+
+	if principalID != "" {
+		query = query.Where("principal_id = ?", principalID)
+	}
+
+	return query
+}
+
+func NewAuditRepository(externalGormClient domain.ExternalDBClient) audit.AuditRepository {
+	return &auditRepository{externalGormClient: externalGormClient}
+}
@@ -0,0 +1,99 @@
+package repository
+
+import (
+	"aitrics-vital-signs/api-server/domain/audit"
+	"aitrics-vital-signs/api-server/domain/mock"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+)
+
+var auditRepo audit.AuditRepository
+var auditSQLMock sqlmock.Sqlmock
+
+func beforeEachAudit(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockExternalDBClient := mock.NewMockExternalDBClient(ctrl)
+
+	sqlDB, mockSQL, err := sqlmock.New()
+	require.NoError(t, err)
+
+	dial := mysql.New(mysql.Config{
+		Conn:                      sqlDB,
+		SkipInitializeWithVersion: true,
+	})
+	db, err := gorm.Open(dial, &gorm.Config{})
+	require.NoError(t, err)
+
+	mockExternalDBClient.EXPECT().MySQL().Return(db).AnyTimes()
+	auditRepo = NewAuditRepository(mockExternalDBClient)
+	auditSQLMock = mockSQL
+}
+
+func Test_CreateAuditEvents(t *testing.T) {
+	beforeEachAudit(t)
+
+	patientID := "P00001"
+	now := time.Now()
+	events := []audit.AuditEvent{
+		{RequestID: "req-1", PrincipalID: "key-1", Action: "READ", Method: "GET", PatientID: &patientID, Outcome: "SUCCESS", StatusCode: 200, OccurredAt: now},
+		{RequestID: "req-1", PrincipalID: "key-1", Action: "READ", Method: "GET", Outcome: "SUCCESS", StatusCode: 200, OccurredAt: now},
+	}
+
+	auditSQLMock.ExpectBegin()
+	auditSQLMock.ExpectExec("INSERT INTO `audit_events`").WillReturnResult(sqlmock.NewResult(1, 2))
+	auditSQLMock.ExpectCommit()
+
+	err := auditRepo.CreateAuditEvents(context.Background(), events)
+	require.NoError(t, err)
+	require.NoError(t, auditSQLMock.ExpectationsWereMet())
+}
+
+func Test_FindAuditEvents(t *testing.T) {
+	from := time.Date(2025, 12, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		param     audit.FindAuditEventsParam
+		setupMock func()
+	}{
+		{
+			name:  "성공 - 환자 / 기간 / cursor 조건",
+			param: audit.FindAuditEventsParam{PatientID: "P00001", From: &from, BeforeID: 10, Limit: 3},
+			setupMock: func() {
+				rows := sqlmock.NewRows([]string{"id", "patient_id"}).AddRow(9, "P00001")
+				auditSQLMock.ExpectQuery("SELECT .* FROM `audit_events` WHERE patient_id = .* AND occurred_at >= .* AND id < .* ORDER BY id DESC LIMIT").
+					WithArgs("P00001", from, 10, 3).
+					WillReturnRows(rows)
+			},
+		},
+		{
+			name:  "성공 - principal 조건",
+			param: audit.FindAuditEventsParam{PrincipalID: "key-1", Limit: 3},
+			setupMock: func() {
+				rows := sqlmock.NewRows([]string{"id", "principal_id"}).AddRow(9, "key-1")
+				auditSQLMock.ExpectQuery("SELECT .* FROM `audit_events` WHERE principal_id = .* ORDER BY id DESC LIMIT").
+					WithArgs("key-1", 3).
+					WillReturnRows(rows)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			beforeEachAudit(t)
+			tt.setupMock()
+
+			result, err := auditRepo.FindAuditEvents(context.Background(), tt.param)
+			require.NoError(t, err)
+			require.Len(t, result, 1)
+			require.NoError(t, auditSQLMock.ExpectationsWereMet())
+		})
+	}
+}
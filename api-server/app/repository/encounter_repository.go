@@ -0,0 +1,56 @@
+package repository
+
+import (
+	"aitrics-vital-signs/api-server/domain"
+	"aitrics-vital-signs/api-server/domain/encounter"
+	pkgError "aitrics-vital-signs/library/error"
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+)
+
+type encounterRepository struct {
+	externalGormClient domain.ExternalDBClient
+}
+
+func (e *encounterRepository) FindEncounterByID(ctx context.Context, encounterID string) (*encounter.Encounter, error) {
+	return e.first(conn(ctx, e.externalGormClient).Where("id = ?", encounterID))
+}
+
+func (e *encounterRepository) FindActiveEncounterByPatientID(ctx context.Context, patientID string) (*encounter.Encounter, error) {
+	return e.first(conn(ctx, e.externalGormClient).Where("active_patient_id = ?", patientID))
+}
+
+func (e *encounterRepository) FindEncounterByPatientIDAndTime(ctx context.Context, param encounter.FindEncounterByPatientIDAndTimeParam) (*encounter.Encounter, error) {
+	// 기록 시각을 포함하는 encounter 중 가장 최근에 시작된 encounter
+	return e.first(conn(ctx, e.externalGormClient).
+		Where("patient_id = ? AND admitted_at <= ? AND (discharged_at IS NULL OR discharged_at >= ?)", param.PatientID, param.At, param.At).
+		Order("admitted_at DESC"))
+}
+
+func (e *encounterRepository) FindEncountersByPatientID(ctx context.Context, patientID string) ([]encounter.Encounter, error) {
+	var results []encounter.Encounter
+	if err := conn(ctx, e.externalGormClient).
+		Where("patient_id = ?", patientID).
+		Order("admitted_at DESC").
+		Find(&results).Error; err != nil {
+		return nil, pkgError.WrapWithCode(err, pkgError.Get)
+	}
+	return results, nil
+}
+
+func (e *encounterRepository) first(query *gorm.DB) (*encounter.Encounter, error) {
+	var result encounter.Encounter
+	if err := query.First(&result).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, pkgError.WrapWithCode(err, pkgError.NotFound)
+		}
+		return nil, pkgError.WrapWithCode(err, pkgError.Get)
+	}
+	return &result, nil
+}
+
+func NewEncounterRepository(externalGormClient domain.ExternalDBClient) encounter.EncounterRepository {
+	return &encounterRepository{externalGormClient: externalGormClient}
+}
@@ -0,0 +1,88 @@
+package repository
+
+import (
+	"aitrics-vital-signs/api-server/domain/encounter"
+	"aitrics-vital-signs/api-server/domain/mock"
+	pkgError "aitrics-vital-signs/library/error"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+)
+
+var encounterRepo encounter.EncounterRepository
+var encounterSQLMock sqlmock.Sqlmock
+
+func beforeEachEncounter(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockExternalDBClient := mock.NewMockExternalDBClient(ctrl)
+
+	sqlDB, mockSQL, err := sqlmock.New()
+	require.NoError(t, err)
+
+	dial := mysql.New(mysql.Config{
+		Conn:                      sqlDB,
+		SkipInitializeWithVersion: true,
+	})
+	db, err := gorm.Open(dial, &gorm.Config{})
+	require.NoError(t, err)
+
+	mockExternalDBClient.EXPECT().MySQL().Return(db).AnyTimes()
+	encounterRepo = NewEncounterRepository(mockExternalDBClient)
+	encounterSQLMock = mockSQL
+}
+
+func Test_FindEncounterByPatientIDAndTime(t *testing.T) {
+	at := time.Date(2025, 12, 1, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		setupMock func()
+		wantCode  pkgError.Code
+	}{
+		{
+			name: "성공 - 기록 시각을 포함하는 encounter",
+			setupMock: func() {
+				rows := sqlmock.NewRows([]string{"id", "patient_id", "type", "admitted_at"}).
+					AddRow("enc-1", "P00001", "INPATIENT", at.Add(-time.Hour))
+				encounterSQLMock.ExpectQuery("SELECT .* FROM .*encounters.* WHERE .*admitted_at <= .* AND \\(discharged_at IS NULL OR discharged_at >= .*\\) ORDER BY admitted_at DESC").
+					WithArgs("P00001", at, at, 1).
+					WillReturnRows(rows)
+			},
+		},
+		{
+			name: "실패 - encounter 없음",
+			setupMock: func() {
+				encounterSQLMock.ExpectQuery("SELECT .* FROM .*encounters.*").
+					WithArgs("P00001", at, at, 1).
+					WillReturnError(gorm.ErrRecordNotFound)
+			},
+			wantCode: pkgError.NotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			beforeEachEncounter(t)
+			tt.setupMock()
+
+			result, err := encounterRepo.FindEncounterByPatientIDAndTime(context.Background(), encounter.FindEncounterByPatientIDAndTimeParam{
+				PatientID: "P00001",
+				At:        at,
+			})
+
+			if tt.wantCode != 0 {
+				require.True(t, pkgError.CompareBusinessError(err, tt.wantCode))
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, "enc-1", result.ID)
+			}
+			require.NoError(t, encounterSQLMock.ExpectationsWereMet())
+		})
+	}
+}
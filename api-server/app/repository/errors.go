@@ -0,0 +1,55 @@
+package repository
+
+import (
+	"aitrics-vital-signs/api-server/domain"
+	pkgError "aitrics-vital-signs/library/error"
+	"errors"
+	"regexp"
+	"strings"
+
+	"github.com/go-sql-driver/mysql"
+)
+
+// mysqlErrDuplicateEntry unique / primary key 중복 (ER_DUP_ENTRY)
+const mysqlErrDuplicateEntry = 1062
+
+// duplicateEntryPattern "Duplicate entry 'P00001' for key 'patients.idx_patients_patient_id'"
+// MySQL 8.0.19 미만은 key 에 table 이름이 붙지 않습니다.
+var duplicateEntryPattern = regexp.MustCompile(`^Duplicate entry '(.*)' for key '(.*)'$`)
+
+func isDuplicateKeyError(err error) bool {
+	var mysqlErr *mysql.MySQLError
+	return errors.As(err, &mysqlErr) && mysqlErr.Number == mysqlErrDuplicateEntry
+}
+
+// duplicateResource 중복 오류 메시지에서 key 이름과 이미 존재하는 값을 추출합니다.
+func duplicateResource(err error) (*domain.DuplicateResource, bool) {
+	var mysqlErr *mysql.MySQLError
+	if !errors.As(err, &mysqlErr) || mysqlErr.Number != mysqlErrDuplicateEntry {
+		return nil, false
+	}
+
+	matches := duplicateEntryPattern.FindStringSubmatch(mysqlErr.Message)
+	if matches == nil {
+		return nil, false
+	}
+
+	key := matches[2]
+	if i := strings.LastIndex(key, "."); i >= 0 {
+		key = key[i+1:]
+	}
+	return &domain.DuplicateResource{Key: key, ExistingID: matches[1]}, true
+}
+
+// wrapWriteError key 중복은 Conflict 로 (이미 존재하는 resource 를 data 로 포함), 그 외에는 code 로 감쌉니다.
+func wrapWriteError(err error, code pkgError.Code) error {
+	if !isDuplicateKeyError(err) {
+		return pkgError.WrapWithCode(err, code)
+	}
+
+	wrapped := pkgError.WrapWithCode(err, pkgError.Conflict, "duplicate key")
+	if resource, ok := duplicateResource(err); ok {
+		return pkgError.WithData(wrapped, resource)
+	}
+	return wrapped
+}
@@ -0,0 +1,88 @@
+package repository
+
+import (
+	"aitrics-vital-signs/api-server/domain"
+	"aitrics-vital-signs/api-server/domain/idempotency"
+	pkgError "aitrics-vital-signs/library/error"
+	"context"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type idempotencyRepository struct {
+	externalGormClient domain.ExternalDBClient
+}
+
+func (i *idempotencyRepository) CreateIdempotencyKey(ctx context.Context, model *idempotency.IdempotencyKey) error {
+	if err := conn(ctx, i.externalGormClient).Create(model).Error; err != nil {
+		return wrapWriteError(err, pkgError.Create)
+	}
+	return nil
+}
+
+func (i *idempotencyRepository) FindIdempotencyKey(ctx context.Context, principalID, key string) (*idempotency.IdempotencyKey, error) {
+	var result idempotency.IdempotencyKey
+	if err := conn(ctx, i.externalGormClient).
+		Where("principal_id = ? AND idempotency_key = ?", principalID, key).
+		First(&result).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, pkgError.WrapWithCode(err, pkgError.NotFound)
+		}
+		return nil, pkgError.WrapWithCode(err, pkgError.Get)
+	}
+	return &result, nil
+}
+
+func (i *idempotencyRepository) CompleteIdempotencyKey(ctx context.Context, param idempotency.CompleteIdempotencyKeyParam) error {
+	result := conn(ctx, i.externalGormClient).
+		Model(&idempotency.IdempotencyKey{}).
+		Where("principal_id = ? AND idempotency_key = ? AND status_code IS NULL", param.PrincipalID, param.Key).
+		Updates(map[string]interface{}{
+			"status_code":   param.StatusCode,
+			"content_type":  param.ContentType,
+			"response_body": param.ResponseBody,
+			"expires_at":    param.ExpiresAt,
+		})
+	if result.Error != nil {
+		return wrapWriteError(result.Error, pkgError.Update)
+	}
+	if result.RowsAffected == 0 {
+		return pkgError.WrapWithCode(pkgError.EmptyBusinessError(), pkgError.NotFound, "idempotency key is not in progress")
+	}
+	return nil
+}
+
+func (i *idempotencyRepository) DeleteIdempotencyKey(ctx context.Context, principalID, key string) error {
+	if err := conn(ctx, i.externalGormClient).
+		Where("principal_id = ? AND idempotency_key = ?", principalID, key).
+		Delete(&idempotency.IdempotencyKey{}).Error; err != nil {
+		return pkgError.WrapWithCode(err, pkgError.Delete)
+	}
+	return nil
+}
+
+func (i *idempotencyRepository) DeleteExpiredIdempotencyKey(ctx context.Context, principalID, key string, before time.Time) (bool, error) {
+	result := conn(ctx, i.externalGormClient).
+		Where("principal_id = ? AND idempotency_key = ? AND expires_at < ?", principalID, key, before).
+		Delete(&idempotency.IdempotencyKey{})
+	if result.Error != nil {
+		return false, pkgError.WrapWithCode(result.Error, pkgError.Delete)
+	}
+	return result.RowsAffected > 0, nil
+}
+
+func (i *idempotencyRepository) DeleteExpiredIdempotencyKeys(ctx context.Context, before time.Time) (int64, error) {
+	result := conn(ctx, i.externalGormClient).
+		Where("expires_at < ?", before).
+		Delete(&idempotency.IdempotencyKey{})
+	if result.Error != nil {
+		return 0, pkgError.WrapWithCode(result.Error, pkgError.Delete)
+	}
+	return result.RowsAffected, nil
+}
+
+func NewIdempotencyRepository(externalGormClient domain.ExternalDBClient) idempotency.IdempotencyRepository {
+	return &idempotencyRepository{externalGormClient: externalGormClient}
+}
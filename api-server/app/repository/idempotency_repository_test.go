@@ -0,0 +1,141 @@
+package repository
+
+import (
+	"aitrics-vital-signs/api-server/domain/idempotency"
+	"aitrics-vital-signs/api-server/domain/mock"
+	pkgError "aitrics-vital-signs/library/error"
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	mysqlDriver "github.com/go-sql-driver/mysql"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+)
+
+var idempotencyRepo idempotency.IdempotencyRepository
+var idempotencySQLMock sqlmock.Sqlmock
+
+func beforeEachIdempotency(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockExternalDBClient := mock.NewMockExternalDBClient(ctrl)
+
+	sqlDB, mockSQL, err := sqlmock.New()
+	require.NoError(t, err)
+
+	dial := mysql.New(mysql.Config{
+		Conn:                      sqlDB,
+		SkipInitializeWithVersion: true,
+	})
+	db, err := gorm.Open(dial, &gorm.Config{})
+	require.NoError(t, err)
+
+	mockExternalDBClient.EXPECT().MySQL().Return(db).AnyTimes()
+	idempotencyRepo = NewIdempotencyRepository(mockExternalDBClient)
+	idempotencySQLMock = mockSQL
+}
+
+func Test_CreateIdempotencyKey(t *testing.T) {
+	tests := []struct {
+		name     string
+		execErr  error
+		wantCode pkgError.Code
+	}{
+		{name: "성공 - key 등록"},
+		{
+			name:     "실패 - 이미 존재하는 key 는 Conflict",
+			execErr:  &mysqlDriver.MySQLError{Number: mysqlErrDuplicateEntry, Message: "Duplicate entry"},
+			wantCode: pkgError.Conflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			beforeEachIdempotency(t)
+
+			idempotencySQLMock.ExpectBegin()
+			exec := idempotencySQLMock.ExpectExec("INSERT INTO .*idempotency_keys.*")
+			if tt.execErr != nil {
+				exec.WillReturnError(tt.execErr)
+				idempotencySQLMock.ExpectRollback()
+			} else {
+				exec.WillReturnResult(sqlmock.NewResult(0, 1))
+				idempotencySQLMock.ExpectCommit()
+			}
+
+			now := time.Now().UTC()
+			err := idempotencyRepo.CreateIdempotencyKey(context.Background(), &idempotency.IdempotencyKey{
+				PrincipalID: "key-1",
+				Key:         "req-1",
+				Method:      http.MethodPost,
+				Path:        "/api/v1/vitals",
+				RequestHash: "hash",
+				CreatedAt:   now,
+				ExpiresAt:   now.Add(time.Minute),
+			})
+			if tt.wantCode != 0 {
+				require.True(t, pkgError.CompareBusinessError(err, tt.wantCode), err)
+			} else {
+				require.NoError(t, err)
+			}
+			require.NoError(t, idempotencySQLMock.ExpectationsWereMet())
+		})
+	}
+}
+
+func Test_CompleteIdempotencyKey(t *testing.T) {
+	tests := []struct {
+		name     string
+		affected int64
+		wantCode pkgError.Code
+	}{
+		{name: "성공 - 응답 저장", affected: 1},
+		{name: "실패 - 처리 중인 key 가 아님", affected: 0, wantCode: pkgError.NotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			beforeEachIdempotency(t)
+
+			idempotencySQLMock.ExpectBegin()
+			idempotencySQLMock.ExpectExec("UPDATE .*idempotency_keys.* WHERE .*status_code IS NULL").
+				WillReturnResult(sqlmock.NewResult(0, tt.affected))
+			idempotencySQLMock.ExpectCommit()
+
+			err := idempotencyRepo.CompleteIdempotencyKey(context.Background(), idempotency.CompleteIdempotencyKeyParam{
+				PrincipalID:  "key-1",
+				Key:          "req-1",
+				StatusCode:   http.StatusOK,
+				ContentType:  "application/json",
+				ResponseBody: []byte(`{}`),
+				ExpiresAt:    time.Now().UTC().Add(time.Hour),
+			})
+			if tt.wantCode != 0 {
+				require.True(t, pkgError.CompareBusinessError(err, tt.wantCode), err)
+			} else {
+				require.NoError(t, err)
+			}
+			require.NoError(t, idempotencySQLMock.ExpectationsWereMet())
+		})
+	}
+}
+
+func Test_DeleteExpiredIdempotencyKey(t *testing.T) {
+	beforeEachIdempotency(t)
+	now := time.Now().UTC()
+
+	idempotencySQLMock.ExpectBegin()
+	idempotencySQLMock.ExpectExec("DELETE FROM .*idempotency_keys.* WHERE .*expires_at < ").
+		WithArgs("key-1", "req-1", now).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	idempotencySQLMock.ExpectCommit()
+
+	deleted, err := idempotencyRepo.DeleteExpiredIdempotencyKey(context.Background(), "key-1", "req-1", now)
+	require.NoError(t, err)
+	require.True(t, deleted)
+	require.NoError(t, idempotencySQLMock.ExpectationsWereMet())
+}
@@ -2,12 +2,16 @@ package repository
 
 import (
 	"aitrics-vital-signs/api-server/domain"
+	"aitrics-vital-signs/api-server/domain/encounter"
 	"aitrics-vital-signs/api-server/domain/patient"
+	"aitrics-vital-signs/api-server/pkg/constant"
 	pkgError "aitrics-vital-signs/library/error"
 	"context"
 	"errors"
+	"time"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type patientRepository struct {
@@ -15,20 +19,21 @@ type patientRepository struct {
 }
 
 func (p *patientRepository) CreatePatient(ctx context.Context, model *patient.Patient) error {
-	return pkgError.WrapWithCode(p.externalGormClient.MySQL().WithContext(ctx).Create(model).Error, pkgError.Create)
+	return wrapWriteError(conn(ctx, p.externalGormClient).Create(model).Error, pkgError.Create)
 }
 
 func (p *patientRepository) FindPatientByID(ctx context.Context, patientID string) (*patient.Patient, error) {
-	var result patient.Patient
-	if err := p.externalGormClient.MySQL().WithContext(ctx).
-		Where("patient_id = ?", patientID).
-		First(&result).Error; err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, pkgError.WrapWithCode(err, pkgError.NotFound)
-		}
+	return p.findPatient(conn(ctx, p.externalGormClient), patientID)
+}
+
+func (p *patientRepository) FindPatientsByPatientIDs(ctx context.Context, patientIDs []string) ([]patient.Patient, error) {
+	var results []patient.Patient
+	if err := conn(ctx, p.externalGormClient).
+		Where("patient_id IN ?", patientIDs).
+		Find(&results).Error; err != nil {
 		return nil, pkgError.WrapWithCode(err, pkgError.Get)
 	}
-	return &result, nil
+	return results, nil
 }
 
 func (p *patientRepository) UpdatePatient(ctx context.Context, model *patient.Patient) error {
@@ -36,7 +41,7 @@ func (p *patientRepository) UpdatePatient(ctx context.Context, model *patient.Pa
 	// version은 이미 Service layer에서 +1 증가된 상태
 	oldVersion := model.Version - 1
 
-	result := p.externalGormClient.MySQL().WithContext(ctx).
+	result := conn(ctx, p.externalGormClient).
 		Model(&patient.Patient{}).
 		Where("id = ? AND version = ?", model.ID, oldVersion).
 		Updates(map[string]interface{}{
@@ -49,7 +54,7 @@ func (p *patientRepository) UpdatePatient(ctx context.Context, model *patient.Pa
 		})
 
 	if result.Error != nil {
-		return pkgError.WrapWithCode(result.Error, pkgError.Update)
+		return wrapWriteError(result.Error, pkgError.Update)
 	}
 
 	// RowsAffected가 0이면 version conflict
@@ -60,6 +65,380 @@ func (p *patientRepository) UpdatePatient(ctx context.Context, model *patient.Pa
 	return nil
 }
 
+// replacePatientSQL patient_id 로 환자를 등록하거나, 이미 있으면 요청 값으로 교체하고 version 을 올립니다.
+// soft delete 된 환자는 version 1 로 되살리며, version, deleted_at 은 앞의 대입식이 참조하므로 마지막에 변경합니다.
+const replacePatientSQL = `INSERT INTO patients (id, patient_id, name, gender, birth_date, version, created_at, updated_at)
+VALUES (?, ?, ?, ?, ?, 1, ?, ?)
+ON DUPLICATE KEY UPDATE
+	name = VALUES(name),
+	gender = VALUES(gender),
+	birth_date = VALUES(birth_date),
+	created_at = IF(deleted_at IS NOT NULL, VALUES(created_at), created_at),
+	updated_at = VALUES(updated_at),
+	version = IF(deleted_at IS NOT NULL, 1, version + 1),
+	deleted_at = NULL`
+
+func (p *patientRepository) ReplacePatient(ctx context.Context, model *patient.Patient) (bool, error) {
+	result := conn(ctx, p.externalGormClient).Exec(replacePatientSQL,
+		model.ID, model.PatientID, model.Name, model.Gender, model.BirthDate, model.CreatedAt, model.UpdatedAt,
+	)
+	if result.Error != nil {
+		return false, wrapWriteError(result.Error, pkgError.Upsert)
+	}
+
+	// affected rows: 신규 등록 1, 교체 / 되살림 2 (go-sql-driver 기본값 clientFoundRows=false 기준)
+	return result.RowsAffected == upsertRowsInserted, nil
+}
+
+// mergeCollision source 와 target 에 모두 있는 vital key 와 target 의 현재 값
+type mergeCollision struct {
+	RecordedAt        time.Time
+	VitalType         string
+	SourceValue       float64
+	SourceEncounterID *string
+	TargetValue       float64
+	TargetEncounterID *string
+	TargetDeletedAt   *time.Time
+}
+
+// findMergeCollisionsSQL soft delete 된 target vital 도 primary key 가 겹치므로 충돌로 조회합니다.
+const findMergeCollisionsSQL = `SELECT s.recorded_at, s.vital_type,
+	s.value AS source_value, s.encounter_id AS source_encounter_id,
+	t.value AS target_value, t.encounter_id AS target_encounter_id, t.deleted_at AS target_deleted_at
+FROM vitals s
+JOIN vitals t ON t.patient_id = ? AND t.recorded_at = s.recorded_at AND t.vital_type = s.vital_type
+WHERE s.patient_id = ? AND s.deleted_at IS NULL`
+
+// recordMovedVitalsSQL 충돌 처리 후 남은 source vital 을 이동 대상으로 기록합니다.
+const recordMovedVitalsSQL = `INSERT INTO patient_merge_vitals (merge_id, recorded_at, vital_type, action)
+SELECT ?, recorded_at, vital_type, ?
+FROM vitals
+WHERE patient_id = ? AND deleted_at IS NULL`
+
+func (p *patientRepository) MergePatients(ctx context.Context, param patient.MergePatientsParam) (*patient.PatientMerge, error) {
+	var merge *patient.PatientMerge
+	err := withinTx(ctx, func(tx *gorm.DB) error {
+		source, target, err := lockMergePatients(tx, param.SourcePatientID, param.TargetPatientID)
+		if err != nil {
+			return err
+		}
+
+		// 진행 중인 encounter 의 vital 은 옮기지 않음 (퇴원 처리 후 병합)
+		var active int64
+		if err := tx.Model(&encounter.Encounter{}).Where("active_patient_id = ?", source.PatientID).Count(&active).Error; err != nil {
+			return pkgError.WrapWithCode(err, pkgError.Get)
+		}
+		if active > 0 {
+			return pkgError.WrapWithCode(pkgError.EmptyBusinessError(), pkgError.Conflict, "source patient has an active encounter")
+		}
+
+		var collisions []mergeCollision
+		if err := tx.Raw(findMergeCollisionsSQL, target.PatientID, source.PatientID).Scan(&collisions).Error; err != nil {
+			return pkgError.WrapWithCode(err, pkgError.Get)
+		}
+
+		items, err := resolveMergeCollisions(tx, param, source, target, collisions)
+		if err != nil {
+			return err
+		}
+
+		if len(items) > 0 {
+			if err := tx.CreateInBatches(items, createVitalsBatchSize).Error; err != nil {
+				return wrapWriteError(err, pkgError.Create)
+			}
+		}
+
+		moved := tx.Exec(recordMovedVitalsSQL, param.MergeID, constant.MergeVitalActionMoved.String(), source.PatientID)
+		if moved.Error != nil {
+			return wrapWriteError(moved.Error, pkgError.Create)
+		}
+		if err := tx.Exec("UPDATE vitals SET patient_id = ?, updated_at = ? WHERE patient_id = ? AND deleted_at IS NULL",
+			target.PatientID, param.Now, source.PatientID).Error; err != nil {
+			return wrapWriteError(err, pkgError.Update)
+		}
+
+		if err := tx.Model(&patient.Patient{}).
+			Where("id = ?", source.ID).
+			Updates(map[string]interface{}{
+				"version":    gorm.Expr("version + 1"),
+				"updated_at": param.Now,
+				"deleted_at": param.Now,
+			}).Error; err != nil {
+			return wrapWriteError(err, pkgError.Update)
+		}
+
+		merge = &patient.PatientMerge{
+			ID:               param.MergeID,
+			SourcePatientRef: source.ID,
+			TargetPatientRef: target.ID,
+			SourcePatientID:  source.PatientID,
+			TargetPatientID:  target.PatientID,
+			CollisionPolicy:  param.CollisionPolicy,
+			MovedCount:       int(moved.RowsAffected),
+			CollisionCount:   len(collisions),
+			MergedAt:         param.Now,
+		}
+		if err := tx.Create(merge).Error; err != nil {
+			return wrapWriteError(err, pkgError.Create)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, pkgError.Wrap(err)
+	}
+	return merge, nil
+}
+
+// lockMergePatients 병합 대상 두 환자를 한 statement 로 잠가 (index 순서) 역방향 병합과의 deadlock 을 피합니다.
+func lockMergePatients(tx *gorm.DB, sourcePatientID, targetPatientID string) (*patient.Patient, *patient.Patient, error) {
+	var models []patient.Patient
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("patient_id IN ?", []string{sourcePatientID, targetPatientID}).
+		Find(&models).Error; err != nil {
+		return nil, nil, pkgError.WrapWithCode(err, pkgError.Get)
+	}
+
+	var source, target *patient.Patient
+	for i := range models {
+		switch models[i].PatientID {
+		case sourcePatientID:
+			source = &models[i]
+		case targetPatientID:
+			target = &models[i]
+		}
+	}
+	if source == nil {
+		return nil, nil, pkgError.WrapWithCode(pkgError.EmptyBusinessError(), pkgError.NotFound, "source patient not found")
+	}
+	if target == nil {
+		return nil, nil, pkgError.WrapWithCode(pkgError.EmptyBusinessError(), pkgError.NotFound, "target patient not found")
+	}
+	return source, target, nil
+}
+
+// resolveMergeCollisions 충돌한 vital 을 정책에 따라 처리하고 되돌리기용 기록을 반환합니다.
+// source vital 은 모두 soft delete 하며, soft delete 된 target vital 은 정책과 관계없이 source 값으로 교체합니다.
+func resolveMergeCollisions(tx *gorm.DB, param patient.MergePatientsParam, source, target *patient.Patient, collisions []mergeCollision) ([]*patient.PatientMergeVital, error) {
+	items := make([]*patient.PatientMergeVital, 0, len(collisions))
+	for _, collision := range collisions {
+		item := &patient.PatientMergeVital{
+			MergeID:    param.MergeID,
+			RecordedAt: collision.RecordedAt,
+			VitalType:  collision.VitalType,
+			Action:     constant.MergeVitalActionReplaced.String(),
+		}
+
+		if collision.TargetDeletedAt == nil {
+			switch constant.MergeCollisionPolicy(param.CollisionPolicy) {
+			case constant.MergeCollisionPolicyKeepTarget:
+				item.Action = constant.MergeVitalActionKeptTarget.String()
+			case constant.MergeCollisionPolicyKeepSource:
+				// replaced
+			default:
+				return nil, pkgError.WithData(
+					pkgError.WrapWithCode(pkgError.EmptyBusinessError(), pkgError.Conflict, "source and target patients have vitals at the same recorded_at"),
+					map[string]int{"collisions": len(collisions)},
+				)
+			}
+		}
+
+		if item.Action == constant.MergeVitalActionReplaced.String() {
+			previousValue := collision.TargetValue
+			item.PreviousValue = &previousValue
+			item.PreviousEncounterID = collision.TargetEncounterID
+			item.PreviousDeletedAt = collision.TargetDeletedAt
+
+			if err := tx.Exec(`UPDATE vitals SET value = ?, encounter_id = ?, version = version + 1, updated_at = ?, deleted_at = NULL
+WHERE patient_id = ? AND recorded_at = ? AND vital_type = ?`,
+				collision.SourceValue, collision.SourceEncounterID, param.Now,
+				target.PatientID, collision.RecordedAt, collision.VitalType).Error; err != nil {
+				return nil, wrapWriteError(err, pkgError.Update)
+			}
+		}
+
+		if err := tx.Exec("UPDATE vitals SET updated_at = ?, deleted_at = ? WHERE patient_id = ? AND recorded_at = ? AND vital_type = ?",
+			param.Now, param.Now, source.PatientID, collision.RecordedAt, collision.VitalType).Error; err != nil {
+			return nil, wrapWriteError(err, pkgError.Delete)
+		}
+
+		items = append(items, item)
+	}
+	return items, nil
+}
+
+// 병합 기록의 action 별로 vital 을 복원합니다.
+// - moved: target 에서 source 로 되돌림
+// - replaced: target 값을 교체 전 값으로 복원 (version 은 증가)
+// - kept_target / replaced: soft delete 된 source vital 복원
+const (
+	revertMovedVitalsSQL = `UPDATE vitals v
+JOIN patient_merge_vitals m ON m.recorded_at = v.recorded_at AND m.vital_type = v.vital_type
+SET v.patient_id = ?, v.updated_at = ?
+WHERE m.merge_id = ? AND m.action = ? AND v.patient_id = ?`
+	revertReplacedVitalsSQL = `UPDATE vitals v
+JOIN patient_merge_vitals m ON m.recorded_at = v.recorded_at AND m.vital_type = v.vital_type
+SET v.value = m.previous_value, v.encounter_id = m.previous_encounter_id, v.version = v.version + 1, v.updated_at = ?, v.deleted_at = m.previous_deleted_at
+WHERE m.merge_id = ? AND m.action = ? AND v.patient_id = ?`
+	restoreSourceVitalsSQL = `UPDATE vitals v
+JOIN patient_merge_vitals m ON m.recorded_at = v.recorded_at AND m.vital_type = v.vital_type
+SET v.updated_at = ?, v.deleted_at = NULL
+WHERE m.merge_id = ? AND m.action IN ? AND v.patient_id = ?`
+)
+
+func (p *patientRepository) RevertPatientMerge(ctx context.Context, param patient.RevertPatientMergeParam) (*patient.PatientMerge, error) {
+	var merge patient.PatientMerge
+	err := withinTx(ctx, func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", param.MergeID).First(&merge).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return pkgError.WrapWithCode(err, pkgError.NotFound)
+			}
+			return pkgError.WrapWithCode(err, pkgError.Get)
+		}
+		if merge.RevertedAt != nil {
+			return pkgError.WrapWithCode(pkgError.EmptyBusinessError(), pkgError.Conflict, "merge is already reverted")
+		}
+
+		// 이후의 병합이 같은 vital 을 다시 옮겼을 수 있으므로 최근 병합부터 되돌림
+		var later int64
+		if err := tx.Model(&patient.PatientMerge{}).
+			Where("merged_at > ? AND reverted_at IS NULL", merge.MergedAt).
+			Where("source_patient_ref IN ? OR target_patient_ref IN ?",
+				[]string{merge.SourcePatientRef, merge.TargetPatientRef}, []string{merge.SourcePatientRef, merge.TargetPatientRef}).
+			Count(&later).Error; err != nil {
+			return pkgError.WrapWithCode(err, pkgError.Get)
+		}
+		if later > 0 {
+			return pkgError.WrapWithCode(pkgError.EmptyBusinessError(), pkgError.Conflict, "later merges of these patients must be reverted first")
+		}
+
+		var target patient.Patient
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", merge.TargetPatientRef).First(&target).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return pkgError.WrapWithCode(err, pkgError.Conflict, "target patient is deleted")
+			}
+			return pkgError.WrapWithCode(err, pkgError.Get)
+		}
+		if target.PatientID != param.TargetPatientID {
+			return pkgError.WrapWithCode(pkgError.EmptyBusinessError(), pkgError.NotFound, "merge not found for the patient")
+		}
+
+		var source patient.Patient
+		if err := tx.Unscoped().Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", merge.SourcePatientRef).First(&source).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return pkgError.WrapWithCode(err, pkgError.Conflict, "source patient is purged")
+			}
+			return pkgError.WrapWithCode(err, pkgError.Get)
+		}
+
+		if err := tx.Exec(revertMovedVitalsSQL, source.PatientID, param.Now,
+			merge.ID, constant.MergeVitalActionMoved.String(), target.PatientID).Error; err != nil {
+			return wrapWriteError(err, pkgError.Update)
+		}
+		if err := tx.Exec(revertReplacedVitalsSQL, param.Now,
+			merge.ID, constant.MergeVitalActionReplaced.String(), target.PatientID).Error; err != nil {
+			return wrapWriteError(err, pkgError.Update)
+		}
+		if err := tx.Exec(restoreSourceVitalsSQL, param.Now,
+			merge.ID, []string{constant.MergeVitalActionKeptTarget.String(), constant.MergeVitalActionReplaced.String()}, source.PatientID).Error; err != nil {
+			return wrapWriteError(err, pkgError.Update)
+		}
+
+		if err := tx.Unscoped().Model(&patient.Patient{}).
+			Where("id = ?", source.ID).
+			Updates(map[string]interface{}{
+				"version":    gorm.Expr("version + 1"),
+				"updated_at": param.Now,
+				"deleted_at": nil,
+			}).Error; err != nil {
+			return wrapWriteError(err, pkgError.Update)
+		}
+
+		merge.RevertedAt = &param.Now
+		if err := tx.Model(&patient.PatientMerge{}).
+			Where("id = ?", merge.ID).
+			Update("reverted_at", param.Now).Error; err != nil {
+			return wrapWriteError(err, pkgError.Update)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, pkgError.Wrap(err)
+	}
+	return &merge, nil
+}
+
+func (p *patientRepository) FindPatientMergesByPatientID(ctx context.Context, patientID string) ([]patient.PatientMerge, error) {
+	db := conn(ctx, p.externalGormClient)
+
+	// 병합된 (soft delete 된) source 환자도 조회
+	refs := db.Unscoped().Model(&patient.Patient{}).Select("id").Where("patient_id = ?", patientID)
+
+	var results []patient.PatientMerge
+	if err := db.Where("source_patient_ref IN (?) OR target_patient_ref IN (?)", refs, refs).
+		Order("merged_at DESC").
+		Find(&results).Error; err != nil {
+		return nil, pkgError.WrapWithCode(err, pkgError.Get)
+	}
+	return results, nil
+}
+
+func (p *patientRepository) ChangePatientID(ctx context.Context, param patient.ChangePatientIDParam) error {
+	return withinTx(ctx, func(tx *gorm.DB) error {
+		result := tx.Model(&patient.Patient{}).
+			Where("patient_id = ? AND version = ?", param.PatientID, param.Version).
+			Updates(map[string]interface{}{
+				"patient_id": param.NewPatientID,
+				"version":    param.Version + 1,
+				"updated_at": param.Now,
+			})
+		if result.Error != nil {
+			return wrapWriteError(result.Error, pkgError.Update)
+		}
+		if result.RowsAffected == 0 {
+			if _, err := p.findPatient(tx, param.PatientID); err != nil {
+				return err
+			}
+			return pkgError.WrapWithCode(pkgError.EmptyBusinessError(), pkgError.Conflict, "version conflict in db update")
+		}
+
+		// soft delete 된 vital 과 종료된 encounter / 병상 배정도 함께 변경
+		// active_patient_id 는 앞에서 변경된 patient_id 를 참조합니다.
+		statements := []string{
+			"UPDATE vitals SET patient_id = ? WHERE patient_id = ?",
+			"UPDATE encounters SET patient_id = ?, active_patient_id = IF(active_patient_id IS NULL, NULL, patient_id) WHERE patient_id = ?",
+			"UPDATE bed_assignments SET patient_id = ?, active_patient_id = IF(active_patient_id IS NULL, NULL, patient_id) WHERE patient_id = ?",
+		}
+		for _, statement := range statements {
+			if err := tx.Exec(statement, param.NewPatientID, param.PatientID).Error; err != nil {
+				return wrapWriteError(err, pkgError.Update)
+			}
+		}
+		return nil
+	})
+}
+
+func (p *patientRepository) findPatient(db *gorm.DB, patientID string) (*patient.Patient, error) {
+	var result patient.Patient
+	if err := db.Where("patient_id = ?", patientID).First(&result).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, pkgError.WrapWithCode(err, pkgError.NotFound)
+		}
+		return nil, pkgError.WrapWithCode(err, pkgError.Get)
+	}
+	return &result, nil
+}
+
+func (p *patientRepository) PurgeDeletedPatients(ctx context.Context, before time.Time) (int64, error) {
+	result := conn(ctx, p.externalGormClient).
+		Unscoped().
+		Where("deleted_at IS NOT NULL AND deleted_at < ?", before).
+		Delete(&patient.Patient{})
+	if result.Error != nil {
+		return 0, pkgError.WrapWithCode(result.Error, pkgError.Delete)
+	}
+	return result.RowsAffected, nil
+}
+
 func NewPatientRepository(externalGormClient domain.ExternalDBClient) patient.PatientRepository {
 	return &patientRepository{externalGormClient: externalGormClient}
 }
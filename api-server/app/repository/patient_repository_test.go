@@ -1,14 +1,19 @@
 package repository
 
 import (
+	"aitrics-vital-signs/api-server/domain"
 	"aitrics-vital-signs/api-server/domain/mock"
 	"aitrics-vital-signs/api-server/domain/patient"
+	"aitrics-vital-signs/api-server/pkg/constant"
 	pkgError "aitrics-vital-signs/library/error"
 	"context"
+	"database/sql"
+	"errors"
 	"testing"
 	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
+	mysqlDriver "github.com/go-sql-driver/mysql"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
@@ -17,6 +22,7 @@ import (
 )
 
 var repo patient.PatientRepository
+var patientTransactor domain.Transactor
 var sqlMock sqlmock.Sqlmock
 
 func beforeEach(t *testing.T) {
@@ -35,26 +41,82 @@ func beforeEach(t *testing.T) {
 
 	mockExternalDBClient.EXPECT().MySQL().Return(db).AnyTimes()
 	repo = NewPatientRepository(mockExternalDBClient)
+	patientTransactor = NewTransactor(mockExternalDBClient, sql.LevelDefault)
 	sqlMock = mockSQL
 }
 
 func Test_CreatePatient(t *testing.T) {
-	beforeEach(t)
+	tests := []struct {
+		name         string
+		setupMock    func()
+		wantErr      bool
+		expectedErr  pkgError.Code
+		wantResource *domain.DuplicateResource
+	}{
+		{
+			name: "성공 - 환자 등록",
+			setupMock: func() {
+				sqlMock.ExpectBegin()
+				sqlMock.ExpectExec("INSERT INTO .*patients.*").
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				sqlMock.ExpectCommit()
+			},
+			wantErr: false,
+		},
+		{
+			name: "실패 - 이미 등록된 patient_id 는 Conflict 와 기존 ID 반환",
+			setupMock: func() {
+				sqlMock.ExpectBegin()
+				sqlMock.ExpectExec("INSERT INTO .*patients.*").
+					WillReturnError(&mysqlDriver.MySQLError{Number: mysqlErrDuplicateEntry, Message: "Duplicate entry 'P00001234' for key 'patients.idx_patients_patient_id'"})
+				sqlMock.ExpectRollback()
+			},
+			wantErr:      true,
+			expectedErr:  pkgError.Conflict,
+			wantResource: &domain.DuplicateResource{Key: "idx_patients_patient_id", ExistingID: "P00001234"},
+		},
+		{
+			name: "실패 - 그 외 DB 오류",
+			setupMock: func() {
+				sqlMock.ExpectBegin()
+				sqlMock.ExpectExec("INSERT INTO .*patients.*").
+					WillReturnError(errors.New("connection refused"))
+				sqlMock.ExpectRollback()
+			},
+			wantErr:     true,
+			expectedErr: pkgError.Create,
+		},
+	}
 
-	sqlMock.ExpectBegin()
-	sqlMock.ExpectExec("INSERT INTO .*patients.*").
-		WillReturnResult(sqlmock.NewResult(1, 1))
-	sqlMock.ExpectCommit()
-	err := repo.CreatePatient(context.Background(), &patient.Patient{
-		ID:        uuid.NewString(),
-		PatientID: "P00001234",
-		Name:      "test",
-		Gender:    "M",
-		BirthDate: time.Now().UTC(),
-		Version:   0,
-		CreatedAt: time.Now().UTC(),
-	})
-	require.NoError(t, err)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			beforeEach(t)
+			tt.setupMock()
+
+			err := repo.CreatePatient(context.Background(), &patient.Patient{
+				ID:        uuid.NewString(),
+				PatientID: "P00001234",
+				Name:      "test",
+				Gender:    "M",
+				BirthDate: time.Now().UTC(),
+				Version:   0,
+				CreatedAt: time.Now().UTC(),
+			})
+
+			if tt.wantErr {
+				require.Error(t, err)
+				require.True(t, pkgError.CompareBusinessError(err, tt.expectedErr))
+				if tt.wantResource != nil {
+					castedErr, ok := pkgError.CastBusinessError(err)
+					require.True(t, ok)
+					require.Equal(t, tt.wantResource, castedErr.Status.Data)
+				}
+			} else {
+				require.NoError(t, err)
+			}
+			require.NoError(t, sqlMock.ExpectationsWereMet())
+		})
+	}
 }
 
 func Test_FindPatientByID(t *testing.T) {
@@ -137,3 +199,338 @@ func Test_UpdatePatient(t *testing.T) {
 	err := repo.UpdatePatient(context.Background(), updateModel)
 	require.NoError(t, err)
 }
+
+func Test_ReplacePatient(t *testing.T) {
+	tests := []struct {
+		name        string
+		setupMock   func()
+		wantCreated bool
+		wantErr     bool
+	}{
+		{
+			name: "성공 - 신규 등록",
+			setupMock: func() {
+				sqlMock.ExpectExec("INSERT INTO patients .* ON DUPLICATE KEY UPDATE .*").
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+			wantCreated: true,
+		},
+		{
+			name: "성공 - 기존 환자 교체",
+			setupMock: func() {
+				sqlMock.ExpectExec("INSERT INTO patients .* ON DUPLICATE KEY UPDATE .*").
+					WillReturnResult(sqlmock.NewResult(0, 2))
+			},
+			wantCreated: false,
+		},
+		{
+			name: "실패 - DB 오류",
+			setupMock: func() {
+				sqlMock.ExpectExec("INSERT INTO patients .* ON DUPLICATE KEY UPDATE .*").
+					WillReturnError(errors.New("connection refused"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			beforeEach(t)
+			tt.setupMock()
+
+			now := time.Now().UTC()
+			created, err := repo.ReplacePatient(context.Background(), &patient.Patient{
+				ID:        uuid.NewString(),
+				PatientID: "P00001234",
+				Name:      "홍길동",
+				Gender:    "M",
+				BirthDate: now,
+				CreatedAt: now,
+				UpdatedAt: &now,
+			})
+
+			if tt.wantErr {
+				require.Error(t, err)
+				require.True(t, pkgError.CompareBusinessError(err, pkgError.Upsert))
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.wantCreated, created)
+			require.NoError(t, sqlMock.ExpectationsWereMet())
+		})
+	}
+}
+
+func Test_MergePatients(t *testing.T) {
+	now := time.Now().UTC()
+	recordedAt := time.Date(2025, 12, 1, 10, 0, 0, 0, time.UTC)
+	patientRows := func() *sqlmock.Rows {
+		return sqlmock.NewRows([]string{"id", "patient_id", "name", "gender", "birth_date", "version", "created_at"}).
+			AddRow("source-ref", "P00000002", "홍길동", "M", now, 1, now).
+			AddRow("target-ref", "P00000001", "홍길동", "M", now, 1, now)
+	}
+	collisionColumns := []string{"recorded_at", "vital_type", "source_value", "source_encounter_id", "target_value", "target_encounter_id", "target_deleted_at"}
+
+	tests := []struct {
+		name        string
+		policy      constant.MergeCollisionPolicy
+		setupMock   func()
+		wantErr     bool
+		expectedErr pkgError.Code
+		wantMoved   int
+	}{
+		{
+			name:   "성공 - 충돌 없이 vital 이동",
+			policy: constant.MergeCollisionPolicyReject,
+			setupMock: func() {
+				sqlMock.ExpectBegin()
+				sqlMock.ExpectQuery("SELECT .* FROM `patients` WHERE patient_id IN .* FOR UPDATE").
+					WillReturnRows(patientRows())
+				sqlMock.ExpectQuery("SELECT count\\(\\*\\) FROM `encounters`").
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+				sqlMock.ExpectQuery("SELECT s.recorded_at, s.vital_type").
+					WithArgs("P00000001", "P00000002").
+					WillReturnRows(sqlmock.NewRows(collisionColumns))
+				sqlMock.ExpectExec("INSERT INTO patient_merge_vitals .* SELECT").
+					WillReturnResult(sqlmock.NewResult(0, 3))
+				sqlMock.ExpectExec("UPDATE vitals SET patient_id = .*").
+					WithArgs("P00000001", sqlmock.AnyArg(), "P00000002").
+					WillReturnResult(sqlmock.NewResult(0, 3))
+				sqlMock.ExpectExec("UPDATE `patients` SET .*deleted_at.*").
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				sqlMock.ExpectExec("INSERT INTO `patient_merges`").
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				sqlMock.ExpectCommit()
+			},
+			wantMoved: 3,
+		},
+		{
+			name:   "성공 - keep_source 는 충돌한 target 값을 교체",
+			policy: constant.MergeCollisionPolicyKeepSource,
+			setupMock: func() {
+				sqlMock.ExpectBegin()
+				sqlMock.ExpectQuery("SELECT .* FROM `patients` WHERE patient_id IN .* FOR UPDATE").
+					WillReturnRows(patientRows())
+				sqlMock.ExpectQuery("SELECT count\\(\\*\\) FROM `encounters`").
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+				sqlMock.ExpectQuery("SELECT s.recorded_at, s.vital_type").
+					WillReturnRows(sqlmock.NewRows(collisionColumns).AddRow(recordedAt, "HR", 90.0, nil, 80.0, nil, nil))
+				sqlMock.ExpectExec("UPDATE vitals SET value = .*").
+					WithArgs(90.0, nil, sqlmock.AnyArg(), "P00000001", recordedAt, "HR").
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				sqlMock.ExpectExec("UPDATE vitals SET updated_at = .*, deleted_at = .*").
+					WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), "P00000002", recordedAt, "HR").
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				sqlMock.ExpectExec("INSERT INTO `patient_merge_vitals`").
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				sqlMock.ExpectExec("INSERT INTO patient_merge_vitals .* SELECT").
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				sqlMock.ExpectExec("UPDATE vitals SET patient_id = .*").
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				sqlMock.ExpectExec("UPDATE `patients` SET .*deleted_at.*").
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				sqlMock.ExpectExec("INSERT INTO `patient_merges`").
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				sqlMock.ExpectCommit()
+			},
+			wantMoved: 0,
+		},
+		{
+			name:   "실패 - reject 정책에서 vital 충돌",
+			policy: constant.MergeCollisionPolicyReject,
+			setupMock: func() {
+				sqlMock.ExpectBegin()
+				sqlMock.ExpectQuery("SELECT .* FROM `patients` WHERE patient_id IN .* FOR UPDATE").
+					WillReturnRows(patientRows())
+				sqlMock.ExpectQuery("SELECT count\\(\\*\\) FROM `encounters`").
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+				sqlMock.ExpectQuery("SELECT s.recorded_at, s.vital_type").
+					WillReturnRows(sqlmock.NewRows(collisionColumns).AddRow(recordedAt, "HR", 90.0, nil, 80.0, nil, nil))
+				sqlMock.ExpectRollback()
+			},
+			wantErr:     true,
+			expectedErr: pkgError.Conflict,
+		},
+		{
+			name:   "실패 - source 환자에 진행 중인 encounter",
+			policy: constant.MergeCollisionPolicyKeepTarget,
+			setupMock: func() {
+				sqlMock.ExpectBegin()
+				sqlMock.ExpectQuery("SELECT .* FROM `patients` WHERE patient_id IN .* FOR UPDATE").
+					WillReturnRows(patientRows())
+				sqlMock.ExpectQuery("SELECT count\\(\\*\\) FROM `encounters`").
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+				sqlMock.ExpectRollback()
+			},
+			wantErr:     true,
+			expectedErr: pkgError.Conflict,
+		},
+		{
+			name:   "실패 - source 환자 없음",
+			policy: constant.MergeCollisionPolicyReject,
+			setupMock: func() {
+				sqlMock.ExpectBegin()
+				sqlMock.ExpectQuery("SELECT .* FROM `patients` WHERE patient_id IN .* FOR UPDATE").
+					WillReturnRows(sqlmock.NewRows([]string{"id", "patient_id"}).AddRow("target-ref", "P00000001"))
+				sqlMock.ExpectRollback()
+			},
+			wantErr:     true,
+			expectedErr: pkgError.NotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			beforeEach(t)
+			tt.setupMock()
+
+			var merge *patient.PatientMerge
+			err := patientTransactor.WithinTransaction(context.Background(), func(ctx context.Context) error {
+				var err error
+				merge, err = repo.MergePatients(ctx, patient.MergePatientsParam{
+					MergeID:         "merge-1",
+					SourcePatientID: "P00000002",
+					TargetPatientID: "P00000001",
+					CollisionPolicy: tt.policy.String(),
+					Now:             now,
+				})
+				return err
+			})
+
+			if tt.wantErr {
+				require.Error(t, err)
+				require.True(t, pkgError.CompareBusinessError(err, tt.expectedErr))
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, "source-ref", merge.SourcePatientRef)
+				require.Equal(t, "target-ref", merge.TargetPatientRef)
+				require.Equal(t, tt.wantMoved, merge.MovedCount)
+			}
+			require.NoError(t, sqlMock.ExpectationsWereMet())
+		})
+	}
+}
+
+func Test_RevertPatientMerge_AlreadyReverted(t *testing.T) {
+	beforeEach(t)
+
+	now := time.Now().UTC()
+	sqlMock.ExpectBegin()
+	sqlMock.ExpectQuery("SELECT .* FROM `patient_merges` WHERE id = .* FOR UPDATE").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "merged_at", "reverted_at"}).AddRow("merge-1", now.Add(-time.Hour), now))
+	sqlMock.ExpectRollback()
+
+	err := patientTransactor.WithinTransaction(context.Background(), func(ctx context.Context) error {
+		_, err := repo.RevertPatientMerge(ctx, patient.RevertPatientMergeParam{
+			MergeID:         "merge-1",
+			TargetPatientID: "P00000001",
+			Now:             now,
+		})
+		return err
+	})
+	require.True(t, pkgError.CompareBusinessError(err, pkgError.Conflict))
+	require.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
+func Test_ChangePatientID(t *testing.T) {
+	tests := []struct {
+		name        string
+		setupMock   func()
+		wantErr     bool
+		expectedErr pkgError.Code
+	}{
+		{
+			name: "성공 - 환자와 관련 데이터의 patient_id 변경",
+			setupMock: func() {
+				sqlMock.ExpectBegin()
+				sqlMock.ExpectExec("UPDATE `patients` SET .*patient_id.*").
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				sqlMock.ExpectExec("UPDATE vitals SET patient_id").
+					WithArgs("P00000009", "P00000001").
+					WillReturnResult(sqlmock.NewResult(0, 10))
+				sqlMock.ExpectExec("UPDATE encounters SET patient_id").
+					WithArgs("P00000009", "P00000001").
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				sqlMock.ExpectExec("UPDATE bed_assignments SET patient_id").
+					WithArgs("P00000009", "P00000001").
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				sqlMock.ExpectCommit()
+			},
+		},
+		{
+			name: "실패 - 이미 사용 중인 patient_id",
+			setupMock: func() {
+				sqlMock.ExpectBegin()
+				sqlMock.ExpectExec("UPDATE `patients` SET .*patient_id.*").
+					WillReturnError(&mysqlDriver.MySQLError{Number: mysqlErrDuplicateEntry, Message: "Duplicate entry 'P00000009' for key 'patients.idx_patients_patient_id'"})
+				sqlMock.ExpectRollback()
+			},
+			wantErr:     true,
+			expectedErr: pkgError.Conflict,
+		},
+		{
+			name: "실패 - version 불일치",
+			setupMock: func() {
+				sqlMock.ExpectBegin()
+				sqlMock.ExpectExec("UPDATE `patients` SET .*patient_id.*").
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				sqlMock.ExpectQuery("SELECT .* FROM `patients`").
+					WillReturnRows(sqlmock.NewRows([]string{"id", "patient_id", "version"}).AddRow("ref", "P00000001", 3))
+				sqlMock.ExpectRollback()
+			},
+			wantErr:     true,
+			expectedErr: pkgError.Conflict,
+		},
+		{
+			name: "실패 - 환자 없음",
+			setupMock: func() {
+				sqlMock.ExpectBegin()
+				sqlMock.ExpectExec("UPDATE `patients` SET .*patient_id.*").
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				sqlMock.ExpectQuery("SELECT .* FROM `patients`").
+					WillReturnError(gorm.ErrRecordNotFound)
+				sqlMock.ExpectRollback()
+			},
+			wantErr:     true,
+			expectedErr: pkgError.NotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			beforeEach(t)
+			tt.setupMock()
+
+			err := patientTransactor.WithinTransaction(context.Background(), func(ctx context.Context) error {
+				return repo.ChangePatientID(ctx, patient.ChangePatientIDParam{
+					PatientID:    "P00000001",
+					NewPatientID: "P00000009",
+					Version:      2,
+					Now:          time.Now().UTC(),
+				})
+			})
+
+			if tt.wantErr {
+				require.Error(t, err)
+				require.True(t, pkgError.CompareBusinessError(err, tt.expectedErr))
+			} else {
+				require.NoError(t, err)
+			}
+			require.NoError(t, sqlMock.ExpectationsWereMet())
+		})
+	}
+}
+
+func Test_PurgeDeletedPatients(t *testing.T) {
+	beforeEach(t)
+
+	sqlMock.ExpectBegin()
+	sqlMock.ExpectExec("DELETE FROM .*patients.* WHERE deleted_at IS NOT NULL AND deleted_at < .*").
+		WillReturnResult(sqlmock.NewResult(0, 3))
+	sqlMock.ExpectCommit()
+
+	purged, err := repo.PurgeDeletedPatients(context.Background(), time.Now().UTC())
+	require.NoError(t, err)
+	require.Equal(t, int64(3), purged)
+	require.NoError(t, sqlMock.ExpectationsWereMet())
+}
@@ -0,0 +1,86 @@
+package repository
+
+import (
+	"aitrics-vital-signs/api-server/domain"
+	pkgError "aitrics-vital-signs/library/error"
+	"context"
+	"database/sql"
+	"strings"
+
+	"gorm.io/gorm"
+	"gorm.io/plugin/dbresolver"
+)
+
+// isolationLevels db.transaction_isolation 설정 값 (비어 있으면 DB 기본값)
+var isolationLevels = map[string]sql.IsolationLevel{
+	"":                 sql.LevelDefault,
+	"READ UNCOMMITTED": sql.LevelReadUncommitted,
+	"READ COMMITTED":   sql.LevelReadCommitted,
+	"REPEATABLE READ":  sql.LevelRepeatableRead,
+	"SERIALIZABLE":     sql.LevelSerializable,
+}
+
+// ParseIsolationLevel 설정 값을 sql.IsolationLevel 로 변환합니다. (알 수 없는 값은 false)
+func ParseIsolationLevel(name string) (sql.IsolationLevel, bool) {
+	level, ok := isolationLevels[strings.ToUpper(strings.TrimSpace(name))]
+	return level, ok
+}
+
+// conn context 에 진행 중인 트랜잭션이 있으면 그 트랜잭션을, 없으면 기본 연결을 반환합니다.
+// 기본 연결의 읽기는 replica 로 분산되며, read-your-writes 가 필요한 요청은 primary 에서 읽습니다.
+func conn(ctx context.Context, client domain.ExternalDBClient) *gorm.DB {
+	if tx, ok := domain.TxFromContext(ctx); ok {
+		return tx.WithContext(ctx)
+	}
+
+	db := client.MySQL().WithContext(ctx)
+	if domain.ReadFromPrimary(ctx) {
+		db = db.Clauses(dbresolver.Write)
+	}
+	return db
+}
+
+// withinTx 여러 statement 를 원자적으로 수행해야 하는 repository 메서드에서 context 의 트랜잭션으로 fn 을 실행합니다.
+// 트랜잭션 경계는 service 의 Transactor 가 소유하므로, 트랜잭션 밖에서 호출되면 실행하지 않고 error 를 반환합니다.
+func withinTx(ctx context.Context, fn func(tx *gorm.DB) error) error {
+	tx, ok := domain.TxFromContext(ctx)
+	if !ok {
+		return pkgError.WrapWithCode(pkgError.EmptyBusinessError(), pkgError.Transaction, "must be called within a transaction")
+	}
+	return fn(tx.WithContext(ctx))
+}
+
+type transactor struct {
+	externalGormClient domain.ExternalDBClient
+	isolation          sql.IsolationLevel
+}
+
+func (t *transactor) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error, opts ...domain.TxOption) error {
+	var fnErr error
+	run := func(tx *gorm.DB) error {
+		fnErr = fn(domain.ContextWithTx(ctx, tx))
+		return fnErr
+	}
+
+	var err error
+	if tx, ok := domain.TxFromContext(ctx); ok {
+		// 이미 트랜잭션 안이면 gorm 이 SAVEPOINT 로 중첩합니다.
+		err = tx.WithContext(ctx).Transaction(run)
+	} else {
+		options := &sql.TxOptions{Isolation: t.isolation}
+		for _, opt := range opts {
+			opt(options)
+		}
+		err = t.externalGormClient.MySQL().WithContext(ctx).Transaction(run, options)
+	}
+
+	// fn 의 error 는 business error 를 그대로 전달하고, begin / commit 실패만 감쌉니다.
+	if err != nil && err != fnErr {
+		return pkgError.WrapWithCode(err, pkgError.Transaction)
+	}
+	return err
+}
+
+func NewTransactor(externalGormClient domain.ExternalDBClient, isolation sql.IsolationLevel) domain.Transactor {
+	return &transactor{externalGormClient: externalGormClient, isolation: isolation}
+}
@@ -0,0 +1,145 @@
+package repository
+
+import (
+	"aitrics-vital-signs/api-server/domain"
+	"aitrics-vital-signs/api-server/domain/mock"
+	"aitrics-vital-signs/api-server/domain/patient"
+	pkgError "aitrics-vital-signs/library/error"
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+)
+
+var txPatientRepo patient.PatientRepository
+var transactorUnderTest domain.Transactor
+var txSQLMock sqlmock.Sqlmock
+
+func beforeEachTransactor(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockExternalDBClient := mock.NewMockExternalDBClient(ctrl)
+
+	sqlDB, mockSQL, err := sqlmock.New()
+	require.NoError(t, err)
+
+	dial := mysql.New(mysql.Config{
+		Conn:                      sqlDB,
+		SkipInitializeWithVersion: true,
+	})
+	db, err := gorm.Open(dial, &gorm.Config{SkipDefaultTransaction: true})
+	require.NoError(t, err)
+
+	mockExternalDBClient.EXPECT().MySQL().Return(db).AnyTimes()
+	txPatientRepo = NewPatientRepository(mockExternalDBClient)
+	transactorUnderTest = NewTransactor(mockExternalDBClient, sql.LevelReadCommitted)
+	txSQLMock = mockSQL
+}
+
+func Test_WithinTransaction(t *testing.T) {
+	findPatient := func(ctx context.Context) error {
+		_, err := txPatientRepo.FindPatientByID(ctx, "P00001")
+		return err
+	}
+
+	tests := []struct {
+		name        string
+		setupMock   func()
+		fn          func(ctx context.Context) error
+		wantErr     bool
+		expectedErr pkgError.Code
+	}{
+		{
+			name: "성공 - repository 가 context 의 트랜잭션을 사용하고 commit",
+			setupMock: func() {
+				txSQLMock.ExpectBegin()
+				txSQLMock.ExpectQuery("SELECT .* FROM `patients`").
+					WillReturnRows(sqlmock.NewRows([]string{"id", "patient_id"}).AddRow("uuid-1", "P00001"))
+				txSQLMock.ExpectCommit()
+			},
+			fn: findPatient,
+		},
+		{
+			name: "실패 - fn 의 error 는 그대로 전달하고 rollback",
+			setupMock: func() {
+				txSQLMock.ExpectBegin()
+				txSQLMock.ExpectRollback()
+			},
+			fn: func(ctx context.Context) error {
+				return pkgError.WrapWithCode(pkgError.EmptyBusinessError(), pkgError.Conflict)
+			},
+			wantErr:     true,
+			expectedErr: pkgError.Conflict,
+		},
+		{
+			name: "성공 - 중첩 호출은 savepoint 로 rollback 되고 바깥 트랜잭션은 commit",
+			setupMock: func() {
+				txSQLMock.ExpectBegin()
+				txSQLMock.ExpectExec("SAVEPOINT").WillReturnResult(sqlmock.NewResult(0, 0))
+				txSQLMock.ExpectQuery("SELECT .* FROM `patients`").WillReturnError(gorm.ErrRecordNotFound)
+				txSQLMock.ExpectExec("ROLLBACK TO SAVEPOINT").WillReturnResult(sqlmock.NewResult(0, 0))
+				txSQLMock.ExpectCommit()
+			},
+			fn: func(ctx context.Context) error {
+				err := transactorUnderTest.WithinTransaction(ctx, findPatient)
+				if !pkgError.CompareBusinessError(err, pkgError.NotFound) {
+					return errors.New("expected not found")
+				}
+				return nil
+			},
+		},
+		{
+			name: "실패 - 트랜잭션 시작 실패",
+			setupMock: func() {
+				txSQLMock.ExpectBegin().WillReturnError(errors.New("connection refused"))
+			},
+			fn:          findPatient,
+			wantErr:     true,
+			expectedErr: pkgError.Transaction,
+		},
+		{
+			name: "실패 - commit 실패",
+			setupMock: func() {
+				txSQLMock.ExpectBegin()
+				txSQLMock.ExpectCommit().WillReturnError(errors.New("connection lost"))
+			},
+			fn:          func(ctx context.Context) error { return nil },
+			wantErr:     true,
+			expectedErr: pkgError.Transaction,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			beforeEachTransactor(t)
+			tt.setupMock()
+
+			err := transactorUnderTest.WithinTransaction(context.Background(), tt.fn)
+			if tt.wantErr {
+				require.Error(t, err)
+				require.True(t, pkgError.CompareBusinessError(err, tt.expectedErr))
+			} else {
+				require.NoError(t, err)
+			}
+			require.NoError(t, txSQLMock.ExpectationsWereMet())
+		})
+	}
+}
+
+func Test_ParseIsolationLevel(t *testing.T) {
+	level, ok := ParseIsolationLevel("read committed")
+	require.True(t, ok)
+	require.Equal(t, sql.LevelReadCommitted, level)
+
+	level, ok = ParseIsolationLevel("")
+	require.True(t, ok)
+	require.Equal(t, sql.LevelDefault, level)
+
+	_, ok = ParseIsolationLevel("SNAPSHOT")
+	require.False(t, ok)
+}
@@ -6,17 +6,21 @@ import (
 	pkgError "aitrics-vital-signs/library/error"
 	"context"
 	"errors"
+	"fmt"
+	"time"
 
 	"gorm.io/gorm"
 )
 
+const createVitalsBatchSize = 500
+
 type vitalRepository struct {
 	externalGormClient domain.ExternalDBClient
 }
 
 func (v *vitalRepository) FindVitalByPatientIDAndRecordedAtAndVitalType(ctx context.Context, param vital.FindVitalByPatientIDAndRecordedAtAndVitalTypeParam) (*vital.Vital, error) {
 	var result vital.Vital
-	if err := v.externalGormClient.MySQL().WithContext(ctx).
+	if err := conn(ctx, v.externalGormClient).
 		Where("patient_id = ? AND recorded_at = ? AND vital_type = ?", param.PatientID, param.RecordedAt, param.VitalType).
 		First(&result).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
@@ -29,7 +33,7 @@ func (v *vitalRepository) FindVitalByPatientIDAndRecordedAtAndVitalType(ctx cont
 
 func (v *vitalRepository) FindVitalsByPatientIDAndDateRange(ctx context.Context, param vital.FindVitalsByPatientIDAndDateRangeParam) ([]vital.Vital, error) {
 	var results []vital.Vital
-	query := v.externalGormClient.MySQL().WithContext(ctx).
+	query := conn(ctx, v.externalGormClient).
 		Where("patient_id = ? AND recorded_at >= ? AND recorded_at <= ?", param.PatientID, param.From, param.To)
 
 	// vitalType이 있으면 해당 타입만 필터링
@@ -37,6 +41,10 @@ func (v *vitalRepository) FindVitalsByPatientIDAndDateRange(ctx context.Context,
 		query = query.Where("vital_type IN ?", param.VitalTypes)
 	}
 
+	if param.EncounterID != "" {
+		query = query.Where("encounter_id = ?", param.EncounterID)
+	}
+
 	if err := query.Order("recorded_at DESC").Find(&results).Error; err != nil {
 		return nil, pkgError.WrapWithCode(err, pkgError.Get)
 	}
@@ -44,34 +52,202 @@ func (v *vitalRepository) FindVitalsByPatientIDAndDateRange(ctx context.Context,
 	return results, nil
 }
 
-func (v *vitalRepository) CreateVital(ctx context.Context, model *vital.Vital) error {
-	return pkgError.WrapWithCode(v.externalGormClient.MySQL().WithContext(ctx).Create(model).Error, pkgError.Create)
+func (v *vitalRepository) StreamVitalsByPatientIDsAndDateRange(ctx context.Context, param vital.StreamVitalsByPatientIDsAndDateRangeParam, fn func(*vital.Vital) error) error {
+	db := conn(ctx, v.externalGormClient)
+	query := db.Model(&vital.Vital{}).
+		Where("patient_id IN ? AND recorded_at >= ? AND recorded_at <= ?", param.PatientIDs, param.From, param.To)
+
+	if len(param.VitalTypes) > 0 {
+		query = query.Where("vital_type IN ?", param.VitalTypes)
+	}
+
+	if param.EncounterID != "" {
+		query = query.Where("encounter_id = ?", param.EncounterID)
+	}
+
+	// 결과를 slice 로 모으지 않고 cursor 에서 한 건씩 읽어 전달
+	rows, err := query.Order("patient_id ASC").Order("recorded_at ASC").Rows()
+	if err != nil {
+		return pkgError.WrapWithCode(err, pkgError.Get)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var result vital.Vital
+		if err := db.ScanRows(rows, &result); err != nil {
+			return pkgError.WrapWithCode(err, pkgError.Get)
+		}
+
+		if err := fn(&result); err != nil {
+			return pkgError.Wrap(err)
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return pkgError.WrapWithCode(err, pkgError.Get)
+	}
+
+	return nil
+}
+
+func (v *vitalRepository) FindLatestVitalsByPatientIDs(ctx context.Context, param vital.FindLatestVitalsByPatientIDsParam) ([]vital.Vital, error) {
+	db := conn(ctx, v.externalGormClient)
+
+	// 환자 / vital type 별 가장 최근 recorded_at (encounter / 기간 내)
+	latest := db.Model(&vital.Vital{}).
+		Select("patient_id, vital_type, MAX(recorded_at) AS recorded_at").
+		Where("patient_id IN ? AND encounter_id IN ? AND recorded_at >= ?", param.PatientIDs, param.EncounterIDs, param.From).
+		Group("patient_id, vital_type")
+
+	var results []vital.Vital
+	if err := db.Table("vitals AS v").
+		Select("v.*").
+		Joins("JOIN (?) AS latest ON v.patient_id = latest.patient_id AND v.vital_type = latest.vital_type AND v.recorded_at = latest.recorded_at", latest).
+		Where("v.encounter_id IN ? AND v.recorded_at >= ?", param.EncounterIDs, param.From).
+		Find(&results).Error; err != nil {
+		return nil, pkgError.WrapWithCode(err, pkgError.Get)
+	}
+
+	return results, nil
+}
+
+// CreateVitals 대량 적재용 batch insert (generator 등)
+func (v *vitalRepository) CreateVitals(ctx context.Context, models []*vital.Vital) error {
+	if len(models) == 0 {
+		return nil
+	}
+	if err := conn(ctx, v.externalGormClient).CreateInBatches(models, createVitalsBatchSize).Error; err != nil {
+		return wrapWriteError(err, pkgError.Create)
+	}
+	return nil
+}
+
+// upsertVitalSQL version 1 요청의 신규 저장 / 수정을 한 statement 로 처리합니다.
+// - 데이터가 없으면 version 1 로 insert
+// - version 1 인 데이터는 version 2 로 update, 그 외 version 은 변경하지 않음 (conflict)
+// - soft delete 된 데이터는 신규 저장과 같이 version 1 로 되살림
+// 뒤쪽 대입식이 앞에서 변경된 값을 참조하므로 version, deleted_at 은 마지막에 변경합니다.
+const upsertVitalSQL = `INSERT INTO vitals (patient_id, recorded_at, vital_type, value, encounter_id, version, created_at, updated_at)
+VALUES (?, ?, ?, ?, ?, 1, ?, ?)
+ON DUPLICATE KEY UPDATE
+	value = IF(deleted_at IS NOT NULL OR version = 1, VALUES(value), value),
+	encounter_id = IF(deleted_at IS NOT NULL, VALUES(encounter_id), IF(version = 1, IF(?, VALUES(encounter_id), COALESCE(encounter_id, VALUES(encounter_id))), encounter_id)),
+	created_at = IF(deleted_at IS NOT NULL, VALUES(created_at), created_at),
+	updated_at = IF(deleted_at IS NOT NULL OR version = 1, VALUES(updated_at), updated_at),
+	version = IF(deleted_at IS NOT NULL, 1, IF(version = 1, 2, version)),
+	deleted_at = NULL`
+
+// insertVitalSQL 신규 저장만 수행합니다. (conflict_policy 적용 시 사용)
+// soft delete 된 데이터는 version 1 로 되살리고, 존재하는 데이터는 변경하지 않습니다.
+const insertVitalSQL = `INSERT INTO vitals (patient_id, recorded_at, vital_type, value, encounter_id, version, created_at, updated_at)
+VALUES (?, ?, ?, ?, ?, 1, ?, ?)
+ON DUPLICATE KEY UPDATE
+	value = IF(deleted_at IS NOT NULL, VALUES(value), value),
+	encounter_id = IF(deleted_at IS NOT NULL, VALUES(encounter_id), encounter_id),
+	created_at = IF(deleted_at IS NOT NULL, VALUES(created_at), created_at),
+	updated_at = IF(deleted_at IS NOT NULL, VALUES(updated_at), updated_at),
+	version = IF(deleted_at IS NOT NULL, 1, version),
+	deleted_at = NULL`
+
+// upsert 결과 affected rows (go-sql-driver 기본값 clientFoundRows=false 기준)
+const (
+	upsertRowsUnchanged = 0
+	upsertRowsInserted  = 1
+	upsertRowsUpdated   = 2
+)
+
+func (v *vitalRepository) UpsertVital(ctx context.Context, param vital.UpsertVitalParam) (vital.UpsertResult, error) {
+	if param.InsertOnly {
+		return v.insertVital(ctx, param)
+	}
+	if param.Version != 1 {
+		return v.updateVitalWithVersion(ctx, param)
+	}
+
+	result := conn(ctx, v.externalGormClient).Exec(upsertVitalSQL,
+		param.PatientID, param.RecordedAt, param.VitalType, param.Value, param.EncounterID, param.Now, param.Now,
+		param.RelinkEncounter,
+	)
+	if result.Error != nil {
+		return 0, wrapWriteError(result.Error, pkgError.Upsert)
+	}
+
+	switch result.RowsAffected {
+	case upsertRowsInserted:
+		return vital.UpsertInserted, nil
+	case upsertRowsUpdated:
+		return vital.UpsertUpdated, nil
+	case upsertRowsUnchanged:
+		return 0, pkgError.WrapWithCode(pkgError.EmptyBusinessError(), pkgError.Conflict, "version conflict in db upsert")
+	default:
+		return 0, pkgError.WrapWithCode(pkgError.EmptyBusinessError(), pkgError.Upsert, fmt.Sprintf("unexpected rows affected: %d", result.RowsAffected))
+	}
+}
+
+func (v *vitalRepository) insertVital(ctx context.Context, param vital.UpsertVitalParam) (vital.UpsertResult, error) {
+	result := conn(ctx, v.externalGormClient).Exec(insertVitalSQL,
+		param.PatientID, param.RecordedAt, param.VitalType, param.Value, param.EncounterID, param.Now, param.Now,
+	)
+	if result.Error != nil {
+		return 0, wrapWriteError(result.Error, pkgError.Create)
+	}
+
+	switch result.RowsAffected {
+	case upsertRowsInserted, upsertRowsUpdated:
+		// soft delete 된 데이터를 되살린 경우도 신규 저장
+		return vital.UpsertInserted, nil
+	case upsertRowsUnchanged:
+		return 0, pkgError.WrapWithCode(pkgError.EmptyBusinessError(), pkgError.Conflict, "vital already exists")
+	default:
+		return 0, pkgError.WrapWithCode(pkgError.EmptyBusinessError(), pkgError.Create, fmt.Sprintf("unexpected rows affected: %d", result.RowsAffected))
+	}
 }
 
-func (v *vitalRepository) UpdateVital(ctx context.Context, model *vital.Vital) error {
-	// Optimistic Lock: WHERE version = (oldVersion) 조건으로 업데이트
-	oldVersion := model.Version - 1
+// updateVitalWithVersion
+// Optimistic Lock: WHERE version = (요청 version) 조건으로 update 하며,
+// 변경된 row 가 없으면 데이터 존재 여부로 NotFound / Conflict 를 구분합니다.
+func (v *vitalRepository) updateVitalWithVersion(ctx context.Context, param vital.UpsertVitalParam) (vital.UpsertResult, error) {
+	var encounterID interface{} = param.EncounterID
+	if !param.RelinkEncounter {
+		encounterID = gorm.Expr("COALESCE(encounter_id, ?)", param.EncounterID)
+	}
 
-	result := v.externalGormClient.MySQL().WithContext(ctx).
+	result := conn(ctx, v.externalGormClient).
 		Model(&vital.Vital{}).
 		Where("patient_id = ? AND recorded_at = ? AND vital_type = ? AND version = ?",
-			model.PatientID, model.RecordedAt, model.VitalType, oldVersion).
+			param.PatientID, param.RecordedAt, param.VitalType, param.Version).
 		Updates(map[string]interface{}{
-			"value":      model.Value,
-			"version":    model.Version,
-			"updated_at": model.UpdatedAt,
+			"value":        param.Value,
+			"encounter_id": encounterID,
+			"version":      param.Version + 1,
+			"updated_at":   param.Now,
 		})
-
 	if result.Error != nil {
-		return pkgError.WrapWithCode(result.Error, pkgError.Update)
+		return 0, wrapWriteError(result.Error, pkgError.Update)
+	}
+	if result.RowsAffected > 0 {
+		return vital.UpsertUpdated, nil
 	}
 
-	// RowsAffected가 0이면 version conflict
-	if result.RowsAffected == 0 {
-		return pkgError.WrapWithCode(pkgError.EmptyBusinessError(), pkgError.Conflict, "version conflict in db update")
+	if _, err := v.FindVitalByPatientIDAndRecordedAtAndVitalType(ctx, vital.FindVitalByPatientIDAndRecordedAtAndVitalTypeParam{
+		PatientID:  param.PatientID,
+		RecordedAt: param.RecordedAt,
+		VitalType:  param.VitalType,
+	}); err != nil {
+		return 0, pkgError.Wrap(err)
 	}
+	return 0, pkgError.WrapWithCode(pkgError.EmptyBusinessError(), pkgError.Conflict, "version conflict in db update")
+}
 
-	return nil
+func (v *vitalRepository) PurgeDeletedVitals(ctx context.Context, before time.Time) (int64, error) {
+	result := conn(ctx, v.externalGormClient).
+		Unscoped().
+		Where("deleted_at IS NOT NULL AND deleted_at < ?", before).
+		Delete(&vital.Vital{})
+	if result.Error != nil {
+		return 0, pkgError.WrapWithCode(result.Error, pkgError.Delete)
+	}
+	return result.RowsAffected, nil
 }
 
 func NewVitalRepository(externalGormClient domain.ExternalDBClient) vital.VitalRepository {
@@ -0,0 +1,129 @@
+//go:build integration
+
+package repository
+
+import (
+	"aitrics-vital-signs/api-server/domain/vital"
+	pkgError "aitrics-vital-signs/library/error"
+	"context"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+)
+
+// 실제 MySQL 에 대한 동시성 테스트입니다.
+// TEST_MYSQL_DSN="user:pass@tcp(localhost:3306)/vitals_test?parseTime=true&loc=UTC" go test -tags integration ./app/repository/ -run Integration
+const integrationDSNEnv = "TEST_MYSQL_DSN"
+
+type integrationDBClient struct {
+	db *gorm.DB
+}
+
+func (c *integrationDBClient) MySQL() *gorm.DB {
+	return c.db
+}
+
+func beforeEachVitalIntegration(t *testing.T) (vital.VitalRepository, *gorm.DB) {
+	dsn := os.Getenv(integrationDSNEnv)
+	if dsn == "" {
+		t.Skipf("%s is not set", integrationDSNEnv)
+	}
+
+	db, err := gorm.Open(mysql.Open(dsn), &gorm.Config{})
+	require.NoError(t, err)
+	require.NoError(t, db.AutoMigrate(&vital.Vital{}))
+
+	return NewVitalRepository(&integrationDBClient{db}), db
+}
+
+func Test_UpsertVital_Integration_Concurrent(t *testing.T) {
+	const writers = 32
+
+	tests := []struct {
+		name    string
+		version int
+	}{
+		{name: "성공 - 최초 저장 경쟁 (version 1)", version: 1},
+		{name: "성공 - 수정 경쟁 (version 2)", version: 2},
+	}
+
+	repo, db := beforeEachVitalIntegration(t)
+	ctx := context.Background()
+	patientID := "PIT" + time.Now().UTC().Format("150405.000")
+	recordedAt := time.Date(2025, 12, 1, 10, 15, 0, 0, time.UTC)
+	t.Cleanup(func() {
+		db.Unscoped().Where("patient_id = ?", patientID).Delete(&vital.Vital{})
+	})
+
+	// 직전 경쟁 결과의 version (없으면 0)
+	currentVersion := 0
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				wg      sync.WaitGroup
+				mu      sync.Mutex
+				results = map[vital.UpsertResult]int{}
+				errs    []error
+			)
+
+			start := make(chan struct{})
+			for i := 0; i < writers; i++ {
+				wg.Add(1)
+				go func(i int) {
+					defer wg.Done()
+					<-start
+
+					result, err := repo.UpsertVital(ctx, vital.UpsertVitalParam{
+						PatientID:  patientID,
+						RecordedAt: recordedAt,
+						VitalType:  "HR",
+						Value:      float64(100 + i),
+						Version:    tt.version,
+						Now:        time.Now().UTC(),
+					})
+
+					mu.Lock()
+					defer mu.Unlock()
+					if err != nil {
+						errs = append(errs, err)
+						return
+					}
+					results[result]++
+				}(i)
+			}
+			close(start)
+			wg.Wait()
+
+			// 충돌 외의 오류 (duplicate key 500 등) 는 없어야 함
+			for _, err := range errs {
+				require.True(t, pkgError.CompareBusinessError(err, pkgError.Conflict), err.Error())
+			}
+
+			if tt.version == 1 {
+				// 한 writer 만 INSERT 하고, version 1 을 본 writer 중 최대 하나만 version 2 로 UPDATE
+				require.Equal(t, 1, results[vital.UpsertInserted])
+				require.LessOrEqual(t, results[vital.UpsertUpdated], 1)
+			} else {
+				require.Equal(t, 0, results[vital.UpsertInserted])
+				require.LessOrEqual(t, results[vital.UpsertUpdated], 1)
+			}
+			require.Equal(t, writers, results[vital.UpsertInserted]+results[vital.UpsertUpdated]+len(errs))
+
+			model, err := repo.FindVitalByPatientIDAndRecordedAtAndVitalType(ctx, vital.FindVitalByPatientIDAndRecordedAtAndVitalTypeParam{
+				PatientID:  patientID,
+				RecordedAt: recordedAt,
+				VitalType:  "HR",
+			})
+			require.NoError(t, err)
+			// 성공한 INSERT / UPDATE 수만큼만 version 이 증가해야 함
+			currentVersion += results[vital.UpsertInserted] + results[vital.UpsertUpdated]
+			require.Equal(t, currentVersion, model.Version)
+		})
+	}
+}
@@ -5,10 +5,12 @@ import (
 	"aitrics-vital-signs/api-server/domain/vital"
 	pkgError "aitrics-vital-signs/library/error"
 	"context"
+	"errors"
 	"testing"
 	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
+	mysqlDriver "github.com/go-sql-driver/mysql"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 	"gorm.io/driver/mysql"
@@ -104,48 +106,179 @@ func Test_FindVitalByPatientIDAndRecordedAtAndVitalType(t *testing.T) {
 	}
 }
 
-func Test_CreateVital(t *testing.T) {
+func Test_CreateVitals(t *testing.T) {
 	beforeEachVital(t)
 
 	now := time.Now().UTC()
+	models := []*vital.Vital{
+		{PatientID: "P00001234", RecordedAt: now, VitalType: "HR", Value: 110.0, Version: 1, CreatedAt: now},
+		{PatientID: "P00001234", RecordedAt: now, VitalType: "RR", Value: 20.0, Version: 1, CreatedAt: now},
+	}
+
 	vitalSQLMock.ExpectBegin()
 	vitalSQLMock.ExpectExec("INSERT INTO .*vitals.*").
-		WillReturnResult(sqlmock.NewResult(1, 1))
+		WillReturnResult(sqlmock.NewResult(0, 2))
 	vitalSQLMock.ExpectCommit()
 
-	err := vitalRepo.CreateVital(context.Background(), &vital.Vital{
-		PatientID:  "P00001234",
-		RecordedAt: time.Date(2025, 12, 1, 10, 15, 0, 0, time.UTC),
-		VitalType:  "HR",
-		Value:      110.0,
-		Version:    1,
-		CreatedAt:  now,
-		UpdatedAt:  &now,
-	})
-	require.NoError(t, err)
+	require.NoError(t, vitalRepo.CreateVitals(context.Background(), models))
+	require.NoError(t, vitalRepo.CreateVitals(context.Background(), nil))
+	require.NoError(t, vitalSQLMock.ExpectationsWereMet())
 }
 
-func Test_UpdateVital(t *testing.T) {
-	beforeEachVital(t)
-
+func Test_UpsertVital(t *testing.T) {
+	recordedAt := time.Date(2025, 12, 1, 10, 15, 0, 0, time.UTC)
 	now := time.Now().UTC()
-	updateModel := &vital.Vital{
-		PatientID:  "P00001234",
-		RecordedAt: time.Date(2025, 12, 1, 10, 15, 0, 0, time.UTC),
-		VitalType:  "HR",
-		Value:      120.0,
-		Version:    2,
-		CreatedAt:  now,
-		UpdatedAt:  &now,
+	encounterID := "enc-1"
+
+	expectFind := func(rows *sqlmock.Rows, err error) {
+		query := vitalSQLMock.ExpectQuery("SELECT .* FROM .*vitals.*").
+			WithArgs("P00001234", recordedAt, "HR", 1)
+		if err != nil {
+			query.WillReturnError(err)
+			return
+		}
+		query.WillReturnRows(rows)
 	}
 
-	vitalSQLMock.ExpectBegin()
-	vitalSQLMock.ExpectExec("UPDATE .*vitals.*").
-		WillReturnResult(sqlmock.NewResult(1, 1))
-	vitalSQLMock.ExpectCommit()
+	tests := []struct {
+		name       string
+		version    int
+		insertOnly bool
+		setupMock  func()
+		wantResult vital.UpsertResult
+		wantCode   pkgError.Code
+	}{
+		{
+			name:    "성공 - 신규 저장",
+			version: 1,
+			setupMock: func() {
+				vitalSQLMock.ExpectExec("INSERT INTO vitals .* ON DUPLICATE KEY UPDATE .*").
+					WithArgs("P00001234", recordedAt, "HR", 110.0, &encounterID, now, now, false).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+			wantResult: vital.UpsertInserted,
+		},
+		{
+			name:    "성공 - version 1 데이터 수정",
+			version: 1,
+			setupMock: func() {
+				vitalSQLMock.ExpectExec("INSERT INTO vitals .* ON DUPLICATE KEY UPDATE .*").
+					WillReturnResult(sqlmock.NewResult(0, 2))
+			},
+			wantResult: vital.UpsertUpdated,
+		},
+		{
+			name:    "실패 - version 1 이 아닌 기존 데이터는 변경되지 않음",
+			version: 1,
+			setupMock: func() {
+				vitalSQLMock.ExpectExec("INSERT INTO vitals .* ON DUPLICATE KEY UPDATE .*").
+					WillReturnResult(sqlmock.NewResult(0, 0))
+			},
+			wantCode: pkgError.Conflict,
+		},
+		{
+			name:    "실패 - 중복 key 오류는 Conflict",
+			version: 1,
+			setupMock: func() {
+				vitalSQLMock.ExpectExec("INSERT INTO vitals .* ON DUPLICATE KEY UPDATE .*").
+					WillReturnError(&mysqlDriver.MySQLError{Number: mysqlErrDuplicateEntry, Message: "Duplicate entry"})
+			},
+			wantCode: pkgError.Conflict,
+		},
+		{
+			name:    "성공 - version 일치 시 수정",
+			version: 2,
+			setupMock: func() {
+				vitalSQLMock.ExpectBegin()
+				vitalSQLMock.ExpectExec("UPDATE .*vitals.* SET .*version.* WHERE .*version = .*").
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				vitalSQLMock.ExpectCommit()
+			},
+			wantResult: vital.UpsertUpdated,
+		},
+		{
+			name:    "실패 - version 불일치",
+			version: 2,
+			setupMock: func() {
+				vitalSQLMock.ExpectBegin()
+				vitalSQLMock.ExpectExec("UPDATE .*vitals.*").
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				vitalSQLMock.ExpectCommit()
+				expectFind(sqlmock.NewRows([]string{"patient_id", "recorded_at", "vital_type", "version"}).
+					AddRow("P00001234", recordedAt, "HR", 3), nil)
+			},
+			wantCode: pkgError.Conflict,
+		},
+		{
+			name:    "실패 - version 1 이 아닌데 데이터 없음",
+			version: 2,
+			setupMock: func() {
+				vitalSQLMock.ExpectBegin()
+				vitalSQLMock.ExpectExec("UPDATE .*vitals.*").
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				vitalSQLMock.ExpectCommit()
+				expectFind(nil, gorm.ErrRecordNotFound)
+			},
+			wantCode: pkgError.NotFound,
+		},
+		{
+			name:       "성공 - 신규 저장만 수행",
+			version:    1,
+			insertOnly: true,
+			setupMock: func() {
+				vitalSQLMock.ExpectExec("INSERT INTO vitals .* ON DUPLICATE KEY UPDATE .*").
+					WithArgs("P00001234", recordedAt, "HR", 110.0, &encounterID, now, now).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+			wantResult: vital.UpsertInserted,
+		},
+		{
+			name:       "성공 - soft delete 된 데이터를 되살림",
+			version:    1,
+			insertOnly: true,
+			setupMock: func() {
+				vitalSQLMock.ExpectExec("INSERT INTO vitals .* ON DUPLICATE KEY UPDATE .*").
+					WillReturnResult(sqlmock.NewResult(0, 2))
+			},
+			wantResult: vital.UpsertInserted,
+		},
+		{
+			name:       "실패 - 신규 저장만 수행하는데 데이터 존재",
+			version:    1,
+			insertOnly: true,
+			setupMock: func() {
+				vitalSQLMock.ExpectExec("INSERT INTO vitals .* ON DUPLICATE KEY UPDATE .*").
+					WillReturnResult(sqlmock.NewResult(0, 0))
+			},
+			wantCode: pkgError.Conflict,
+		},
+	}
 
-	err := vitalRepo.UpdateVital(context.Background(), updateModel)
-	require.NoError(t, err)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			beforeEachVital(t)
+			tt.setupMock()
+
+			result, err := vitalRepo.UpsertVital(context.Background(), vital.UpsertVitalParam{
+				PatientID:   "P00001234",
+				RecordedAt:  recordedAt,
+				VitalType:   "HR",
+				Value:       110.0,
+				Version:     tt.version,
+				EncounterID: &encounterID,
+				InsertOnly:  tt.insertOnly,
+				Now:         now,
+			})
+
+			if tt.wantCode != 0 {
+				require.True(t, pkgError.CompareBusinessError(err, tt.wantCode), err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.wantResult, result)
+			}
+			require.NoError(t, vitalSQLMock.ExpectationsWereMet())
+		})
+	}
 }
 
 func Test_FindVitalsByPatientIDAndDateRange(t *testing.T) {
@@ -236,3 +369,112 @@ func Test_FindVitalsByPatientIDAndDateRange(t *testing.T) {
 		})
 	}
 }
+
+func Test_StreamVitalsByPatientIDsAndDateRange(t *testing.T) {
+	from := time.Date(2025, 12, 1, 10, 0, 0, 0, time.UTC)
+	to := time.Date(2025, 12, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		fn        func(*vital.Vital) error
+		setupMock func()
+		wantCount int
+		wantErr   bool
+	}{
+		{
+			name: "성공 - cursor 순서대로 전달",
+			setupMock: func() {
+				rows := sqlmock.NewRows([]string{"patient_id", "recorded_at", "vital_type", "value", "version", "created_at"}).
+					AddRow("P00001234", time.Date(2025, 12, 1, 10, 15, 0, 0, time.UTC), "HR", 110.0, 1, time.Now().UTC()).
+					AddRow("P00005678", time.Date(2025, 12, 1, 11, 15, 0, 0, time.UTC), "HR", 90.0, 1, time.Now().UTC())
+				vitalSQLMock.ExpectQuery("SELECT .* FROM .*vitals.* WHERE .*patient_id IN .* ORDER BY patient_id ASC,recorded_at ASC").
+					WithArgs("P00001234", "P00005678", from, to, "HR").
+					WillReturnRows(rows)
+			},
+			wantCount: 2,
+		},
+		{
+			name: "실패 - callback 에러 시 중단",
+			fn: func(*vital.Vital) error {
+				return pkgError.WrapWithCode(pkgError.EmptyBusinessError(), pkgError.Get)
+			},
+			setupMock: func() {
+				rows := sqlmock.NewRows([]string{"patient_id", "recorded_at", "vital_type", "value", "version", "created_at"}).
+					AddRow("P00001234", time.Date(2025, 12, 1, 10, 15, 0, 0, time.UTC), "HR", 110.0, 1, time.Now().UTC())
+				vitalSQLMock.ExpectQuery("SELECT .* FROM .*vitals.*").
+					WillReturnRows(rows)
+			},
+			wantErr: true,
+		},
+		{
+			name: "실패 - DB 에러",
+			setupMock: func() {
+				vitalSQLMock.ExpectQuery("SELECT .* FROM .*vitals.*").
+					WillReturnError(gorm.ErrInvalidDB)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			beforeEachVital(t)
+			tt.setupMock()
+
+			count := 0
+			fn := tt.fn
+			if fn == nil {
+				fn = func(v *vital.Vital) error {
+					count++
+					return nil
+				}
+			}
+
+			err := vitalRepo.StreamVitalsByPatientIDsAndDateRange(context.Background(), vital.StreamVitalsByPatientIDsAndDateRangeParam{
+				PatientIDs: []string{"P00001234", "P00005678"},
+				From:       from,
+				To:         to,
+				VitalTypes: []string{"HR"},
+			}, fn)
+
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.wantCount, count)
+			}
+		})
+	}
+}
+
+func Test_FindLatestVitalsByPatientIDs(t *testing.T) {
+	beforeEachVital(t)
+
+	rows := sqlmock.NewRows([]string{"patient_id", "recorded_at", "vital_type", "value"}).
+		AddRow("P00001", time.Now().UTC(), "HR", 88.0)
+	from := time.Now().UTC().Add(-24 * time.Hour)
+	vitalSQLMock.ExpectQuery("SELECT v\\.\\* FROM vitals AS v JOIN \\(SELECT patient_id, vital_type, MAX\\(recorded_at\\) .* WHERE \\(patient_id IN \\(\\?,\\?\\) AND encounter_id IN \\(\\?,\\?\\) AND recorded_at >= \\?\\) .* GROUP BY .*\\) AS latest ON .* WHERE \\(v\\.encounter_id IN \\(\\?,\\?\\) AND v\\.recorded_at >= \\?\\)").
+		WithArgs("P00001", "P00002", "enc-1", "enc-2", from, "enc-1", "enc-2", from).
+		WillReturnRows(rows)
+
+	results, err := vitalRepo.FindLatestVitalsByPatientIDs(context.Background(), vital.FindLatestVitalsByPatientIDsParam{
+		PatientIDs:   []string{"P00001", "P00002"},
+		EncounterIDs: []string{"enc-1", "enc-2"},
+		From:         from,
+	})
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+}
+
+func Test_PurgeDeletedVitals(t *testing.T) {
+	beforeEachVital(t)
+
+	vitalSQLMock.ExpectBegin()
+	vitalSQLMock.ExpectExec("DELETE FROM .*vitals.* WHERE deleted_at IS NOT NULL AND deleted_at < .*").
+		WillReturnError(errors.New("db error"))
+	vitalSQLMock.ExpectRollback()
+
+	_, err := vitalRepo.PurgeDeletedVitals(context.Background(), time.Now().UTC())
+	require.Error(t, err)
+	require.True(t, pkgError.CompareBusinessError(err, pkgError.Delete))
+}
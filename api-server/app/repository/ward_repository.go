@@ -0,0 +1,216 @@
+package repository
+
+import (
+	"aitrics-vital-signs/api-server/domain"
+	"aitrics-vital-signs/api-server/domain/encounter"
+	"aitrics-vital-signs/api-server/domain/ward"
+	pkgError "aitrics-vital-signs/library/error"
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+)
+
+type wardRepository struct {
+	externalGormClient domain.ExternalDBClient
+}
+
+func (w *wardRepository) CreateWard(ctx context.Context, model *ward.Ward) error {
+	return wrapWriteError(conn(ctx, w.externalGormClient).Create(model).Error, pkgError.Create)
+}
+
+func (w *wardRepository) FindWardByID(ctx context.Context, wardID string) (*ward.Ward, error) {
+	var result ward.Ward
+	if err := conn(ctx, w.externalGormClient).
+		Where("id = ?", wardID).
+		First(&result).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, pkgError.WrapWithCode(err, pkgError.NotFound)
+		}
+		return nil, pkgError.WrapWithCode(err, pkgError.Get)
+	}
+	return &result, nil
+}
+
+func (w *wardRepository) FindWards(ctx context.Context) ([]ward.Ward, error) {
+	var results []ward.Ward
+	if err := conn(ctx, w.externalGormClient).
+		Order("code ASC").
+		Find(&results).Error; err != nil {
+		return nil, pkgError.WrapWithCode(err, pkgError.Get)
+	}
+	return results, nil
+}
+
+func (w *wardRepository) CreateBed(ctx context.Context, model *ward.Bed) error {
+	return wrapWriteError(conn(ctx, w.externalGormClient).Create(model).Error, pkgError.Create)
+}
+
+func (w *wardRepository) FindBedByID(ctx context.Context, bedID string) (*ward.Bed, error) {
+	var result ward.Bed
+	if err := conn(ctx, w.externalGormClient).
+		Where("id = ?", bedID).
+		First(&result).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, pkgError.WrapWithCode(err, pkgError.NotFound)
+		}
+		return nil, pkgError.WrapWithCode(err, pkgError.Get)
+	}
+	return &result, nil
+}
+
+func (w *wardRepository) FindBedsByWardID(ctx context.Context, wardID string) ([]ward.Bed, error) {
+	var results []ward.Bed
+	if err := conn(ctx, w.externalGormClient).
+		Where("ward_id = ?", wardID).
+		Order("label ASC").
+		Find(&results).Error; err != nil {
+		return nil, pkgError.WrapWithCode(err, pkgError.Get)
+	}
+	return results, nil
+}
+
+func (w *wardRepository) FindActiveBedAssignmentByPatientID(ctx context.Context, patientID string) (*ward.BedAssignment, error) {
+	var result ward.BedAssignment
+	if err := conn(ctx, w.externalGormClient).
+		Where("active_patient_id = ?", patientID).
+		First(&result).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, pkgError.WrapWithCode(err, pkgError.NotFound)
+		}
+		return nil, pkgError.WrapWithCode(err, pkgError.Get)
+	}
+	return &result, nil
+}
+
+func (w *wardRepository) FindActiveBedAssignmentByBedID(ctx context.Context, bedID string) (*ward.BedAssignment, error) {
+	var result ward.BedAssignment
+	if err := conn(ctx, w.externalGormClient).
+		Where("active_bed_id = ?", bedID).
+		First(&result).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, pkgError.WrapWithCode(err, pkgError.NotFound)
+		}
+		return nil, pkgError.WrapWithCode(err, pkgError.Get)
+	}
+	return &result, nil
+}
+
+func (w *wardRepository) FindActiveBedAssignmentsByWardID(ctx context.Context, wardID string) ([]ward.BedAssignment, error) {
+	var results []ward.BedAssignment
+	if err := conn(ctx, w.externalGormClient).
+		Where("ward_id = ? AND released_at IS NULL", wardID).
+		Find(&results).Error; err != nil {
+		return nil, pkgError.WrapWithCode(err, pkgError.Get)
+	}
+	return results, nil
+}
+
+func (w *wardRepository) FindBedAssignmentsByPatientID(ctx context.Context, patientID string) ([]ward.BedAssignment, error) {
+	var results []ward.BedAssignment
+	if err := conn(ctx, w.externalGormClient).
+		Where("patient_id = ?", patientID).
+		Order("assigned_at DESC").
+		Find(&results).Error; err != nil {
+		return nil, pkgError.WrapWithCode(err, pkgError.Get)
+	}
+	return results, nil
+}
+
+func (w *wardRepository) CreateBedAssignment(ctx context.Context, model *ward.BedAssignment, newEncounter *encounter.Encounter) error {
+	// 입원 시 encounter 생성과 병상 배정을 service 의 트랜잭션에서 함께 처리
+	return withinTx(ctx, func(tx *gorm.DB) error {
+		if newEncounter != nil {
+			if err := tx.Create(newEncounter).Error; err != nil {
+				return wrapWriteError(err, pkgError.Create)
+			}
+		}
+
+		if err := tx.Create(model).Error; err != nil {
+			return wrapWriteError(err, pkgError.Create)
+		}
+
+		return nil
+	})
+}
+
+func (w *wardRepository) ReleaseBedAssignment(ctx context.Context, model *ward.BedAssignment, closedEncounter *encounter.Encounter) error {
+	// 퇴원 시 병상 배정 해제와 encounter 종료를 service 의 트랜잭션에서 함께 처리
+	return withinTx(ctx, func(tx *gorm.DB) error {
+		if err := releaseBedAssignment(tx, model); err != nil {
+			return err
+		}
+
+		if closedEncounter == nil {
+			return nil
+		}
+
+		result := tx.Model(&encounter.Encounter{}).
+			Where("id = ? AND discharged_at IS NULL", closedEncounter.ID).
+			Updates(map[string]interface{}{
+				"active_patient_id": nil,
+				"discharged_at":     closedEncounter.DischargedAt,
+				"updated_at":        closedEncounter.UpdatedAt,
+			})
+		if result.Error != nil {
+			return wrapWriteError(result.Error, pkgError.Update)
+		}
+		if result.RowsAffected == 0 {
+			return pkgError.WrapWithCode(pkgError.EmptyBusinessError(), pkgError.Conflict, "encounter is already closed")
+		}
+
+		return nil
+	})
+}
+
+func (w *wardRepository) TransferBedAssignment(ctx context.Context, current *ward.BedAssignment, next *ward.BedAssignment) error {
+	// 기존 배정 해제와 신규 배정을 service 의 트랜잭션에서 함께 처리
+	return withinTx(ctx, func(tx *gorm.DB) error {
+		if err := releaseBedAssignment(tx, current); err != nil {
+			return err
+		}
+
+		if err := tx.Create(next).Error; err != nil {
+			return wrapWriteError(err, pkgError.Create)
+		}
+
+		// encounter 의 현재 병동 갱신
+		if err := tx.Model(&encounter.Encounter{}).
+			Where("id = ?", next.EncounterID).
+			Updates(map[string]interface{}{
+				"ward_id":    next.WardID,
+				"updated_at": next.UpdatedAt,
+			}).Error; err != nil {
+			return wrapWriteError(err, pkgError.Update)
+		}
+
+		return nil
+	})
+}
+
+// releaseBedAssignment 아직 해제되지 않은 배정만 해제하며, 이미 해제된 경우 conflict 를 반환합니다.
+func releaseBedAssignment(db *gorm.DB, model *ward.BedAssignment) error {
+	result := db.Model(&ward.BedAssignment{}).
+		Where("id = ? AND released_at IS NULL", model.ID).
+		Updates(map[string]interface{}{
+			"active_bed_id":     nil,
+			"active_patient_id": nil,
+			"release_reason":    model.ReleaseReason,
+			"released_at":       model.ReleasedAt,
+			"updated_at":        model.UpdatedAt,
+		})
+
+	if result.Error != nil {
+		return wrapWriteError(result.Error, pkgError.Update)
+	}
+
+	if result.RowsAffected == 0 {
+		return pkgError.WrapWithCode(pkgError.EmptyBusinessError(), pkgError.Conflict, "bed assignment is already released")
+	}
+
+	return nil
+}
+
+func NewWardRepository(externalGormClient domain.ExternalDBClient) ward.WardRepository {
+	return &wardRepository{externalGormClient: externalGormClient}
+}
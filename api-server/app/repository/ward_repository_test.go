@@ -0,0 +1,173 @@
+package repository
+
+import (
+	"aitrics-vital-signs/api-server/domain"
+	"aitrics-vital-signs/api-server/domain/encounter"
+	"aitrics-vital-signs/api-server/domain/mock"
+	"aitrics-vital-signs/api-server/domain/ward"
+	pkgError "aitrics-vital-signs/library/error"
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+)
+
+var wardRepo ward.WardRepository
+var wardTransactor domain.Transactor
+var wardSQLMock sqlmock.Sqlmock
+
+func beforeEachWard(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockExternalDBClient := mock.NewMockExternalDBClient(ctrl)
+
+	sqlDB, mockSQL, err := sqlmock.New()
+	require.NoError(t, err)
+
+	dial := mysql.New(mysql.Config{
+		Conn:                      sqlDB,
+		SkipInitializeWithVersion: true,
+	})
+	db, err := gorm.Open(dial, &gorm.Config{SkipDefaultTransaction: true})
+	require.NoError(t, err)
+
+	mockExternalDBClient.EXPECT().MySQL().Return(db).AnyTimes()
+	wardRepo = NewWardRepository(mockExternalDBClient)
+	wardTransactor = NewTransactor(mockExternalDBClient, sql.LevelDefault)
+	wardSQLMock = mockSQL
+}
+
+func Test_FindActiveBedAssignmentByPatientID(t *testing.T) {
+	beforeEachWard(t)
+
+	wardSQLMock.ExpectQuery("SELECT .* FROM .*bed_assignments.* WHERE active_patient_id = .*").
+		WithArgs("P00001", 1).
+		WillReturnError(gorm.ErrRecordNotFound)
+
+	_, err := wardRepo.FindActiveBedAssignmentByPatientID(context.Background(), "P00001")
+	require.True(t, pkgError.CompareBusinessError(err, pkgError.NotFound))
+	require.NoError(t, wardSQLMock.ExpectationsWereMet())
+}
+
+func Test_TransferBedAssignment(t *testing.T) {
+	now := time.Now().UTC()
+	reason := "TRANSFER"
+	bedID, patientID := "bed-2", "P00001"
+
+	tests := []struct {
+		name      string
+		setupMock func()
+		wantCode  pkgError.Code
+	}{
+		{
+			name: "성공 - 해제와 신규 배정을 한 트랜잭션으로 처리",
+			setupMock: func() {
+				wardSQLMock.ExpectBegin()
+				wardSQLMock.ExpectExec("UPDATE .*bed_assignments.* WHERE id = .* AND released_at IS NULL").
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				wardSQLMock.ExpectExec("INSERT INTO .*bed_assignments.*").
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				wardSQLMock.ExpectExec("UPDATE .*encounters.* SET .*ward_id.* WHERE id = .*").
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				wardSQLMock.ExpectCommit()
+			},
+		},
+		{
+			name: "실패 - 이미 해제된 배정이면 rollback",
+			setupMock: func() {
+				wardSQLMock.ExpectBegin()
+				wardSQLMock.ExpectExec("UPDATE .*bed_assignments.*").
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				wardSQLMock.ExpectRollback()
+			},
+			wantCode: pkgError.Conflict,
+		},
+		{
+			name: "실패 - 병상 중복 배정(unique index) 이면 rollback",
+			setupMock: func() {
+				wardSQLMock.ExpectBegin()
+				wardSQLMock.ExpectExec("UPDATE .*bed_assignments.*").
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				wardSQLMock.ExpectExec("INSERT INTO .*bed_assignments.*").
+					WillReturnError(errors.New("Duplicate entry 'bed-2' for key 'idx_bed_assignments_active_bed_id'"))
+				wardSQLMock.ExpectRollback()
+			},
+			wantCode: pkgError.Create,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			beforeEachWard(t)
+			tt.setupMock()
+
+			err := wardTransactor.WithinTransaction(context.Background(), func(ctx context.Context) error {
+				return wardRepo.TransferBedAssignment(ctx,
+					&ward.BedAssignment{ID: "a-1", ReleaseReason: &reason, ReleasedAt: &now, UpdatedAt: &now},
+					&ward.BedAssignment{
+						ID:              "a-2",
+						PatientID:       patientID,
+						WardID:          "ward-1",
+						BedID:           bedID,
+						EncounterID:     "enc-1",
+						ActiveBedID:     &bedID,
+						ActivePatientID: &patientID,
+						AssignReason:    reason,
+						AssignedAt:      now,
+						CreatedAt:       now,
+					},
+				)
+			})
+
+			if tt.wantCode != 0 {
+				require.True(t, pkgError.CompareBusinessError(err, tt.wantCode))
+			} else {
+				require.NoError(t, err)
+			}
+			require.NoError(t, wardSQLMock.ExpectationsWereMet())
+		})
+	}
+}
+
+func Test_ReleaseBedAssignment(t *testing.T) {
+	beforeEachWard(t)
+
+	now := time.Now().UTC()
+	reason := "DISCHARGE"
+
+	// 배정 해제와 encounter 종료를 한 트랜잭션으로 처리
+	wardSQLMock.ExpectBegin()
+	wardSQLMock.ExpectExec("UPDATE .*bed_assignments.* WHERE id = .* AND released_at IS NULL").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	wardSQLMock.ExpectExec("UPDATE .*encounters.* WHERE id = .* AND discharged_at IS NULL").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	wardSQLMock.ExpectCommit()
+
+	err := wardTransactor.WithinTransaction(context.Background(), func(ctx context.Context) error {
+		return wardRepo.ReleaseBedAssignment(ctx,
+			&ward.BedAssignment{ID: "a-1", EncounterID: "enc-1", ReleaseReason: &reason, ReleasedAt: &now, UpdatedAt: &now},
+			&encounter.Encounter{ID: "enc-1", DischargedAt: &now, UpdatedAt: &now},
+		)
+	})
+	require.NoError(t, err)
+	require.NoError(t, wardSQLMock.ExpectationsWereMet())
+}
+
+func Test_ReleaseBedAssignment_RequiresTransaction(t *testing.T) {
+	beforeEachWard(t)
+
+	// 트랜잭션 밖에서 호출하면 일부만 반영되지 않도록 query 를 실행하지 않음
+	now := time.Now().UTC()
+	err := wardRepo.ReleaseBedAssignment(context.Background(),
+		&ward.BedAssignment{ID: "a-1", EncounterID: "enc-1", ReleasedAt: &now, UpdatedAt: &now},
+		&encounter.Encounter{ID: "enc-1", DischargedAt: &now, UpdatedAt: &now},
+	)
+	require.True(t, pkgError.CompareBusinessError(err, pkgError.Transaction))
+	require.NoError(t, wardSQLMock.ExpectationsWereMet())
+}
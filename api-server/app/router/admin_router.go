@@ -0,0 +1,20 @@
+package router
+
+import (
+	"aitrics-vital-signs/api-server/domain/admin"
+	"aitrics-vital-signs/api-server/domain/auth"
+	"aitrics-vital-signs/api-server/internal/middleware"
+	"aitrics-vital-signs/api-server/pkg/constant"
+
+	"github.com/gin-gonic/gin"
+)
+
+func NewAdminRouter(engine *gin.Engine, controller admin.AdminController, authenticator auth.Authenticator) {
+	v1Group := engine.Group("/api/v1")
+	v1Group.Use(middleware.ValidTokenMiddleware(authenticator), middleware.RequireScope(constant.ScopeAdmin))
+
+	adminGroup := v1Group.Group("/admin")
+	{
+		adminGroup.GET("/config", controller.GetConfig)
+	}
+}
@@ -0,0 +1,22 @@
+package router
+
+import (
+	"aitrics-vital-signs/api-server/domain/apikey"
+	"aitrics-vital-signs/api-server/domain/auth"
+	"aitrics-vital-signs/api-server/internal/middleware"
+	"aitrics-vital-signs/api-server/pkg/constant"
+
+	"github.com/gin-gonic/gin"
+)
+
+func NewAPIKeyRouter(engine *gin.Engine, controller apikey.APIKeyController, authenticator auth.Authenticator) {
+	v1Group := engine.Group("/api/v1")
+	v1Group.Use(middleware.ValidTokenMiddleware(authenticator), middleware.RequireScope(constant.ScopeAdmin))
+
+	apiKeyGroup := v1Group.Group("/api-keys")
+	{
+		apiKeyGroup.POST("", controller.CreateAPIKey)
+		apiKeyGroup.GET("", controller.ListAPIKeys)
+		apiKeyGroup.DELETE("/:key_id", controller.RevokeAPIKey)
+	}
+}
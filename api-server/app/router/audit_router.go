@@ -0,0 +1,21 @@
+package router
+
+import (
+	"aitrics-vital-signs/api-server/domain/audit"
+	"aitrics-vital-signs/api-server/domain/auth"
+	"aitrics-vital-signs/api-server/internal/middleware"
+	"aitrics-vital-signs/api-server/pkg/constant"
+
+	"github.com/gin-gonic/gin"
+)
+
+func NewAuditRouter(engine *gin.Engine, controller audit.AuditController, authenticator auth.Authenticator) {
+	v1Group := engine.Group("/api/v1")
+	v1Group.Use(middleware.ValidTokenMiddleware(authenticator))
+
+	auditGroup := v1Group.Group("/audit-events")
+	{
+		auditGroup.GET("", middleware.RequireScope(constant.ScopeAdmin), controller.GetAuditEvents)
+		auditGroup.GET("/export", middleware.RequireScope(constant.ScopeAdmin), controller.ExportAuditEvents)
+	}
+}
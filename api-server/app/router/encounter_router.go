@@ -0,0 +1,20 @@
+package router
+
+import (
+	"aitrics-vital-signs/api-server/domain/auth"
+	"aitrics-vital-signs/api-server/domain/encounter"
+	"aitrics-vital-signs/api-server/internal/middleware"
+	"aitrics-vital-signs/api-server/pkg/constant"
+
+	"github.com/gin-gonic/gin"
+)
+
+func NewEncounterRouter(engine *gin.Engine, controller encounter.EncounterController, authenticator auth.Authenticator) {
+	v1Group := engine.Group("/api/v1")
+	v1Group.Use(middleware.ValidTokenMiddleware(authenticator))
+
+	patientGroup := v1Group.Group("/patients")
+	{
+		patientGroup.GET("/:patient_id/encounters", middleware.RequireScope(constant.ScopePatientsRead), controller.GetPatientEncounters)
+	}
+}
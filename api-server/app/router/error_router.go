@@ -0,0 +1,12 @@
+package router
+
+import (
+	"aitrics-vital-signs/api-server/internal/output"
+
+	"github.com/gin-gonic/gin"
+)
+
+// NewErrorRouter error code catalog 는 Problem 응답의 type 이 가리키는 문서이므로 인증 없이 조회합니다.
+func NewErrorRouter(engine *gin.Engine) {
+	engine.GET(output.ErrorCatalogPath, output.ErrorCatalog)
+}
@@ -0,0 +1,13 @@
+package router
+
+import (
+	"aitrics-vital-signs/api-server/domain/health"
+
+	"github.com/gin-gonic/gin"
+)
+
+// NewHealthRouter probe 용 endpoint 는 인증 / 감사 기록 대상이 아닙니다.
+func NewHealthRouter(engine *gin.Engine, controller health.HealthController) {
+	engine.GET("/healthz", controller.Healthz)
+	engine.GET("/readyz", controller.Readyz)
+}
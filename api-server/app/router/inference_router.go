@@ -1,18 +1,20 @@
 package router
 
 import (
+	"aitrics-vital-signs/api-server/domain/auth"
 	"aitrics-vital-signs/api-server/domain/inference"
 	"aitrics-vital-signs/api-server/internal/middleware"
+	"aitrics-vital-signs/api-server/pkg/constant"
 
 	"github.com/gin-gonic/gin"
 )
 
-func NewInferenceRouter(engine *gin.Engine, controller inference.InferenceController) {
+func NewInferenceRouter(engine *gin.Engine, controller inference.InferenceController, authenticator auth.Authenticator) {
 	v1Group := engine.Group("/api/v1")
-	v1Group.Use(middleware.ValidTokenMiddleware())
+	v1Group.Use(middleware.ValidTokenMiddleware(authenticator))
 
 	inferenceGroup := v1Group.Group("/inference")
 	{
-		inferenceGroup.POST("/vital-risk", controller.CalculateVitalRisk)
+		inferenceGroup.POST("/vital-risk", middleware.RequireScope(constant.ScopeInferenceRun), controller.CalculateVitalRisk)
 	}
 }
@@ -0,0 +1,12 @@
+package router
+
+import (
+	"aitrics-vital-signs/api-server/internal/metrics"
+
+	"github.com/gin-gonic/gin"
+)
+
+// NewMetricsRouter Prometheus scrape 용 endpoint 는 인증 / 감사 기록 대상이 아닙니다.
+func NewMetricsRouter(engine *gin.Engine) {
+	engine.GET("/metrics", gin.WrapH(metrics.Handler()))
+}
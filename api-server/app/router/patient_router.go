@@ -1,20 +1,29 @@
 package router
 
 import (
+	"aitrics-vital-signs/api-server/domain/auth"
+	"aitrics-vital-signs/api-server/domain/idempotency"
 	"aitrics-vital-signs/api-server/domain/patient"
 	"aitrics-vital-signs/api-server/internal/middleware"
+	"aitrics-vital-signs/api-server/pkg/constant"
 
 	"github.com/gin-gonic/gin"
 )
 
-func NewPatientRouter(engine *gin.Engine, controller patient.PatientController) {
+func NewPatientRouter(engine *gin.Engine, controller patient.PatientController, authenticator auth.Authenticator, idempotencyService idempotency.IdempotencyService) {
 	v1Group := engine.Group("/api/v1")
-	v1Group.Use(middleware.ValidTokenMiddleware())
+	v1Group.Use(middleware.ValidTokenMiddleware(authenticator))
 
 	patientGroup := v1Group.Group("/patients")
 	{
-		patientGroup.POST("", controller.CreatePatient)
-		patientGroup.PUT("/:patient_id", controller.UpdatePatient)
-		patientGroup.GET("/:patient_id/vitals", controller.GetPatientVitals)
+		patientGroup.POST("", middleware.RequireScope(constant.ScopePatientsWrite), middleware.IdempotencyMiddleware(idempotencyService), controller.CreatePatient)
+		patientGroup.GET("/:patient_id", middleware.RequireScope(constant.ScopePatientsRead), controller.GetPatient)
+		patientGroup.PUT("/:patient_id", middleware.RequireScope(constant.ScopePatientsWrite), controller.UpdatePatient)
+		patientGroup.GET("/:patient_id/vitals", middleware.RequireScope(constant.ScopeVitalsRead), controller.GetPatientVitals)
+		// 등록 오류 정정 (병합 / 외부 환자 ID 변경)
+		patientGroup.GET("/:patient_id/merges", middleware.RequireScope(constant.ScopePatientsRead), controller.GetPatientMerges)
+		patientGroup.POST("/:patient_id/merges", middleware.RequireScope(constant.ScopeAdmin), controller.MergePatients)
+		patientGroup.POST("/:patient_id/merges/:merge_id/revert", middleware.RequireScope(constant.ScopeAdmin), controller.RevertPatientMerge)
+		patientGroup.PUT("/:patient_id/patient-id", middleware.RequireScope(constant.ScopeAdmin), controller.ChangePatientID)
 	}
 }
@@ -1,11 +1,12 @@
 package router
 
 import (
+	"aitrics-vital-signs/api-server/domain/auth"
 	"aitrics-vital-signs/api-server/domain/mock"
-	"aitrics-vital-signs/library/envs"
+	"aitrics-vital-signs/api-server/pkg/constant"
+	pkgError "aitrics-vital-signs/library/error"
 	"net/http"
 	"net/http/httptest"
-	"os"
 	"testing"
 
 	"github.com/gin-gonic/gin"
@@ -14,15 +15,14 @@ import (
 )
 
 func Test_ValidToken(t *testing.T) {
-	t.Setenv("TOKEN", "test-token-123")
-	envs.Token = os.Getenv("TOKEN")
 	gin.SetMode(gin.TestMode)
 
 	engine := gin.New()
 
 	ctrl := gomock.NewController(t)
 	patientController := mock.NewMockPatientController(ctrl)
-	NewPatientRouter(engine, patientController)
+	authenticator := mock.NewMockAuthenticator(ctrl)
+	NewPatientRouter(engine, patientController, authenticator, mock.NewMockIdempotencyService(ctrl))
 
 	req := httptest.NewRequest(
 		http.MethodPost,
@@ -35,3 +35,113 @@ func Test_ValidToken(t *testing.T) {
 
 	require.Equal(t, http.StatusUnauthorized, w.Code)
 }
+
+func Test_RequireScope(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		setupMock      func(authenticator *mock.MockAuthenticator, controller *mock.MockPatientController)
+		wantStatusCode int
+	}{
+		{
+			name: "성공 - scope 보유",
+			setupMock: func(authenticator *mock.MockAuthenticator, controller *mock.MockPatientController) {
+				authenticator.EXPECT().
+					Authenticate(gomock.Any(), "test-token-123").
+					Return(&auth.Principal{ID: "key-1", Scopes: []string{constant.ScopePatientsWrite.String()}}, nil)
+				controller.EXPECT().
+					CreatePatient(gomock.Any()).
+					Do(func(ctx *gin.Context) {
+						principal, ok := auth.PrincipalFromContext(ctx.Request.Context())
+						require.True(t, ok)
+						require.Equal(t, "key-1", principal.ID)
+						ctx.Status(http.StatusOK)
+					})
+			},
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name: "성공 - admin scope 는 모든 scope 포함",
+			setupMock: func(authenticator *mock.MockAuthenticator, controller *mock.MockPatientController) {
+				authenticator.EXPECT().
+					Authenticate(gomock.Any(), "test-token-123").
+					Return(&auth.Principal{ID: "key-1", Scopes: []string{constant.ScopeAdmin.String()}}, nil)
+				controller.EXPECT().
+					CreatePatient(gomock.Any()).
+					Do(func(ctx *gin.Context) {
+						ctx.Status(http.StatusOK)
+					})
+			},
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name: "실패 - scope 없음 (403)",
+			setupMock: func(authenticator *mock.MockAuthenticator, controller *mock.MockPatientController) {
+				authenticator.EXPECT().
+					Authenticate(gomock.Any(), "test-token-123").
+					Return(&auth.Principal{ID: "key-1", Scopes: []string{constant.ScopePatientsRead.String()}}, nil)
+			},
+			wantStatusCode: http.StatusForbidden,
+		},
+		{
+			name: "실패 - 폐기된 키 (401)",
+			setupMock: func(authenticator *mock.MockAuthenticator, controller *mock.MockPatientController) {
+				authenticator.EXPECT().
+					Authenticate(gomock.Any(), "test-token-123").
+					Return(nil, pkgError.WrapWithCode(pkgError.EmptyBusinessError(), pkgError.Unauthorized, "api key is revoked"))
+			},
+			wantStatusCode: http.StatusUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			engine := gin.New()
+
+			ctrl := gomock.NewController(t)
+			patientController := mock.NewMockPatientController(ctrl)
+			authenticator := mock.NewMockAuthenticator(ctrl)
+			tt.setupMock(authenticator, patientController)
+			NewPatientRouter(engine, patientController, authenticator, mock.NewMockIdempotencyService(ctrl))
+
+			req := httptest.NewRequest(
+				http.MethodPost,
+				"/api/v1/patients",
+				nil,
+			)
+			req.Header.Set("Authorization", "Bearer test-token-123")
+			w := httptest.NewRecorder()
+
+			engine.ServeHTTP(w, req)
+
+			require.Equal(t, tt.wantStatusCode, w.Code)
+		})
+	}
+}
+
+func Test_MergeRequiresAdmin(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	engine := gin.New()
+
+	ctrl := gomock.NewController(t)
+	patientController := mock.NewMockPatientController(ctrl)
+	authenticator := mock.NewMockAuthenticator(ctrl)
+	authenticator.EXPECT().
+		Authenticate(gomock.Any(), "test-token-123").
+		Return(&auth.Principal{ID: "key-1", Scopes: []string{constant.ScopePatientsWrite.String()}}, nil)
+	NewPatientRouter(engine, patientController, authenticator, mock.NewMockIdempotencyService(ctrl))
+
+	req := httptest.NewRequest(
+		http.MethodPost,
+		"/api/v1/patients/P00000001/merges",
+		nil,
+	)
+	req.Header.Set("Authorization", "Bearer test-token-123")
+	w := httptest.NewRecorder()
+
+	engine.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusForbidden, w.Code)
+}
@@ -1,18 +1,23 @@
 package router
 
 import (
+	"aitrics-vital-signs/api-server/domain/auth"
+	"aitrics-vital-signs/api-server/domain/idempotency"
 	"aitrics-vital-signs/api-server/domain/vital"
 	"aitrics-vital-signs/api-server/internal/middleware"
+	"aitrics-vital-signs/api-server/pkg/constant"
 
 	"github.com/gin-gonic/gin"
 )
 
-func NewVitalRouter(engine *gin.Engine, controller vital.VitalController) {
+func NewVitalRouter(engine *gin.Engine, controller vital.VitalController, authenticator auth.Authenticator, idempotencyService idempotency.IdempotencyService) {
 	v1Group := engine.Group("/api/v1")
-	v1Group.Use(middleware.ValidTokenMiddleware())
+	v1Group.Use(middleware.ValidTokenMiddleware(authenticator))
 
 	vitalGroup := v1Group.Group("/vitals")
 	{
-		vitalGroup.POST("", controller.UpsertVital)
+		vitalGroup.POST("", middleware.RequireScope(constant.ScopeVitalsWrite), middleware.IdempotencyMiddleware(idempotencyService), controller.UpsertVital)
+		vitalGroup.GET("/:patient_id/:vital_type/:recorded_at", middleware.RequireScope(constant.ScopeVitalsRead), controller.GetVital)
+		vitalGroup.GET("/export", middleware.RequireScope(constant.ScopeVitalsRead), controller.ExportVitals)
 	}
 }
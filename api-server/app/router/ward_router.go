@@ -0,0 +1,31 @@
+package router
+
+import (
+	"aitrics-vital-signs/api-server/domain/auth"
+	"aitrics-vital-signs/api-server/domain/ward"
+	"aitrics-vital-signs/api-server/internal/middleware"
+	"aitrics-vital-signs/api-server/pkg/constant"
+
+	"github.com/gin-gonic/gin"
+)
+
+func NewWardRouter(engine *gin.Engine, controller ward.WardController, authenticator auth.Authenticator) {
+	v1Group := engine.Group("/api/v1")
+	v1Group.Use(middleware.ValidTokenMiddleware(authenticator))
+
+	wardGroup := v1Group.Group("/wards")
+	{
+		wardGroup.POST("", middleware.RequireScope(constant.ScopeAdmin), controller.CreateWard)
+		wardGroup.GET("", middleware.RequireScope(constant.ScopePatientsRead), controller.ListWards)
+		wardGroup.POST("/:ward_id/beds", middleware.RequireScope(constant.ScopeAdmin), controller.CreateBed)
+		wardGroup.GET("/:ward_id/patients", middleware.RequireScope(constant.ScopePatientsRead, constant.ScopeVitalsRead), controller.GetWardPatients)
+	}
+
+	patientGroup := v1Group.Group("/patients")
+	{
+		patientGroup.POST("/:patient_id/admission", middleware.RequireScope(constant.ScopePatientsWrite), controller.AdmitPatient)
+		patientGroup.POST("/:patient_id/transfer", middleware.RequireScope(constant.ScopePatientsWrite), controller.TransferPatient)
+		patientGroup.POST("/:patient_id/discharge", middleware.RequireScope(constant.ScopePatientsWrite), controller.DischargePatient)
+		patientGroup.GET("/:patient_id/bed-assignments", middleware.RequireScope(constant.ScopePatientsRead), controller.GetPatientBedAssignments)
+	}
+}
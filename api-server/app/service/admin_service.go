@@ -0,0 +1,19 @@
+package service
+
+import (
+	"aitrics-vital-signs/api-server/domain/admin"
+	"aitrics-vital-signs/library/envs"
+	"context"
+)
+
+type adminService struct {
+	currentConfig func() envs.Config
+}
+
+func (a *adminService) GetConfig(ctx context.Context) envs.Config {
+	return a.currentConfig().Redacted()
+}
+
+func NewAdminService(currentConfig func() envs.Config) admin.AdminService {
+	return &adminService{currentConfig: currentConfig}
+}
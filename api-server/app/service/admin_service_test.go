@@ -0,0 +1,22 @@
+package service
+
+import (
+	"aitrics-vital-signs/library/envs"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_GetConfig(t *testing.T) {
+	config := envs.Default()
+	config.DB.Host = "mysql"
+	config.DB.Password = "aitrics1234!"
+	config.Auth.Token = "aitrics-token"
+
+	result := NewAdminService(func() envs.Config { return config }).GetConfig(context.Background())
+
+	require.Equal(t, "mysql", result.DB.Host)
+	require.NotEqual(t, "aitrics1234!", result.DB.Password)
+	require.NotEqual(t, "aitrics-token", result.Auth.Token)
+}
@@ -0,0 +1,156 @@
+package service
+
+import (
+	"aitrics-vital-signs/api-server/domain/apikey"
+	"aitrics-vital-signs/api-server/domain/auth"
+	"aitrics-vital-signs/api-server/pkg/constant"
+	"aitrics-vital-signs/library/envs"
+	pkgError "aitrics-vital-signs/library/error"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	apiKeyPrefix      = "avs_"
+	apiKeyRandomBytes = 32
+	apiKeyDisplayLen  = 12 // 목록에서 키를 식별하기 위한 prefix 길이
+)
+
+type apiKeyService struct {
+	repo apikey.APIKeyRepository
+}
+
+func (a *apiKeyService) CreateAPIKey(ctx context.Context, request apikey.CreateAPIKeyRequest) (*apikey.CreateAPIKeyResponse, error) {
+	now := time.Now().UTC()
+	if request.ExpiresAt != nil && !request.ExpiresAt.After(now) {
+		return nil, pkgError.WrapWithCode(pkgError.EmptyBusinessError(), pkgError.WrongParam, "expires_at must be in the future")
+	}
+
+	rawKey, err := generateAPIKey()
+	if err != nil {
+		return nil, pkgError.WrapWithCode(err, pkgError.Create, "fail to generate api key")
+	}
+
+	conflictPolicy := request.ConflictPolicy
+	if conflictPolicy == "" {
+		conflictPolicy = constant.ConflictPolicyStrict.String()
+	}
+
+	model := &apikey.APIKey{
+		ID:             uuid.NewString(),
+		Name:           request.Name,
+		Prefix:         rawKey[:apiKeyDisplayLen],
+		KeyHash:        hashAPIKey(rawKey),
+		Scopes:         strings.Join(request.Scopes, ","),
+		ConflictPolicy: conflictPolicy,
+		ExpiresAt:      request.ExpiresAt,
+		CreatedAt:      now,
+		UpdatedAt:      &now,
+	}
+	if err := a.repo.CreateAPIKey(ctx, model); err != nil {
+		return nil, pkgError.Wrap(err)
+	}
+
+	return &apikey.CreateAPIKeyResponse{
+		APIKeyResponse: toAPIKeyResponse(model),
+		Key:            rawKey,
+	}, nil
+}
+
+func (a *apiKeyService) ListAPIKeys(ctx context.Context) ([]apikey.APIKeyResponse, error) {
+	models, err := a.repo.FindAPIKeys(ctx)
+	if err != nil {
+		return nil, pkgError.Wrap(err)
+	}
+
+	results := make([]apikey.APIKeyResponse, 0, len(models))
+	for idx := range models {
+		results = append(results, toAPIKeyResponse(&models[idx]))
+	}
+
+	return results, nil
+}
+
+func (a *apiKeyService) RevokeAPIKey(ctx context.Context, id string) error {
+	if err := a.repo.RevokeAPIKey(ctx, id, time.Now().UTC()); err != nil {
+		return pkgError.Wrap(err)
+	}
+
+	return nil
+}
+
+// Authenticate
+// Bearer 토큰을 해시하여 api_keys 에서 조회합니다.
+// TOKEN 환경변수가 설정되어 있다면 키 발급 및 무중단 교체를 위한 bootstrap admin 토큰으로 함께 허용합니다.
+func (a *apiKeyService) Authenticate(ctx context.Context, token string) (*auth.Principal, error) {
+	if envs.Token != "" && subtle.ConstantTimeCompare([]byte(token), []byte(envs.Token)) == 1 {
+		return &auth.Principal{
+			ID:     constant.PrincipalTypeLegacyToken.String(),
+			Name:   constant.PrincipalTypeLegacyToken.String(),
+			Type:   constant.PrincipalTypeLegacyToken.String(),
+			Scopes: []string{constant.ScopeAdmin.String()},
+		}, nil
+	}
+
+	model, err := a.repo.FindAPIKeyByHash(ctx, hashAPIKey(token))
+	if err != nil {
+		if pkgError.CompareBusinessError(err, pkgError.NotFound) {
+			return nil, pkgError.WrapWithCode(err, pkgError.Unauthorized, "invalid api key")
+		}
+		return nil, pkgError.Wrap(err)
+	}
+
+	if model.RevokedAt != nil {
+		return nil, pkgError.WrapWithCode(pkgError.EmptyBusinessError(), pkgError.Unauthorized, "api key is revoked")
+	}
+
+	if model.ExpiresAt != nil && !model.ExpiresAt.After(time.Now().UTC()) {
+		return nil, pkgError.WrapWithCode(pkgError.EmptyBusinessError(), pkgError.Unauthorized, "api key is expired")
+	}
+
+	return &auth.Principal{
+		ID:             model.ID,
+		Name:           model.Name,
+		Type:           constant.PrincipalTypeAPIKey.String(),
+		Scopes:         model.ScopeList(),
+		ConflictPolicy: model.ConflictPolicy,
+	}, nil
+}
+
+func generateAPIKey() (string, error) {
+	buf := make([]byte, apiKeyRandomBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return apiKeyPrefix + hex.EncodeToString(buf), nil
+}
+
+// 키는 충분한 엔트로피를 가진 난수이므로 salt 없이 SHA-256 으로 저장하고 조회합니다.
+func hashAPIKey(rawKey string) string {
+	sum := sha256.Sum256([]byte(rawKey))
+	return hex.EncodeToString(sum[:])
+}
+
+func toAPIKeyResponse(model *apikey.APIKey) apikey.APIKeyResponse {
+	return apikey.APIKeyResponse{
+		ID:             model.ID,
+		Name:           model.Name,
+		Prefix:         model.Prefix,
+		Scopes:         model.ScopeList(),
+		ConflictPolicy: model.ConflictPolicy,
+		ExpiresAt:      model.ExpiresAt,
+		RevokedAt:      model.RevokedAt,
+		CreatedAt:      model.CreatedAt,
+	}
+}
+
+func NewAPIKeyService(repo apikey.APIKeyRepository) apikey.APIKeyService {
+	return &apiKeyService{repo: repo}
+}
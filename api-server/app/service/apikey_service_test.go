@@ -0,0 +1,161 @@
+package service
+
+import (
+	"aitrics-vital-signs/api-server/domain/apikey"
+	"aitrics-vital-signs/api-server/domain/mock"
+	"aitrics-vital-signs/api-server/pkg/constant"
+	"aitrics-vital-signs/library/envs"
+	pkgError "aitrics-vital-signs/library/error"
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+var (
+	mockAPIKeyRepository *mock.MockAPIKeyRepository
+	apiKeySvc            apikey.APIKeyService
+)
+
+func beforeEachAPIKey(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockAPIKeyRepository = mock.NewMockAPIKeyRepository(ctrl)
+	apiKeySvc = NewAPIKeyService(mockAPIKeyRepository)
+}
+
+func Test_CreateAPIKey(t *testing.T) {
+	beforeEachAPIKey(t)
+
+	var stored *apikey.APIKey
+	mockAPIKeyRepository.EXPECT().
+		CreateAPIKey(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, model *apikey.APIKey) error {
+			stored = model
+			return nil
+		})
+
+	result, err := apiKeySvc.CreateAPIKey(context.Background(), apikey.CreateAPIKeyRequest{
+		Name:   "bedside-gateway",
+		Scopes: []string{"vitals:write", "patients:read"},
+	})
+	require.NoError(t, err)
+
+	// 평문 키는 응답에만 존재하고 DB 에는 해시만 저장
+	require.True(t, strings.HasPrefix(result.Key, apiKeyPrefix))
+	require.Equal(t, hashAPIKey(result.Key), stored.KeyHash)
+	require.NotContains(t, stored.KeyHash, result.Key)
+	require.Equal(t, "vitals:write,patients:read", stored.Scopes)
+	require.Equal(t, result.Key[:apiKeyDisplayLen], result.Prefix)
+	// conflict_policy 생략 시 strict
+	require.Equal(t, constant.ConflictPolicyStrict.String(), stored.ConflictPolicy)
+}
+
+func Test_CreateAPIKey_PastExpiry(t *testing.T) {
+	beforeEachAPIKey(t)
+
+	expiresAt := time.Now().UTC().Add(-time.Hour)
+	_, err := apiKeySvc.CreateAPIKey(context.Background(), apikey.CreateAPIKeyRequest{
+		Name:      "bedside-gateway",
+		Scopes:    []string{"vitals:write"},
+		ExpiresAt: &expiresAt,
+	})
+	require.True(t, pkgError.CompareBusinessError(err, pkgError.WrongParam))
+}
+
+func Test_Authenticate(t *testing.T) {
+	past := time.Now().UTC().Add(-time.Hour)
+	future := time.Now().UTC().Add(time.Hour)
+
+	tests := []struct {
+		name       string
+		token      string
+		legacy     string
+		setupMock  func()
+		wantErr    bool
+		wantScopes []string
+		wantPolicy string
+	}{
+		{
+			name:  "성공 - 유효한 API Key",
+			token: "avs_valid",
+			setupMock: func() {
+				mockAPIKeyRepository.EXPECT().
+					FindAPIKeyByHash(gomock.Any(), hashAPIKey("avs_valid")).
+					Return(&apikey.APIKey{ID: "key-1", Name: "monitor", Scopes: "vitals:write", ConflictPolicy: "max_of", ExpiresAt: &future}, nil)
+			},
+			wantScopes: []string{"vitals:write"},
+			wantPolicy: "max_of",
+		},
+		{
+			name:       "성공 - bootstrap TOKEN 은 admin",
+			token:      "legacy-token",
+			legacy:     "legacy-token",
+			setupMock:  func() {},
+			wantScopes: []string{constant.ScopeAdmin.String()},
+		},
+		{
+			name:  "실패 - 존재하지 않는 키",
+			token: "avs_unknown",
+			setupMock: func() {
+				mockAPIKeyRepository.EXPECT().
+					FindAPIKeyByHash(gomock.Any(), gomock.Any()).
+					Return(nil, pkgError.WrapWithCode(pkgError.EmptyBusinessError(), pkgError.NotFound))
+			},
+			wantErr: true,
+		},
+		{
+			name:  "실패 - 폐기된 키",
+			token: "avs_revoked",
+			setupMock: func() {
+				mockAPIKeyRepository.EXPECT().
+					FindAPIKeyByHash(gomock.Any(), gomock.Any()).
+					Return(&apikey.APIKey{ID: "key-1", Scopes: "admin", RevokedAt: &past}, nil)
+			},
+			wantErr: true,
+		},
+		{
+			name:  "실패 - 만료된 키",
+			token: "avs_expired",
+			setupMock: func() {
+				mockAPIKeyRepository.EXPECT().
+					FindAPIKeyByHash(gomock.Any(), gomock.Any()).
+					Return(&apikey.APIKey{ID: "key-1", Scopes: "admin", ExpiresAt: &past}, nil)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			beforeEachAPIKey(t)
+			originToken := envs.Token
+			envs.Token = tt.legacy
+			defer func() { envs.Token = originToken }()
+			tt.setupMock()
+
+			principal, err := apiKeySvc.Authenticate(context.Background(), tt.token)
+
+			if tt.wantErr {
+				require.True(t, pkgError.CompareBusinessError(err, pkgError.Unauthorized))
+				require.Nil(t, principal)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.wantScopes, principal.Scopes)
+				require.Equal(t, tt.wantPolicy, principal.ConflictPolicy)
+			}
+		})
+	}
+}
+
+func Test_RevokeAPIKey(t *testing.T) {
+	beforeEachAPIKey(t)
+
+	mockAPIKeyRepository.EXPECT().
+		RevokeAPIKey(gomock.Any(), "key-1", gomock.Any()).
+		Return(nil)
+
+	require.NoError(t, apiKeySvc.RevokeAPIKey(context.Background(), "key-1"))
+}
@@ -0,0 +1,111 @@
+package service
+
+import (
+	"aitrics-vital-signs/api-server/domain/audit"
+	pkgError "aitrics-vital-signs/library/error"
+	"context"
+	"time"
+)
+
+// 감사 기록 조회 기본 페이지 크기
+const defaultAuditEventsLimit = 100
+
+type auditService struct {
+	repo audit.AuditRepository
+}
+
+func (a *auditService) GetAuditEvents(ctx context.Context, request audit.GetAuditEventsRequest) (*audit.GetAuditEventsResponse, error) {
+	param := audit.FindAuditEventsParam{
+		PatientID:   request.PatientID,
+		PrincipalID: request.PrincipalID,
+		BeforeID:    request.Cursor,
+		Limit:       request.Limit,
+	}
+	if param.Limit == 0 {
+		param.Limit = defaultAuditEventsLimit
+	}
+
+	if request.From != "" {
+		from, err := time.Parse(time.RFC3339, request.From)
+		if err != nil {
+			return nil, pkgError.WrapWithCode(err, pkgError.WrongParam, "invalid from date format")
+		}
+		param.From = &from
+	}
+
+	if request.To != "" {
+		to, err := time.Parse(time.RFC3339, request.To)
+		if err != nil {
+			return nil, pkgError.WrapWithCode(err, pkgError.WrongParam, "invalid to date format")
+		}
+		param.To = &to
+	}
+
+	if param.From != nil && param.To != nil && param.From.After(*param.To) {
+		return nil, pkgError.WrapWithCode(pkgError.EmptyBusinessError(), pkgError.WrongParam, "from must be before to")
+	}
+
+	// 다음 페이지 존재 여부 확인을 위해 한 건 더 조회
+	limit := param.Limit
+	param.Limit = limit + 1
+
+	models, err := a.repo.FindAuditEvents(ctx, param)
+	if err != nil {
+		return nil, pkgError.Wrap(err)
+	}
+
+	response := &audit.GetAuditEventsResponse{Items: make([]audit.AuditEventResponse, 0, len(models))}
+	if len(models) > limit {
+		models = models[:limit]
+		nextCursor := models[limit-1].ID
+		response.NextCursor = &nextCursor
+	}
+
+	for _, model := range models {
+		response.Items = append(response.Items, audit.AuditEventResponse{
+			ID:            model.ID,
+			RequestID:     model.RequestID,
+			PrincipalID:   model.PrincipalID,
+			PrincipalName: model.PrincipalName,
+			PrincipalType: model.PrincipalType,
+			Action:        model.Action,
+			Method:        model.Method,
+			Route:         model.Route,
+			Path:          model.Path,
+			PatientID:     model.PatientID,
+			ResourceKey:   model.ResourceKey,
+			Outcome:       model.Outcome,
+			StatusCode:    model.StatusCode,
+			ErrorCode:     model.ErrorCode,
+			ClientIP:      model.ClientIP,
+			OccurredAt:    model.OccurredAt,
+		})
+	}
+
+	return response, nil
+}
+
+func (a *auditService) ExportAuditEvents(ctx context.Context, request audit.ExportAuditEventsRequest, fn func(*audit.AuditEvent) error) error {
+	from, to, err := parseVitalTimeRange(request.From, request.To)
+	if err != nil {
+		return pkgError.Wrap(err)
+	}
+	if err := validateExportRange(from, to); err != nil {
+		return err
+	}
+
+	if err := a.repo.StreamAuditEvents(ctx, audit.StreamAuditEventsParam{
+		PatientID:   request.PatientID,
+		PrincipalID: request.PrincipalID,
+		From:        from,
+		To:          to,
+	}, fn); err != nil {
+		return pkgError.Wrap(err)
+	}
+
+	return nil
+}
+
+func NewAuditService(repo audit.AuditRepository) audit.AuditService {
+	return &auditService{repo: repo}
+}
@@ -0,0 +1,85 @@
+package service
+
+import (
+	"aitrics-vital-signs/api-server/domain/audit"
+	"aitrics-vital-signs/api-server/domain/mock"
+	pkgError "aitrics-vital-signs/library/error"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+var (
+	mockAuditRepository *mock.MockAuditRepository
+	auditSvc            audit.AuditService
+)
+
+func beforeEachAudit(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockAuditRepository = mock.NewMockAuditRepository(ctrl)
+	auditSvc = NewAuditService(mockAuditRepository)
+}
+
+func Test_GetAuditEvents(t *testing.T) {
+	tests := []struct {
+		name           string
+		request        audit.GetAuditEventsRequest
+		setupMock      func()
+		wantCode       pkgError.Code
+		wantLen        int
+		wantNextCursor *uint64
+	}{
+		{
+			name:    "성공 - 다음 페이지 존재",
+			request: audit.GetAuditEventsRequest{PatientID: "P00001", Limit: 2},
+			setupMock: func() {
+				mockAuditRepository.EXPECT().
+					FindAuditEvents(gomock.Any(), audit.FindAuditEventsParam{PatientID: "P00001", Limit: 3}).
+					Return([]audit.AuditEvent{{ID: 30}, {ID: 20}, {ID: 10}}, nil)
+			},
+			wantLen:        2,
+			wantNextCursor: func() *uint64 { v := uint64(20); return &v }(),
+		},
+		{
+			name:    "성공 - 마지막 페이지 (기본 limit)",
+			request: audit.GetAuditEventsRequest{PrincipalID: "key-1", Cursor: 20},
+			setupMock: func() {
+				mockAuditRepository.EXPECT().
+					FindAuditEvents(gomock.Any(), audit.FindAuditEventsParam{PrincipalID: "key-1", BeforeID: 20, Limit: defaultAuditEventsLimit + 1}).
+					Return([]audit.AuditEvent{{ID: 10}}, nil)
+			},
+			wantLen: 1,
+		},
+		{
+			name:      "실패 - 잘못된 from 형식",
+			request:   audit.GetAuditEventsRequest{From: "2025-12-01"},
+			setupMock: func() {},
+			wantCode:  pkgError.WrongParam,
+		},
+		{
+			name:      "실패 - from 이 to 보다 이후",
+			request:   audit.GetAuditEventsRequest{From: "2025-12-02T00:00:00Z", To: "2025-12-01T00:00:00Z"},
+			setupMock: func() {},
+			wantCode:  pkgError.WrongParam,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			beforeEachAudit(t)
+			tt.setupMock()
+
+			result, err := auditSvc.GetAuditEvents(context.Background(), tt.request)
+			if tt.wantCode != 0 {
+				require.True(t, pkgError.CompareBusinessError(err, tt.wantCode))
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, result.Items, tt.wantLen)
+			require.Equal(t, tt.wantNextCursor, result.NextCursor)
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package service
+
+import (
+	"aitrics-vital-signs/api-server/domain/encounter"
+	"aitrics-vital-signs/api-server/domain/patient"
+	"aitrics-vital-signs/api-server/internal/tracing"
+	pkgError "aitrics-vital-signs/library/error"
+	"context"
+)
+
+type encounterService struct {
+	repo        encounter.EncounterRepository
+	patientRepo patient.PatientRepository
+}
+
+func (e *encounterService) GetPatientEncounters(ctx context.Context, patientID string) ([]encounter.EncounterResponse, error) {
+	ctx, span := tracing.Start(ctx, "EncounterService.GetPatientEncounters")
+	defer span.End()
+
+	if _, err := e.patientRepo.FindPatientByID(ctx, patientID); err != nil {
+		return nil, pkgError.Wrap(err)
+	}
+
+	encounters, err := e.repo.FindEncountersByPatientID(ctx, patientID)
+	if err != nil {
+		return nil, pkgError.Wrap(err)
+	}
+
+	results := make([]encounter.EncounterResponse, 0, len(encounters))
+	for _, item := range encounters {
+		results = append(results, encounter.EncounterResponse{
+			ID:           item.ID,
+			PatientID:    item.PatientID,
+			Type:         item.Type,
+			WardID:       item.WardID,
+			AdmittedAt:   item.AdmittedAt,
+			DischargedAt: item.DischargedAt,
+		})
+	}
+
+	return results, nil
+}
+
+// resolveEncounter
+// encounter_id 가 지정되면 해당 환자의 encounter 인지 검증하고, 없으면 nil 을 반환합니다.
+func resolveEncounter(ctx context.Context, repo encounter.EncounterRepository, patientID, encounterID string) (*encounter.Encounter, error) {
+	if encounterID == "" {
+		return nil, nil
+	}
+
+	model, err := repo.FindEncounterByID(ctx, encounterID)
+	if err != nil {
+		if pkgError.CompareBusinessError(err, pkgError.NotFound) {
+			return nil, pkgError.WrapWithCode(err, pkgError.WrongParam, "encounter not found")
+		}
+		return nil, pkgError.Wrap(err)
+	}
+
+	if model.PatientID != patientID {
+		return nil, pkgError.WrapWithCode(pkgError.EmptyBusinessError(), pkgError.WrongParam, "encounter does not belong to patient")
+	}
+
+	return model, nil
+}
+
+func NewEncounterService(repo encounter.EncounterRepository, patientRepo patient.PatientRepository) encounter.EncounterService {
+	return &encounterService{
+		repo:        repo,
+		patientRepo: patientRepo,
+	}
+}
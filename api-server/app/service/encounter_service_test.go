@@ -0,0 +1,89 @@
+package service
+
+import (
+	"aitrics-vital-signs/api-server/domain/encounter"
+	"aitrics-vital-signs/api-server/domain/mock"
+	"aitrics-vital-signs/api-server/domain/patient"
+	pkgError "aitrics-vital-signs/library/error"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+var (
+	mockEncounterRepository *mock.MockEncounterRepository
+	encounterSvc            encounter.EncounterService
+)
+
+func beforeEachEncounter(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockEncounterRepository = mock.NewMockEncounterRepository(ctrl)
+	mockPatientRepository = mock.NewMockPatientRepository(ctrl)
+	encounterSvc = NewEncounterService(mockEncounterRepository, mockPatientRepository)
+}
+
+func Test_GetPatientEncounters(t *testing.T) {
+	beforeEachEncounter(t)
+
+	admittedAt := time.Now().UTC().Add(-48 * time.Hour)
+	dischargedAt := admittedAt.Add(24 * time.Hour)
+	mockPatientRepository.EXPECT().FindPatientByID(gomock.Any(), "P00001").Return(&patient.Patient{PatientID: "P00001"}, nil)
+	mockEncounterRepository.EXPECT().FindEncountersByPatientID(gomock.Any(), "P00001").Return([]encounter.Encounter{
+		{ID: "enc-2", PatientID: "P00001", Type: "INPATIENT", AdmittedAt: dischargedAt.Add(time.Hour)},
+		{ID: "enc-1", PatientID: "P00001", Type: "EMERGENCY", AdmittedAt: admittedAt, DischargedAt: &dischargedAt},
+	}, nil)
+
+	result, err := encounterSvc.GetPatientEncounters(context.Background(), "P00001")
+	require.NoError(t, err)
+	require.Len(t, result, 2)
+	require.Nil(t, result[0].DischargedAt)
+	require.Equal(t, "EMERGENCY", result[1].Type)
+}
+
+func Test_ResolveEncounter(t *testing.T) {
+	tests := []struct {
+		name      string
+		setupMock func()
+		wantCode  pkgError.Code
+	}{
+		{
+			name: "성공 - 환자의 encounter",
+			setupMock: func() {
+				mockEncounterRepository.EXPECT().FindEncounterByID(gomock.Any(), "enc-1").Return(&encounter.Encounter{ID: "enc-1", PatientID: "P00001"}, nil)
+			},
+		},
+		{
+			name: "실패 - 다른 환자의 encounter",
+			setupMock: func() {
+				mockEncounterRepository.EXPECT().FindEncounterByID(gomock.Any(), "enc-1").Return(&encounter.Encounter{ID: "enc-1", PatientID: "P00002"}, nil)
+			},
+			wantCode: pkgError.WrongParam,
+		},
+		{
+			name: "실패 - 존재하지 않는 encounter",
+			setupMock: func() {
+				mockEncounterRepository.EXPECT().FindEncounterByID(gomock.Any(), "enc-1").Return(nil, notFoundErr())
+			},
+			wantCode: pkgError.WrongParam,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			beforeEachEncounter(t)
+			tt.setupMock()
+
+			result, err := resolveEncounter(context.Background(), mockEncounterRepository, "P00001", "enc-1")
+			if tt.wantCode != 0 {
+				require.True(t, pkgError.CompareBusinessError(err, tt.wantCode))
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, "enc-1", result.ID)
+		})
+	}
+}
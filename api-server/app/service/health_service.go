@@ -0,0 +1,83 @@
+package service
+
+import (
+	"aitrics-vital-signs/api-server/domain/health"
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// 의존성 하나의 상태 확인에 허용하는 최대 시간
+const healthCheckTimeout = 2 * time.Second
+
+type healthService struct {
+	dependencies []health.Dependency
+	startedAt    time.Time
+	shuttingDown atomic.Bool
+}
+
+func (h *healthService) Liveness(ctx context.Context) health.LivenessResponse {
+	return health.LivenessResponse{
+		Status:        health.StatusAlive,
+		UptimeSeconds: int64(time.Since(h.startedAt).Seconds()),
+	}
+}
+
+// Readiness
+// 모든 의존성을 동시에 확인하며, 하나라도 실패하면 not_ready 입니다.
+// shutdown 중에는 의존성 확인 없이 not_ready 를 반환해 로드밸런서가 트래픽을 먼저 제외하도록 합니다.
+func (h *healthService) Readiness(ctx context.Context) health.ReadinessResponse {
+	response := health.ReadinessResponse{
+		Status: health.StatusReady,
+		Checks: make(map[string]health.DependencyStatus, len(h.dependencies)),
+	}
+
+	if h.shuttingDown.Load() {
+		response.Status = health.StatusNotReady
+		response.ShuttingDown = true
+		return response
+	}
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	for _, dependency := range h.dependencies {
+		wg.Add(1)
+		go func(dependency health.Dependency) {
+			defer wg.Done()
+			status := check(ctx, dependency.Checker)
+
+			mu.Lock()
+			defer mu.Unlock()
+			response.Checks[dependency.Name] = status
+			if status.Status != health.StatusUp {
+				response.Status = health.StatusNotReady
+			}
+		}(dependency)
+	}
+	wg.Wait()
+
+	return response
+}
+
+func (h *healthService) StartShutdown() {
+	h.shuttingDown.Store(true)
+}
+
+func check(ctx context.Context, checker health.Checker) health.DependencyStatus {
+	ctx, cancel := context.WithTimeout(ctx, healthCheckTimeout)
+	defer cancel()
+
+	start := time.Now()
+	err := checker.Check(ctx)
+	status := health.DependencyStatus{Status: health.StatusUp, LatencyMs: time.Since(start).Milliseconds()}
+	if err != nil {
+		status.Status = health.StatusDown
+		status.Error = err.Error()
+	}
+	return status
+}
+
+func NewHealthService(dependencies ...health.Dependency) health.HealthService {
+	return &healthService{dependencies: dependencies, startedAt: time.Now()}
+}
@@ -0,0 +1,73 @@
+package service
+
+import (
+	"aitrics-vital-signs/api-server/domain/health"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Readiness(t *testing.T) {
+	up := health.CheckerFunc(func(ctx context.Context) error { return nil })
+	down := health.CheckerFunc(func(ctx context.Context) error { return errors.New("connection refused") })
+
+	tests := []struct {
+		name         string
+		dependencies []health.Dependency
+		shutdown     bool
+		wantStatus   string
+		wantChecks   map[string]string
+	}{
+		{
+			name:         "성공 - 모든 의존성 정상",
+			dependencies: []health.Dependency{{Name: "db", Checker: up}, {Name: "audit_writer", Checker: up}},
+			wantStatus:   health.StatusReady,
+			wantChecks:   map[string]string{"db": health.StatusUp, "audit_writer": health.StatusUp},
+		},
+		{
+			name:         "실패 - 일부 의존성 실패",
+			dependencies: []health.Dependency{{Name: "db", Checker: down}, {Name: "audit_writer", Checker: up}},
+			wantStatus:   health.StatusNotReady,
+			wantChecks:   map[string]string{"db": health.StatusDown, "audit_writer": health.StatusUp},
+		},
+		{
+			name:         "실패 - shutdown 중",
+			dependencies: []health.Dependency{{Name: "db", Checker: up}},
+			shutdown:     true,
+			wantStatus:   health.StatusNotReady,
+			wantChecks:   map[string]string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := NewHealthService(tt.dependencies...)
+			if tt.shutdown {
+				svc.StartShutdown()
+			}
+
+			result := svc.Readiness(context.Background())
+			require.Equal(t, tt.wantStatus, result.Status)
+			require.Equal(t, tt.shutdown, result.ShuttingDown)
+
+			checks := make(map[string]string)
+			for name, status := range result.Checks {
+				checks[name] = status.Status
+				if status.Status == health.StatusDown {
+					require.NotEmpty(t, status.Error)
+				}
+			}
+			require.Equal(t, tt.wantChecks, checks)
+		})
+	}
+}
+
+func Test_Liveness(t *testing.T) {
+	svc := NewHealthService()
+	svc.StartShutdown()
+
+	// liveness 는 shutdown 중에도 alive
+	require.Equal(t, health.StatusAlive, svc.Liveness(context.Background()).Status)
+}
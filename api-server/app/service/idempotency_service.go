@@ -0,0 +1,102 @@
+package service
+
+import (
+	"aitrics-vital-signs/api-server/domain/idempotency"
+	pkgError "aitrics-vital-signs/library/error"
+	"context"
+	"time"
+)
+
+// idempotencyLockTimeout 처리 중인 key 의 만료 시간
+// 응답 저장 전에 서버가 종료되어도 이 시간이 지나면 같은 key 로 다시 처리할 수 있습니다.
+const idempotencyLockTimeout = time.Minute
+
+type idempotencyService struct {
+	repo idempotency.IdempotencyRepository
+	ttl  time.Duration
+}
+
+func (i *idempotencyService) Begin(ctx context.Context, request idempotency.BeginRequest) (*idempotency.IdempotencyKey, error) {
+	now := time.Now().UTC()
+	model := &idempotency.IdempotencyKey{
+		PrincipalID: request.PrincipalID,
+		Key:         request.Key,
+		Method:      request.Method,
+		Path:        request.Path,
+		RequestHash: request.RequestHash,
+		CreatedAt:   now,
+		ExpiresAt:   now.Add(idempotencyLockTimeout),
+	}
+
+	err := i.repo.CreateIdempotencyKey(ctx, model)
+	if err == nil {
+		return nil, nil
+	}
+	if !pkgError.CompareBusinessError(err, pkgError.Conflict) {
+		return nil, pkgError.Wrap(err)
+	}
+
+	// 만료된 key 는 삭제 후 한 번만 다시 등록
+	deleted, err := i.repo.DeleteExpiredIdempotencyKey(ctx, request.PrincipalID, request.Key, now)
+	if err != nil {
+		return nil, pkgError.Wrap(err)
+	}
+	if deleted {
+		if err := i.repo.CreateIdempotencyKey(ctx, model); err == nil {
+			return nil, nil
+		} else if !pkgError.CompareBusinessError(err, pkgError.Conflict) {
+			return nil, pkgError.Wrap(err)
+		}
+	}
+
+	existing, err := i.repo.FindIdempotencyKey(ctx, request.PrincipalID, request.Key)
+	if err != nil {
+		if pkgError.CompareBusinessError(err, pkgError.NotFound) {
+			// 조회 전에 Release 된 경우
+			return nil, pkgError.WrapWithCode(err, pkgError.Conflict, "request with the same idempotency key is in progress")
+		}
+		return nil, pkgError.Wrap(err)
+	}
+
+	if existing.RequestHash != request.RequestHash {
+		return nil, pkgError.WrapWithCode(pkgError.EmptyBusinessError(), pkgError.IdempotencyKeyReused, "idempotency key is already used for a different request")
+	}
+	if !existing.Completed() {
+		return nil, pkgError.WrapWithCode(pkgError.EmptyBusinessError(), pkgError.Conflict, "request with the same idempotency key is in progress")
+	}
+
+	return existing, nil
+}
+
+func (i *idempotencyService) Complete(ctx context.Context, request idempotency.CompleteRequest) error {
+	if err := i.repo.CompleteIdempotencyKey(ctx, idempotency.CompleteIdempotencyKeyParam{
+		PrincipalID:  request.PrincipalID,
+		Key:          request.Key,
+		StatusCode:   request.StatusCode,
+		ContentType:  request.ContentType,
+		ResponseBody: request.ResponseBody,
+		ExpiresAt:    time.Now().UTC().Add(i.ttl),
+	}); err != nil {
+		return pkgError.Wrap(err)
+	}
+	return nil
+}
+
+func (i *idempotencyService) Release(ctx context.Context, principalID, key string) error {
+	if err := i.repo.DeleteIdempotencyKey(ctx, principalID, key); err != nil {
+		return pkgError.Wrap(err)
+	}
+	return nil
+}
+
+func (i *idempotencyService) PurgeExpiredIdempotencyKeys(ctx context.Context, before time.Time) (int64, error) {
+	purged, err := i.repo.DeleteExpiredIdempotencyKeys(ctx, before)
+	if err != nil {
+		return 0, pkgError.Wrap(err)
+	}
+	return purged, nil
+}
+
+func NewIdempotencyService(repo idempotency.IdempotencyRepository, ttl time.Duration) idempotency.IdempotencyService {
+	return &idempotencyService{repo: repo, ttl: ttl}
+}
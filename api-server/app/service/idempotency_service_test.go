@@ -0,0 +1,144 @@
+package service
+
+import (
+	"aitrics-vital-signs/api-server/domain/idempotency"
+	"aitrics-vital-signs/api-server/domain/mock"
+	pkgError "aitrics-vital-signs/library/error"
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+var (
+	mockIdempotencyRepository *mock.MockIdempotencyRepository
+	idempotencySvc            idempotency.IdempotencyService
+)
+
+const testIdempotencyTTL = 24 * time.Hour
+
+func beforeEachIdempotency(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockIdempotencyRepository = mock.NewMockIdempotencyRepository(ctrl)
+	idempotencySvc = NewIdempotencyService(mockIdempotencyRepository, testIdempotencyTTL)
+}
+
+func Test_Begin(t *testing.T) {
+	request := idempotency.BeginRequest{PrincipalID: "key-1", Key: "req-1", Method: http.MethodPost, Path: "/api/v1/vitals", RequestHash: "hash-1"}
+	status := http.StatusOK
+	conflictErr := func() error {
+		return pkgError.WrapWithCode(pkgError.EmptyBusinessError(), pkgError.Conflict, "duplicate key")
+	}
+
+	tests := []struct {
+		name       string
+		setupMock  func()
+		wantReplay bool
+		wantCode   pkgError.Code
+	}{
+		{
+			name: "성공 - 새 key 등록",
+			setupMock: func() {
+				mockIdempotencyRepository.EXPECT().
+					CreateIdempotencyKey(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, model *idempotency.IdempotencyKey) error {
+						require.Equal(t, "hash-1", model.RequestHash)
+						require.Nil(t, model.StatusCode)
+						// 처리 중인 key 는 짧게 만료
+						require.Equal(t, idempotencyLockTimeout, model.ExpiresAt.Sub(model.CreatedAt))
+						return nil
+					})
+			},
+		},
+		{
+			name: "성공 - 저장된 응답 재사용",
+			setupMock: func() {
+				mockIdempotencyRepository.EXPECT().CreateIdempotencyKey(gomock.Any(), gomock.Any()).Return(conflictErr())
+				mockIdempotencyRepository.EXPECT().DeleteExpiredIdempotencyKey(gomock.Any(), "key-1", "req-1", gomock.Any()).Return(false, nil)
+				mockIdempotencyRepository.EXPECT().
+					FindIdempotencyKey(gomock.Any(), "key-1", "req-1").
+					Return(&idempotency.IdempotencyKey{RequestHash: "hash-1", StatusCode: &status, ResponseBody: []byte(`{}`)}, nil)
+			},
+			wantReplay: true,
+		},
+		{
+			name: "성공 - 만료된 key 는 다시 등록",
+			setupMock: func() {
+				mockIdempotencyRepository.EXPECT().CreateIdempotencyKey(gomock.Any(), gomock.Any()).Return(conflictErr())
+				mockIdempotencyRepository.EXPECT().DeleteExpiredIdempotencyKey(gomock.Any(), "key-1", "req-1", gomock.Any()).Return(true, nil)
+				mockIdempotencyRepository.EXPECT().CreateIdempotencyKey(gomock.Any(), gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name: "실패 - 다른 요청에 사용된 key",
+			setupMock: func() {
+				mockIdempotencyRepository.EXPECT().CreateIdempotencyKey(gomock.Any(), gomock.Any()).Return(conflictErr())
+				mockIdempotencyRepository.EXPECT().DeleteExpiredIdempotencyKey(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(false, nil)
+				mockIdempotencyRepository.EXPECT().
+					FindIdempotencyKey(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(&idempotency.IdempotencyKey{RequestHash: "hash-2", StatusCode: &status}, nil)
+			},
+			wantCode: pkgError.IdempotencyKeyReused,
+		},
+		{
+			name: "실패 - 처리 중인 key",
+			setupMock: func() {
+				mockIdempotencyRepository.EXPECT().CreateIdempotencyKey(gomock.Any(), gomock.Any()).Return(conflictErr())
+				mockIdempotencyRepository.EXPECT().DeleteExpiredIdempotencyKey(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(false, nil)
+				mockIdempotencyRepository.EXPECT().
+					FindIdempotencyKey(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(&idempotency.IdempotencyKey{RequestHash: "hash-1"}, nil)
+			},
+			wantCode: pkgError.Conflict,
+		},
+		{
+			name: "실패 - DB 오류",
+			setupMock: func() {
+				mockIdempotencyRepository.EXPECT().
+					CreateIdempotencyKey(gomock.Any(), gomock.Any()).
+					Return(pkgError.WrapWithCode(pkgError.EmptyBusinessError(), pkgError.Create))
+			},
+			wantCode: pkgError.Create,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			beforeEachIdempotency(t)
+			tt.setupMock()
+
+			replay, err := idempotencySvc.Begin(context.Background(), request)
+			if tt.wantCode != 0 {
+				require.True(t, pkgError.CompareBusinessError(err, tt.wantCode), err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.wantReplay, replay != nil)
+		})
+	}
+}
+
+func Test_Complete(t *testing.T) {
+	beforeEachIdempotency(t)
+
+	mockIdempotencyRepository.EXPECT().
+		CompleteIdempotencyKey(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, param idempotency.CompleteIdempotencyKeyParam) error {
+			require.Equal(t, http.StatusCreated, param.StatusCode)
+			// 응답 저장 시 TTL 로 만료일 연장
+			require.WithinDuration(t, time.Now().UTC().Add(testIdempotencyTTL), param.ExpiresAt, time.Minute)
+			return nil
+		})
+
+	err := idempotencySvc.Complete(context.Background(), idempotency.CompleteRequest{
+		PrincipalID:  "key-1",
+		Key:          "req-1",
+		StatusCode:   http.StatusCreated,
+		ContentType:  "application/json",
+		ResponseBody: []byte(`{}`),
+	})
+	require.NoError(t, err)
+}
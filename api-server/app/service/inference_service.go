@@ -1,25 +1,31 @@
 package service
 
 import (
+	"aitrics-vital-signs/api-server/domain/encounter"
 	"aitrics-vital-signs/api-server/domain/inference"
 	"aitrics-vital-signs/api-server/domain/patient"
 	"aitrics-vital-signs/api-server/domain/vital"
+	"aitrics-vital-signs/api-server/internal/metrics"
+	"aitrics-vital-signs/api-server/internal/tracing"
 	internalVital "aitrics-vital-signs/api-server/internal/vital"
 	"aitrics-vital-signs/api-server/pkg/constant"
 	"aitrics-vital-signs/library/envs"
 	pkgError "aitrics-vital-signs/library/error"
 	"context"
-	"fmt"
 	"math"
 	"time"
 )
 
 type inferenceService struct {
-	vitalRepo   vital.VitalRepository
-	patientRepo patient.PatientRepository
+	vitalRepo     vital.VitalRepository
+	patientRepo   patient.PatientRepository
+	encounterRepo encounter.EncounterRepository
 }
 
 func (i *inferenceService) CalculateVitalRisk(ctx context.Context, request inference.VitalRiskRequest) (*inference.VitalRiskResponse, error) {
+	ctx, span := tracing.Start(ctx, "InferenceService.CalculateVitalRisk")
+	defer span.End()
+
 	// 등록된 patient 검증
 	_, err := i.patientRepo.FindPatientByID(ctx, request.PatientID)
 	if err != nil {
@@ -31,16 +37,39 @@ func (i *inferenceService) CalculateVitalRisk(ctx context.Context, request infer
 
 	// 현재 시간 기준으로 시간 범위 설정
 	now := time.Now().UTC()
-	from := now.Add(-time.Duration(timeWindowHours) * time.Hour)
 	to := now
 
-	// HR, SBP, SpO2 만 처리
-	vitals, err := i.vitalRepo.FindVitalsByPatientIDAndDateRange(ctx, vital.FindVitalsByPatientIDAndDateRangeParam{
-		PatientID:  request.PatientID,
-		From:       from,
-		To:         to,
+	// encounter 지정이 없으면 진행 중인 encounter 기준 (없으면 전체 vital 대상)
+	target, err := i.findTargetEncounter(ctx, request)
+	if err != nil {
+		return nil, pkgError.Wrap(err)
+	}
+
+	var encounterID *string
+	if target != nil {
+		encounterID = &target.ID
+		if target.DischargedAt != nil && target.DischargedAt.Before(to) {
+			to = *target.DischargedAt
+		}
+	}
+
+	from := to.Add(-time.Duration(timeWindowHours) * time.Hour)
+	if target != nil && target.AdmittedAt.After(from) {
+		from = target.AdmittedAt
+	}
+
+	findParam := vital.FindVitalsByPatientIDAndDateRangeParam{
+		PatientID: request.PatientID,
+		From:      from,
+		To:        to,
+		// HR, SBP, SpO2 만 처리
 		VitalTypes: []string{constant.VitalTypeHR.String(), constant.VitalTypeSBP.String(), constant.VitalTypeSpO2.String()},
-	})
+	}
+	if encounterID != nil {
+		findParam.EncounterID = *encounterID
+	}
+
+	vitals, err := i.vitalRepo.FindVitalsByPatientIDAndDateRange(ctx, findParam)
 	if err != nil {
 		return nil, pkgError.Wrap(err)
 	}
@@ -63,35 +92,16 @@ func (i *inferenceService) CalculateVitalRisk(ctx context.Context, request infer
 		}
 	}
 
-	// 위험 조건 평가
-	triggeredRules := make([]string, 0, len(internalVital.RiskRules))
-
-	// 정의해둔 조건대로 rules 계산 및 추가
-	for _, rule := range internalVital.RiskRules {
-		avg, exists := vitalAverages[rule.VitalType]
-		if !exists {
-			continue
-		}
-
-		if internalVital.EvaluateRule(avg, rule) {
-			triggeredRules = append(
-				triggeredRules,
-				fmt.Sprintf("%s %s %.0f", rule.VitalType, rule.Comparator, rule.Threshold),
-			)
-		}
-	}
-
-	// risk_level 결정
-	riskLevel := constant.RiskLevelLow
-	triggeredCount := len(triggeredRules)
-	if triggeredCount >= 3 {
-		riskLevel = constant.RiskLevelHigh
-	} else if triggeredCount >= 1 {
-		riskLevel = constant.RiskLevelMedium
+	// 위험 조건 평가 (병동 조회와 같은 rule 을 사용하며, metric 은 risk 계산 API 에서만 기록)
+	riskLevel, triggeredRules := internalVital.Evaluate(vitalAverages)
+	for _, name := range triggeredRules {
+		metrics.InferenceTriggeredRules.WithLabelValues(name).Inc()
 	}
+	metrics.InferenceRuns.WithLabelValues(riskLevel.String()).Inc()
 
 	return &inference.VitalRiskResponse{
 		PatientID:          request.PatientID,
+		EncounterID:        encounterID,
 		RiskLevel:          riskLevel.String(),
 		TriggeredRules:     triggeredRules,
 		VitalAverages:      vitalAverages,
@@ -104,9 +114,27 @@ func (i *inferenceService) CalculateVitalRisk(ctx context.Context, request infer
 	}, nil
 }
 
-func NewInferenceService(vitalRepo vital.VitalRepository, patientRepo patient.PatientRepository) inference.InferenceService {
+// findTargetEncounter 위험도 계산 대상 encounter (지정된 encounter 혹은 진행 중인 encounter)
+func (i *inferenceService) findTargetEncounter(ctx context.Context, request inference.VitalRiskRequest) (*encounter.Encounter, error) {
+	if request.EncounterID != "" {
+		return resolveEncounter(ctx, i.encounterRepo, request.PatientID, request.EncounterID)
+	}
+
+	active, err := i.encounterRepo.FindActiveEncounterByPatientID(ctx, request.PatientID)
+	if err != nil {
+		if pkgError.CompareBusinessError(err, pkgError.NotFound) {
+			return nil, nil
+		}
+		return nil, pkgError.Wrap(err)
+	}
+
+	return active, nil
+}
+
+func NewInferenceService(vitalRepo vital.VitalRepository, patientRepo patient.PatientRepository, encounterRepo encounter.EncounterRepository) inference.InferenceService {
 	return &inferenceService{
-		vitalRepo:   vitalRepo,
-		patientRepo: patientRepo,
+		vitalRepo:     vitalRepo,
+		patientRepo:   patientRepo,
+		encounterRepo: encounterRepo,
 	}
 }
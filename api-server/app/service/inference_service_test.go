@@ -1,6 +1,7 @@
 package service
 
 import (
+	"aitrics-vital-signs/api-server/domain/encounter"
 	"aitrics-vital-signs/api-server/domain/inference"
 	"aitrics-vital-signs/api-server/domain/mock"
 	"aitrics-vital-signs/api-server/domain/patient"
@@ -25,7 +26,16 @@ func beforeEachInference(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	mockVitalRepo = mock.NewMockVitalRepository(ctrl)
 	mockPatientRepository = mock.NewMockPatientRepository(ctrl)
-	inferenceSvc = NewInferenceService(mockVitalRepo, mockPatientRepository)
+	mockEncounterRepository = mock.NewMockEncounterRepository(ctrl)
+	inferenceSvc = NewInferenceService(mockVitalRepo, mockPatientRepository, mockEncounterRepository)
+}
+
+// expectNoActiveEncounter 진행 중인 encounter 가 없는 환자 (전체 vital 대상)
+func expectNoActiveEncounter() {
+	mockEncounterRepository.EXPECT().
+		FindActiveEncounterByPatientID(gomock.Any(), gomock.Any()).
+		Return(nil, notFoundErr()).
+		AnyTimes()
 }
 
 func Test_CalculateVitalRisk(t *testing.T) {
@@ -217,6 +227,7 @@ func Test_CalculateVitalRisk(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			beforeEachInference(t)
 			tt.setupMock()
+			expectNoActiveEncounter()
 
 			result, err := inferenceSvc.CalculateVitalRisk(ctx, tt.req)
 
@@ -247,6 +258,7 @@ func Test_CalculateVitalRisk_EnvironmentVariable(t *testing.T) {
 	// 테스트 실행 전 VITAL_RISK_TIME_WINDOW_HOURS 환경변수를 설정해야 함
 	// 기본값은 24시간
 	beforeEachInference(t)
+	expectNoActiveEncounter()
 
 	timeWindow := envs.VitalRiskTimeWindowHours
 	mockPatientRepository.EXPECT().
@@ -269,3 +281,28 @@ func Test_CalculateVitalRisk_EnvironmentVariable(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, result)
 }
+
+func Test_CalculateVitalRisk_ActiveEncounter(t *testing.T) {
+	beforeEachInference(t)
+
+	// 2시간 전에 시작된 encounter 는 24시간 window 보다 짧으므로 입원 시각부터 계산
+	admittedAt := time.Now().UTC().Add(-2 * time.Hour)
+	mockPatientRepository.EXPECT().
+		FindPatientByID(gomock.Any(), "P00001234").
+		Return(&patient.Patient{PatientID: "P00001234"}, nil)
+	mockEncounterRepository.EXPECT().
+		FindActiveEncounterByPatientID(gomock.Any(), "P00001234").
+		Return(&encounter.Encounter{ID: "enc-1", PatientID: "P00001234", AdmittedAt: admittedAt}, nil)
+	mockVitalRepo.EXPECT().
+		FindVitalsByPatientIDAndDateRange(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, param vital.FindVitalsByPatientIDAndDateRangeParam) ([]vital.Vital, error) {
+			require.Equal(t, "enc-1", param.EncounterID)
+			require.Equal(t, admittedAt, param.From)
+			return []vital.Vital{}, nil
+		})
+
+	result, err := inferenceSvc.CalculateVitalRisk(context.Background(), inference.VitalRiskRequest{PatientID: "P00001234"})
+	require.NoError(t, err)
+	require.Equal(t, "enc-1", *result.EncounterID)
+	require.Equal(t, admittedAt, result.TimeRange.From)
+}
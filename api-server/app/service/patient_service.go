@@ -1,8 +1,13 @@
 package service
 
 import (
+	"aitrics-vital-signs/api-server/domain"
+	"aitrics-vital-signs/api-server/domain/encounter"
 	"aitrics-vital-signs/api-server/domain/patient"
 	"aitrics-vital-signs/api-server/domain/vital"
+	"aitrics-vital-signs/api-server/internal/metrics"
+	"aitrics-vital-signs/api-server/internal/tracing"
+	"aitrics-vital-signs/api-server/pkg/constant"
 	pkgError "aitrics-vital-signs/library/error"
 	"context"
 	"math"
@@ -12,11 +17,16 @@ import (
 )
 
 type patientService struct {
-	repo      patient.PatientRepository
-	vitalRepo vital.VitalRepository
+	repo          patient.PatientRepository
+	vitalRepo     vital.VitalRepository
+	encounterRepo encounter.EncounterRepository
+	transactor    domain.Transactor
 }
 
 func (p *patientService) CreatePatient(ctx context.Context, request patient.CreatePatientRequest) error {
+	ctx, span := tracing.Start(ctx, "PatientService.CreatePatient")
+	defer span.End()
+
 	birthDate, err := time.Parse(time.DateOnly, request.BirthDate)
 	if err != nil {
 		return pkgError.WrapWithCode(err, pkgError.WrongParam)
@@ -38,18 +48,42 @@ func (p *patientService) CreatePatient(ctx context.Context, request patient.Crea
 	return nil
 }
 
+func (p *patientService) GetPatient(ctx context.Context, patientID string) (*patient.PatientResponse, error) {
+	ctx, span := tracing.Start(ctx, "PatientService.GetPatient")
+	defer span.End()
+
+	model, err := p.repo.FindPatientByID(ctx, patientID)
+	if err != nil {
+		return nil, pkgError.Wrap(err)
+	}
+
+	return &patient.PatientResponse{
+		PatientID: model.PatientID,
+		Name:      model.Name,
+		Gender:    model.Gender,
+		BirthDate: model.BirthDate.Format(time.DateOnly),
+		Version:   model.Version,
+	}, nil
+}
+
 func (p *patientService) UpdatePatient(ctx context.Context, patientID string, request patient.UpdatePatientRequest) error {
+	ctx, span := tracing.Start(ctx, "PatientService.UpdatePatient")
+	defer span.End()
+
 	birthDate, err := time.Parse(time.DateOnly, request.BirthDate)
 	if err != nil {
 		return pkgError.WrapWithCode(err, pkgError.WrongParam)
 	}
 
+	// version(If-Match) 확인은 primary 의 최신 데이터 기준
+	ctx = domain.ReadForWrite(ctx)
 	existingPatient, err := p.repo.FindPatientByID(ctx, patientID)
 	if err != nil {
 		return pkgError.Wrap(err)
 	}
 
 	if existingPatient.Version != request.Version {
+		metrics.OptimisticLockConflicts.WithLabelValues(metrics.EntityPatient).Inc()
 		return pkgError.WrapWithCode(pkgError.EmptyBusinessError(), pkgError.Conflict, "version mismatch")
 	}
 
@@ -61,29 +95,185 @@ func (p *patientService) UpdatePatient(ctx context.Context, patientID string, re
 	existingPatient.UpdatedAt = &now
 
 	if err := p.repo.UpdatePatient(ctx, existingPatient); err != nil {
+		metrics.RecordConflict(metrics.EntityPatient, err)
 		return pkgError.Wrap(err)
 	}
 
 	return nil
 }
 
-func (p *patientService) GetPatientVitals(ctx context.Context, patientID string, request patient.GetPatientVitalsRequest) (*patient.GetPatientVitalsResponse, error) {
-	// Query Parameter 날짜 파싱
-	from, err := time.Parse(time.RFC3339, request.From)
+func (p *patientService) ReplacePatient(ctx context.Context, patientID string, request patient.UpdatePatientRequest) (*patient.ReplacePatientResponse, error) {
+	ctx, span := tracing.Start(ctx, "PatientService.ReplacePatient")
+	defer span.End()
+
+	birthDate, err := time.Parse(time.DateOnly, request.BirthDate)
+	if err != nil {
+		return nil, pkgError.WrapWithCode(err, pkgError.WrongParam)
+	}
+
+	var (
+		created bool
+		model   *patient.Patient
+	)
+	// 교체와 재조회를 같은 트랜잭션에서 수행해 다른 요청의 변경이 섞인 version 을 응답하지 않도록 합니다.
+	if err := p.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		now := time.Now().UTC()
+		var err error
+		created, err = p.repo.ReplacePatient(ctx, &patient.Patient{
+			ID:        uuid.NewString(),
+			PatientID: patientID,
+			Name:      request.Name,
+			Gender:    request.Gender,
+			BirthDate: birthDate,
+			CreatedAt: now,
+			UpdatedAt: &now,
+		})
+		if err != nil {
+			return err
+		}
+
+		model, err = p.repo.FindPatientByID(ctx, patientID)
+		return err
+	}); err != nil {
+		return nil, pkgError.Wrap(err)
+	}
+
+	return &patient.ReplacePatientResponse{
+		PatientResponse: patient.PatientResponse{
+			PatientID: model.PatientID,
+			Name:      model.Name,
+			Gender:    model.Gender,
+			BirthDate: model.BirthDate.Format(time.DateOnly),
+			Version:   model.Version,
+		},
+		Created: created,
+	}, nil
+}
+
+func (p *patientService) MergePatients(ctx context.Context, targetPatientID string, request patient.MergePatientsRequest) (*patient.PatientMergeResponse, error) {
+	ctx, span := tracing.Start(ctx, "PatientService.MergePatients")
+	defer span.End()
+
+	if request.SourcePatientID == targetPatientID {
+		return nil, pkgError.WrapWithCode(pkgError.EmptyBusinessError(), pkgError.WrongParam, "source and target patients must be different")
+	}
+
+	policy := constant.MergeCollisionPolicy(request.CollisionPolicy)
+	if policy == "" {
+		policy = constant.MergeCollisionPolicyReject
+	}
+
+	var model *patient.PatientMerge
+	if err := p.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		model, err = p.repo.MergePatients(ctx, patient.MergePatientsParam{
+			MergeID:         uuid.NewString(),
+			SourcePatientID: request.SourcePatientID,
+			TargetPatientID: targetPatientID,
+			CollisionPolicy: policy.String(),
+			Now:             time.Now().UTC(),
+		})
+		return err
+	}); err != nil {
+		return nil, pkgError.Wrap(err)
+	}
+
+	return newPatientMergeResponse(model), nil
+}
+
+func (p *patientService) RevertPatientMerge(ctx context.Context, targetPatientID, mergeID string) (*patient.PatientMergeResponse, error) {
+	ctx, span := tracing.Start(ctx, "PatientService.RevertPatientMerge")
+	defer span.End()
+
+	var model *patient.PatientMerge
+	if err := p.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		model, err = p.repo.RevertPatientMerge(ctx, patient.RevertPatientMergeParam{
+			MergeID:         mergeID,
+			TargetPatientID: targetPatientID,
+			Now:             time.Now().UTC(),
+		})
+		return err
+	}); err != nil {
+		return nil, pkgError.Wrap(err)
+	}
+
+	return newPatientMergeResponse(model), nil
+}
+
+func (p *patientService) GetPatientMerges(ctx context.Context, patientID string) (*patient.GetPatientMergesResponse, error) {
+	ctx, span := tracing.Start(ctx, "PatientService.GetPatientMerges")
+	defer span.End()
+
+	models, err := p.repo.FindPatientMergesByPatientID(ctx, patientID)
 	if err != nil {
-		return nil, pkgError.WrapWithCode(err, pkgError.WrongParam, "invalid from date format")
+		return nil, pkgError.Wrap(err)
+	}
+
+	items := make([]patient.PatientMergeResponse, 0, len(models))
+	for i := range models {
+		items = append(items, *newPatientMergeResponse(&models[i]))
+	}
+	return &patient.GetPatientMergesResponse{Items: items}, nil
+}
+
+func (p *patientService) ChangePatientID(ctx context.Context, patientID string, request patient.ChangePatientIDRequest) (*patient.PatientResponse, error) {
+	ctx, span := tracing.Start(ctx, "PatientService.ChangePatientID")
+	defer span.End()
+
+	if request.NewPatientID == patientID {
+		return nil, pkgError.WrapWithCode(pkgError.EmptyBusinessError(), pkgError.WrongParam, "new patient_id must be different")
 	}
 
-	to, err := time.Parse(time.RFC3339, request.To)
+	var response *patient.PatientResponse
+	if err := p.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := p.repo.ChangePatientID(ctx, patient.ChangePatientIDParam{
+			PatientID:    patientID,
+			NewPatientID: request.NewPatientID,
+			Version:      request.Version,
+			Now:          time.Now().UTC(),
+		}); err != nil {
+			return err
+		}
+
+		var err error
+		response, err = p.GetPatient(ctx, request.NewPatientID)
+		return err
+	}); err != nil {
+		return nil, pkgError.Wrap(err)
+	}
+
+	return response, nil
+}
+
+func newPatientMergeResponse(model *patient.PatientMerge) *patient.PatientMergeResponse {
+	return &patient.PatientMergeResponse{
+		MergeID:         model.ID,
+		SourcePatientID: model.SourcePatientID,
+		TargetPatientID: model.TargetPatientID,
+		CollisionPolicy: model.CollisionPolicy,
+		MovedCount:      model.MovedCount,
+		CollisionCount:  model.CollisionCount,
+		MergedAt:        model.MergedAt,
+		RevertedAt:      model.RevertedAt,
+	}
+}
+
+func (p *patientService) GetPatientVitals(ctx context.Context, patientID string, request patient.GetPatientVitalsRequest) (*patient.GetPatientVitalsResponse, error) {
+	ctx, span := tracing.Start(ctx, "PatientService.GetPatientVitals")
+	defer span.End()
+
+	from, to, err := p.parsePatientVitalsRange(ctx, patientID, request)
 	if err != nil {
-		return nil, pkgError.WrapWithCode(err, pkgError.WrongParam, "invalid to date format")
+		return nil, pkgError.Wrap(err)
 	}
 
 	vitals, err := p.vitalRepo.FindVitalsByPatientIDAndDateRange(ctx, vital.FindVitalsByPatientIDAndDateRangeParam{
-		PatientID:  patientID,
-		From:       from,
-		To:         to,
-		VitalTypes: request.VitalTypes,
+		PatientID:   patientID,
+		From:        from,
+		To:          to,
+		VitalTypes:  request.VitalTypes,
+		EncounterID: request.EncounterID,
 	})
 	if err != nil {
 		return nil, pkgError.Wrap(err)
@@ -95,6 +285,7 @@ func (p *patientService) GetPatientVitals(ctx context.Context, patientID string,
 			VitalType:  v.VitalType,
 			RecordedAt: v.RecordedAt,
 			Value:      math.Round(v.Value*10) / 10,
+			Version:    v.Version,
 		})
 	}
 
@@ -104,9 +295,71 @@ func (p *patientService) GetPatientVitals(ctx context.Context, patientID string,
 	}, nil
 }
 
-func NewPatientService(repo patient.PatientRepository, vitalRepo vital.VitalRepository) patient.PatientService {
+func (p *patientService) StreamPatientVitals(ctx context.Context, patientID string, request patient.GetPatientVitalsRequest, fn func(*vital.Vital) error) error {
+	ctx, span := tracing.Start(ctx, "PatientService.StreamPatientVitals")
+	defer span.End()
+
+	from, to, err := p.parsePatientVitalsRange(ctx, patientID, request)
+	if err != nil {
+		return pkgError.Wrap(err)
+	}
+	if err := validateExportRange(from, to); err != nil {
+		return err
+	}
+
+	if err := p.vitalRepo.StreamVitalsByPatientIDsAndDateRange(ctx, vital.StreamVitalsByPatientIDsAndDateRangeParam{
+		PatientIDs:  []string{patientID},
+		From:        from,
+		To:          to,
+		VitalTypes:  request.VitalTypes,
+		EncounterID: request.EncounterID,
+	}, fn); err != nil {
+		return pkgError.Wrap(err)
+	}
+
+	return nil
+}
+
+// parsePatientVitalsRange
+// encounter_id 가 지정되면 해당 환자의 encounter 인지 검증하고, 생략된 from / to 를 encounter 기간으로 채웁니다.
+func (p *patientService) parsePatientVitalsRange(ctx context.Context, patientID string, request patient.GetPatientVitalsRequest) (time.Time, time.Time, error) {
+	model, err := resolveEncounter(ctx, p.encounterRepo, patientID, request.EncounterID)
+	if err != nil {
+		return time.Time{}, time.Time{}, pkgError.Wrap(err)
+	}
+
+	if model != nil {
+		if request.From == "" {
+			request.From = model.AdmittedAt.Format(time.RFC3339Nano)
+		}
+		if request.To == "" {
+			to := time.Now().UTC()
+			if model.DischargedAt != nil {
+				to = *model.DischargedAt
+			}
+			request.To = to.Format(time.RFC3339Nano)
+		}
+	}
+
+	return parseVitalTimeRange(request.From, request.To)
+}
+
+func (p *patientService) PurgeDeletedPatients(ctx context.Context, before time.Time) (int64, error) {
+	ctx, span := tracing.Start(ctx, "PatientService.PurgeDeletedPatients")
+	defer span.End()
+
+	purged, err := p.repo.PurgeDeletedPatients(ctx, before)
+	if err != nil {
+		return 0, pkgError.Wrap(err)
+	}
+	return purged, nil
+}
+
+func NewPatientService(repo patient.PatientRepository, vitalRepo vital.VitalRepository, encounterRepo encounter.EncounterRepository, transactor domain.Transactor) patient.PatientService {
 	return &patientService{
-		repo:      repo,
-		vitalRepo: vitalRepo,
+		repo:          repo,
+		vitalRepo:     vitalRepo,
+		encounterRepo: encounterRepo,
+		transactor:    transactor,
 	}
 }
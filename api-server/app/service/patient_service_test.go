@@ -1,9 +1,12 @@
 package service
 
 import (
+	"aitrics-vital-signs/api-server/domain"
+	"aitrics-vital-signs/api-server/domain/encounter"
 	"aitrics-vital-signs/api-server/domain/mock"
 	"aitrics-vital-signs/api-server/domain/patient"
 	"aitrics-vital-signs/api-server/domain/vital"
+	"aitrics-vital-signs/api-server/pkg/constant"
 	pkgError "aitrics-vital-signs/library/error"
 	"context"
 	"testing"
@@ -17,6 +20,7 @@ import (
 
 var (
 	mockRepository *mock.MockPatientRepository
+	mockTransactor *mock.MockTransactor
 	svc            patient.PatientService
 )
 
@@ -24,7 +28,14 @@ func beforeEach(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	mockRepository = mock.NewMockPatientRepository(ctrl)
 	mockVitalRepository = mock.NewMockVitalRepository(ctrl)
-	svc = NewPatientService(mockRepository, mockVitalRepository)
+	mockEncounterRepository = mock.NewMockEncounterRepository(ctrl)
+	mockTransactor = mock.NewMockTransactor(ctrl)
+	// 트랜잭션 안의 repository 호출만 검증하므로 fn 을 그대로 실행합니다.
+	mockTransactor.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, fn func(ctx context.Context) error, opts ...domain.TxOption) error {
+			return fn(ctx)
+		}).AnyTimes()
+	svc = NewPatientService(mockRepository, mockVitalRepository, mockEncounterRepository, mockTransactor)
 }
 
 func Test_CreatePatient(t *testing.T) {
@@ -63,7 +74,7 @@ func Test_CreatePatient(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			mockRepository.EXPECT().
-				CreatePatient(ctx, gomock.Any()).
+				CreatePatient(gomock.Any(), gomock.Any()).
 				DoAndReturn(func(_ context.Context, p *patient.Patient) error {
 					require.NotEmpty(t, p.ID)
 					require.Equal(t, tt.req.PatientID, p.PatientID)
@@ -91,6 +102,61 @@ func Test_CreatePatient(t *testing.T) {
 	}
 }
 
+func Test_GetPatient(t *testing.T) {
+	tests := []struct {
+		name        string
+		setupMock   func()
+		want        *patient.PatientResponse
+		wantErr     bool
+		expectedErr error
+	}{
+		{
+			name: "성공",
+			setupMock: func() {
+				mockRepository.EXPECT().
+					FindPatientByID(gomock.Any(), "P00001234").
+					Return(&patient.Patient{
+						PatientID: "P00001234",
+						Name:      "홍길동",
+						Gender:    "M",
+						BirthDate: time.Date(1975, 3, 1, 0, 0, 0, 0, time.UTC),
+						Version:   2,
+					}, nil)
+			},
+			want: &patient.PatientResponse{PatientID: "P00001234", Name: "홍길동", Gender: "M", BirthDate: "1975-03-01", Version: 2},
+		},
+		{
+			name: "실패 - 환자 없음",
+			setupMock: func() {
+				mockRepository.EXPECT().
+					FindPatientByID(gomock.Any(), "P00001234").
+					Return(nil, pkgError.WrapWithCode(gorm.ErrRecordNotFound, pkgError.NotFound))
+			},
+			wantErr:     true,
+			expectedErr: pkgError.WrapWithCode(pkgError.EmptyBusinessError(), pkgError.NotFound),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			beforeEach(t)
+			tt.setupMock()
+
+			result, err := svc.GetPatient(context.Background(), "P00001234")
+
+			if tt.wantErr {
+				require.Error(t, err)
+				expectedBE, _ := pkgError.CastBusinessError(tt.expectedErr)
+				actualBE, _ := pkgError.CastBusinessError(err)
+				require.Equal(t, expectedBE.Status.Code, actualBE.Status.Code)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.want, result)
+			}
+		})
+	}
+}
+
 func Test_UpdatePatient(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -121,9 +187,13 @@ func Test_UpdatePatient(t *testing.T) {
 					CreatedAt: now,
 					UpdatedAt: &now,
 				}
+				// version 확인용 조회는 replica 가 아닌 primary 에서
 				mockRepository.EXPECT().
 					FindPatientByID(gomock.Any(), "P00001234").
-					Return(existingPatient, nil)
+					DoAndReturn(func(ctx context.Context, _ string) (*patient.Patient, error) {
+						require.True(t, domain.ReadFromPrimary(ctx))
+						return existingPatient, nil
+					})
 				mockRepository.EXPECT().
 					UpdatePatient(gomock.Any(), gomock.Any()).
 					DoAndReturn(func(_ context.Context, p *patient.Patient) error {
@@ -219,6 +289,253 @@ func Test_UpdatePatient(t *testing.T) {
 	}
 }
 
+func Test_ReplacePatient(t *testing.T) {
+	req := patient.UpdatePatientRequest{
+		Name:      "홍길동",
+		Gender:    "M",
+		BirthDate: "1975-03-01",
+	}
+
+	tests := []struct {
+		name        string
+		req         patient.UpdatePatientRequest
+		setupMock   func()
+		wantErr     bool
+		expectedErr pkgError.Code
+		wantCreated bool
+		wantVersion int
+	}{
+		{
+			name: "성공 - 신규 등록",
+			req:  req,
+			setupMock: func() {
+				mockRepository.EXPECT().
+					ReplacePatient(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, p *patient.Patient) (bool, error) {
+						require.Equal(t, "P00001234", p.PatientID)
+						require.Equal(t, "홍길동", p.Name)
+						require.NotEmpty(t, p.ID)
+						return true, nil
+					})
+				mockRepository.EXPECT().
+					FindPatientByID(gomock.Any(), "P00001234").
+					Return(&patient.Patient{PatientID: "P00001234", Name: "홍길동", Gender: "M", Version: 1}, nil)
+			},
+			wantCreated: true,
+			wantVersion: 1,
+		},
+		{
+			name: "성공 - 기존 환자 교체",
+			req:  req,
+			setupMock: func() {
+				mockRepository.EXPECT().
+					ReplacePatient(gomock.Any(), gomock.Any()).
+					Return(false, nil)
+				mockRepository.EXPECT().
+					FindPatientByID(gomock.Any(), "P00001234").
+					Return(&patient.Patient{PatientID: "P00001234", Name: "홍길동", Gender: "M", Version: 3}, nil)
+			},
+			wantCreated: false,
+			wantVersion: 3,
+		},
+		{
+			name: "실패 - 잘못된 생년월일",
+			req: patient.UpdatePatientRequest{
+				Name:      "홍길동",
+				Gender:    "M",
+				BirthDate: "1975/03/01",
+			},
+			setupMock:   func() {},
+			wantErr:     true,
+			expectedErr: pkgError.WrongParam,
+		},
+		{
+			name: "실패 - DB 오류",
+			req:  req,
+			setupMock: func() {
+				mockRepository.EXPECT().
+					ReplacePatient(gomock.Any(), gomock.Any()).
+					Return(false, pkgError.WrapWithCode(pkgError.EmptyBusinessError(), pkgError.Upsert))
+			},
+			wantErr:     true,
+			expectedErr: pkgError.Upsert,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			beforeEach(t)
+			tt.setupMock()
+
+			result, err := svc.ReplacePatient(context.Background(), "P00001234", tt.req)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				require.True(t, pkgError.CompareBusinessError(err, tt.expectedErr))
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.wantCreated, result.Created)
+			require.Equal(t, tt.wantVersion, result.Version)
+			require.Equal(t, "P00001234", result.PatientID)
+		})
+	}
+}
+
+func Test_MergePatients(t *testing.T) {
+	now := time.Now().UTC()
+
+	tests := []struct {
+		name        string
+		req         patient.MergePatientsRequest
+		setupMock   func()
+		wantErr     bool
+		expectedErr pkgError.Code
+	}{
+		{
+			name: "성공 - 정책 생략 시 reject 로 병합",
+			req:  patient.MergePatientsRequest{SourcePatientID: "P00000002"},
+			setupMock: func() {
+				mockRepository.EXPECT().
+					MergePatients(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, param patient.MergePatientsParam) (*patient.PatientMerge, error) {
+						require.Equal(t, "P00000002", param.SourcePatientID)
+						require.Equal(t, "P00000001", param.TargetPatientID)
+						require.Equal(t, constant.MergeCollisionPolicyReject.String(), param.CollisionPolicy)
+						require.NotEmpty(t, param.MergeID)
+						return &patient.PatientMerge{
+							ID:              param.MergeID,
+							SourcePatientID: param.SourcePatientID,
+							TargetPatientID: param.TargetPatientID,
+							CollisionPolicy: param.CollisionPolicy,
+							MovedCount:      10,
+							MergedAt:        now,
+						}, nil
+					})
+			},
+		},
+		{
+			name:        "실패 - source 와 target 이 같은 환자",
+			req:         patient.MergePatientsRequest{SourcePatientID: "P00000001"},
+			setupMock:   func() {},
+			wantErr:     true,
+			expectedErr: pkgError.WrongParam,
+		},
+		{
+			name: "실패 - vital 충돌 (reject)",
+			req:  patient.MergePatientsRequest{SourcePatientID: "P00000002", CollisionPolicy: constant.MergeCollisionPolicyReject.String()},
+			setupMock: func() {
+				mockRepository.EXPECT().
+					MergePatients(gomock.Any(), gomock.Any()).
+					Return(nil, pkgError.WrapWithCode(pkgError.EmptyBusinessError(), pkgError.Conflict))
+			},
+			wantErr:     true,
+			expectedErr: pkgError.Conflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			beforeEach(t)
+			tt.setupMock()
+
+			result, err := svc.MergePatients(context.Background(), "P00000001", tt.req)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				require.True(t, pkgError.CompareBusinessError(err, tt.expectedErr))
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, "P00000002", result.SourcePatientID)
+			require.Equal(t, 10, result.MovedCount)
+			require.Nil(t, result.RevertedAt)
+		})
+	}
+}
+
+func Test_RevertPatientMerge(t *testing.T) {
+	beforeEach(t)
+
+	now := time.Now().UTC()
+	mockRepository.EXPECT().
+		RevertPatientMerge(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, param patient.RevertPatientMergeParam) (*patient.PatientMerge, error) {
+			require.Equal(t, "merge-1", param.MergeID)
+			require.Equal(t, "P00000001", param.TargetPatientID)
+			return &patient.PatientMerge{ID: "merge-1", MergedAt: now.Add(-time.Hour), RevertedAt: &now}, nil
+		})
+
+	result, err := svc.RevertPatientMerge(context.Background(), "P00000001", "merge-1")
+	require.NoError(t, err)
+	require.Equal(t, "merge-1", result.MergeID)
+	require.NotNil(t, result.RevertedAt)
+}
+
+func Test_ChangePatientID(t *testing.T) {
+	tests := []struct {
+		name        string
+		req         patient.ChangePatientIDRequest
+		setupMock   func()
+		wantErr     bool
+		expectedErr pkgError.Code
+	}{
+		{
+			name: "성공 - 외부 환자 ID 변경",
+			req:  patient.ChangePatientIDRequest{NewPatientID: "P00000009", Version: 2},
+			setupMock: func() {
+				mockRepository.EXPECT().
+					ChangePatientID(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, param patient.ChangePatientIDParam) error {
+						require.Equal(t, "P00000001", param.PatientID)
+						require.Equal(t, "P00000009", param.NewPatientID)
+						require.Equal(t, 2, param.Version)
+						return nil
+					})
+				mockRepository.EXPECT().
+					FindPatientByID(gomock.Any(), "P00000009").
+					Return(&patient.Patient{PatientID: "P00000009", Name: "홍길동", Gender: "M", Version: 3}, nil)
+			},
+		},
+		{
+			name:        "실패 - 같은 ID 로 변경",
+			req:         patient.ChangePatientIDRequest{NewPatientID: "P00000001", Version: 2},
+			setupMock:   func() {},
+			wantErr:     true,
+			expectedErr: pkgError.WrongParam,
+		},
+		{
+			name: "실패 - 이미 사용 중인 ID",
+			req:  patient.ChangePatientIDRequest{NewPatientID: "P00000009", Version: 2},
+			setupMock: func() {
+				mockRepository.EXPECT().
+					ChangePatientID(gomock.Any(), gomock.Any()).
+					Return(pkgError.WrapWithCode(pkgError.EmptyBusinessError(), pkgError.Conflict, "duplicate key"))
+			},
+			wantErr:     true,
+			expectedErr: pkgError.Conflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			beforeEach(t)
+			tt.setupMock()
+
+			result, err := svc.ChangePatientID(context.Background(), "P00000001", tt.req)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				require.True(t, pkgError.CompareBusinessError(err, tt.expectedErr))
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, "P00000009", result.PatientID)
+			require.Equal(t, 3, result.Version)
+		})
+	}
+}
+
 func Test_GetPatientVitals(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -259,6 +576,23 @@ func Test_GetPatientVitals(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name:      "성공 - from 이 to 보다 늦어도 기존처럼 조회 (빈 결과)",
+			patientID: "P00001234",
+			req: patient.GetPatientVitalsRequest{
+				From: "2025-12-01T12:00:00Z",
+				To:   "2025-12-01T10:00:00Z",
+			},
+			setupMock: func() {
+				mockVitalRepository.EXPECT().
+					FindVitalsByPatientIDAndDateRange(gomock.Any(), vital.FindVitalsByPatientIDAndDateRangeParam{
+						PatientID: "P00001234",
+						From:      time.Date(2025, 12, 1, 12, 0, 0, 0, time.UTC),
+						To:        time.Date(2025, 12, 1, 10, 0, 0, 0, time.UTC),
+					}).
+					Return([]vital.Vital{}, nil)
+			},
+		},
 		{
 			name:      "성공 - vital_type 없을 때 (모든 타입)",
 			patientID: "P00001234",
@@ -363,3 +697,97 @@ func Test_GetPatientVitals(t *testing.T) {
 		})
 	}
 }
+
+func Test_GetPatientVitals_Encounter(t *testing.T) {
+	beforeEach(t)
+
+	// 종료된 encounter 를 지정하면 from / to 가 encounter 기간으로 채워짐
+	admittedAt := time.Date(2023, 3, 1, 9, 0, 0, 0, time.UTC)
+	dischargedAt := time.Date(2023, 3, 5, 15, 30, 0, 0, time.UTC)
+	mockEncounterRepository.EXPECT().
+		FindEncounterByID(gomock.Any(), "enc-1").
+		Return(&encounter.Encounter{ID: "enc-1", PatientID: "P00001234", AdmittedAt: admittedAt, DischargedAt: &dischargedAt}, nil)
+	mockVitalRepository.EXPECT().
+		FindVitalsByPatientIDAndDateRange(gomock.Any(), vital.FindVitalsByPatientIDAndDateRangeParam{
+			PatientID:   "P00001234",
+			From:        admittedAt,
+			To:          dischargedAt,
+			EncounterID: "enc-1",
+		}).
+		Return([]vital.Vital{}, nil)
+
+	result, err := svc.GetPatientVitals(context.Background(), "P00001234", patient.GetPatientVitalsRequest{EncounterID: "enc-1"})
+	require.NoError(t, err)
+	require.Equal(t, "P00001234", result.PatientID)
+}
+
+func Test_StreamPatientVitals(t *testing.T) {
+	tests := []struct {
+		name        string
+		req         patient.GetPatientVitalsRequest
+		setupMock   func()
+		wantErr     bool
+		expectedErr error
+	}{
+		{
+			name: "성공 - 단일 환자 스트리밍",
+			req: patient.GetPatientVitalsRequest{
+				From:       "2025-12-01T10:00:00Z",
+				To:         "2025-12-01T12:00:00Z",
+				VitalTypes: []string{"HR"},
+			},
+			setupMock: func() {
+				mockVitalRepository.EXPECT().
+					StreamVitalsByPatientIDsAndDateRange(gomock.Any(), vital.StreamVitalsByPatientIDsAndDateRangeParam{
+						PatientIDs: []string{"P00001234"},
+						From:       time.Date(2025, 12, 1, 10, 0, 0, 0, time.UTC),
+						To:         time.Date(2025, 12, 1, 12, 0, 0, 0, time.UTC),
+						VitalTypes: []string{"HR"},
+					}, gomock.Any()).
+					Return(nil)
+			},
+		},
+		{
+			name: "실패 - from 이 to 보다 늦음",
+			req: patient.GetPatientVitalsRequest{
+				From: "2025-12-01T12:00:00Z",
+				To:   "2025-12-01T10:00:00Z",
+			},
+			setupMock:   func() {},
+			wantErr:     true,
+			expectedErr: pkgError.WrapWithCode(pkgError.EmptyBusinessError(), pkgError.WrongParam),
+		},
+		{
+			name: "실패 - Vital Repository 에러",
+			req: patient.GetPatientVitalsRequest{
+				From: "2025-12-01T10:00:00Z",
+				To:   "2025-12-01T12:00:00Z",
+			},
+			setupMock: func() {
+				mockVitalRepository.EXPECT().
+					StreamVitalsByPatientIDsAndDateRange(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(pkgError.WrapWithCode(pkgError.EmptyBusinessError(), pkgError.Get, "db error"))
+			},
+			wantErr:     true,
+			expectedErr: pkgError.WrapWithCode(pkgError.EmptyBusinessError(), pkgError.Get),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			beforeEach(t)
+			tt.setupMock()
+
+			err := svc.StreamPatientVitals(context.Background(), "P00001234", tt.req, func(*vital.Vital) error { return nil })
+
+			if tt.wantErr {
+				require.Error(t, err)
+				expectedBE, _ := pkgError.CastBusinessError(tt.expectedErr)
+				actualBE, _ := pkgError.CastBusinessError(err)
+				require.Equal(t, expectedBE.Status.Code, actualBE.Status.Code)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
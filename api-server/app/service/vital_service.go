@@ -1,77 +1,293 @@
 package service
 
 import (
+	"aitrics-vital-signs/api-server/domain"
+	"aitrics-vital-signs/api-server/domain/encounter"
 	"aitrics-vital-signs/api-server/domain/patient"
 	"aitrics-vital-signs/api-server/domain/vital"
+	"aitrics-vital-signs/api-server/internal/metrics"
+	"aitrics-vital-signs/api-server/internal/tracing"
+	"aitrics-vital-signs/api-server/pkg/constant"
 	pkgError "aitrics-vital-signs/library/error"
 	"context"
+	"database/sql"
+	"fmt"
 	"time"
 )
 
 type vitalService struct {
-	repo        vital.VitalRepository
-	patientRepo patient.PatientRepository
+	repo          vital.VitalRepository
+	patientRepo   patient.PatientRepository
+	encounterRepo encounter.EncounterRepository
+	transactor    domain.Transactor
 }
 
-func (v *vitalService) UpsertVital(ctx context.Context, request vital.UpsertVitalRequest) error {
-	// 등록된 patient 검증
-	_, err := v.patientRepo.FindPatientByID(ctx, request.PatientID)
+// maxConflictAttempts conflict_policy 적용 시 충돌이 계속되는 경우의 최대 저장 시도 횟수
+const maxConflictAttempts = 3
+
+func (v *vitalService) UpsertVital(ctx context.Context, request vital.UpsertVitalRequest) (*vital.UpsertVitalResponse, error) {
+	ctx, span := tracing.Start(ctx, "VitalService.UpsertVital")
+	defer span.End()
+
+	policy := constant.ConflictPolicy(request.ConflictPolicy)
+	if policy == "" {
+		policy = constant.ConflictPolicyStrict
+	}
+	if policy == constant.ConflictPolicyStrict && request.Version < 1 {
+		return nil, pkgError.WrapWithCode(pkgError.EmptyBusinessError(), pkgError.WrongParam, "version is required for strict conflict policy")
+	}
+
+	// patient 검증, encounter 연결, 저장을 한 트랜잭션으로 수행
+	// conflict_policy 재시도가 다른 요청이 commit 한 최신 데이터를 다시 읽을 수 있도록 READ COMMITTED 를 사용합니다.
+	var response *vital.UpsertVitalResponse
+	if err := v.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		// 등록된 patient 검증
+		if _, err := v.patientRepo.FindPatientByID(ctx, request.PatientID); err != nil {
+			return pkgError.Wrap(err)
+		}
+
+		// 명시된 encounter 는 검증 후 (재)연결하고, 아니면 recorded_at 으로 찾은 encounter 를 미연결 데이터에만 연결
+		encounterID, err := v.linkEncounter(ctx, request)
+		if err != nil {
+			return pkgError.Wrap(err)
+		}
+
+		param := vital.UpsertVitalParam{
+			PatientID:       request.PatientID,
+			RecordedAt:      request.RecordedAt,
+			VitalType:       request.VitalType,
+			Value:           request.Value,
+			Version:         request.Version,
+			EncounterID:     encounterID,
+			RelinkEncounter: request.EncounterID != "",
+			Now:             time.Now().UTC(),
+		}
+
+		if policy == constant.ConflictPolicyStrict {
+			response, err = v.upsertStrict(ctx, param)
+		} else {
+			response, err = v.upsertWithPolicy(ctx, policy, param)
+		}
+		return err
+	}, domain.WithIsolation(sql.LevelReadCommitted)); err != nil {
+		return nil, pkgError.Wrap(err)
+	}
+
+	switch response.Result {
+	case vital.UpsertInserted.String():
+		metrics.VitalsUpserted.WithLabelValues(metrics.ResultInserted).Inc()
+	case vital.UpsertUpdated.String():
+		metrics.VitalsUpserted.WithLabelValues(metrics.ResultUpdated).Inc()
+	case vital.UpsertUnchanged.String():
+		metrics.VitalsUpserted.WithLabelValues(metrics.ResultUnchanged).Inc()
+	}
+	return response, nil
+}
+
+// upsertStrict
+// 조회 후 저장 사이의 경쟁을 없애기 위해 version 확인과 저장을 repository 에서 한 번에 수행합니다.
+func (v *vitalService) upsertStrict(ctx context.Context, param vital.UpsertVitalParam) (*vital.UpsertVitalResponse, error) {
+	result, err := v.repo.UpsertVital(ctx, param)
 	if err != nil {
-		return pkgError.Wrap(err)
+		metrics.RecordConflict(metrics.EntityVital, err)
+		if pkgError.CompareBusinessError(err, pkgError.NotFound) {
+			return nil, pkgError.WrapWithCode(err, pkgError.WrongParam, "version must be 1 for new record")
+		}
+		return nil, pkgError.Wrap(err)
+	}
+
+	return &vital.UpsertVitalResponse{
+		ConflictPolicy: constant.ConflictPolicyStrict.String(),
+		Result:         result.String(),
+		Value:          param.Value,
+		Attempts:       1,
+	}, nil
+}
+
+// upsertWithPolicy
+// 요청 version 대신 현재 데이터의 version 으로 저장하며, 그 사이 다른 저장이 끼어들어 충돌하면
+// 현재 데이터를 다시 조회해 정책을 적용합니다. (최대 maxConflictAttempts 회)
+// UpsertVital 의 트랜잭션 안에서 호출되므로 현재 데이터는 replica 가 아닌 primary 에서 읽습니다.
+func (v *vitalService) upsertWithPolicy(ctx context.Context, policy constant.ConflictPolicy, param vital.UpsertVitalParam) (*vital.UpsertVitalResponse, error) {
+	var err error
+	for attempt := 1; attempt <= maxConflictAttempts; attempt++ {
+		if attempt > 1 {
+			metrics.VitalConflictRetries.WithLabelValues(policy.String()).Inc()
+		}
+
+		current, findErr := v.repo.FindVitalByPatientIDAndRecordedAtAndVitalType(ctx, vital.FindVitalByPatientIDAndRecordedAtAndVitalTypeParam{
+			PatientID:  param.PatientID,
+			RecordedAt: param.RecordedAt,
+			VitalType:  param.VitalType,
+		})
+		if findErr != nil && !pkgError.CompareBusinessError(findErr, pkgError.NotFound) {
+			return nil, pkgError.Wrap(findErr)
+		}
+
+		if current == nil {
+			// 동시에 저장된 데이터를 덮어쓰지 않도록 신규 저장만 수행
+			param.Version, param.InsertOnly = 1, true
+		} else {
+			if !overwrites(policy, current.Value, param.Value) {
+				return &vital.UpsertVitalResponse{
+					ConflictPolicy: policy.String(),
+					Result:         vital.UpsertUnchanged.String(),
+					Value:          current.Value,
+					Attempts:       attempt,
+				}, nil
+			}
+			param.Version, param.InsertOnly = current.Version, false
+		}
+
+		var result vital.UpsertResult
+		result, err = v.repo.UpsertVital(ctx, param)
+		if err == nil {
+			return &vital.UpsertVitalResponse{
+				ConflictPolicy: policy.String(),
+				Result:         result.String(),
+				Value:          param.Value,
+				Attempts:       attempt,
+			}, nil
+		}
+
+		// 조회 이후 변경 / 삭제된 경우에만 재시도
+		if !pkgError.CompareBusinessError(err, pkgError.Conflict) && !pkgError.CompareBusinessError(err, pkgError.NotFound) {
+			return nil, pkgError.Wrap(err)
+		}
+	}
+
+	metrics.RecordConflict(metrics.EntityVital, err)
+	return nil, pkgError.WrapWithCode(err, pkgError.Conflict, fmt.Sprintf("conflict not resolved after %d attempts", maxConflictAttempts))
+}
+
+// overwrites 정책에 따라 기존 값(current)을 요청 값(incoming)으로 덮어쓸지 결정합니다.
+func overwrites(policy constant.ConflictPolicy, current, incoming float64) bool {
+	switch policy {
+	case constant.ConflictPolicyKeepFirst:
+		return false
+	case constant.ConflictPolicyMaxOf:
+		return incoming > current
+	case constant.ConflictPolicyMinOf:
+		return incoming < current
+	default:
+		return true
 	}
+}
+
+func (v *vitalService) GetVital(ctx context.Context, request vital.GetVitalRequest) (*vital.VitalResponse, error) {
+	ctx, span := tracing.Start(ctx, "VitalService.GetVital")
+	defer span.End()
 
-	// 기존 Vital 데이터 조회
-	existingVital, err := v.repo.FindVitalByPatientIDAndRecordedAtAndVitalType(ctx, vital.FindVitalByPatientIDAndRecordedAtAndVitalTypeParam{
+	model, err := v.repo.FindVitalByPatientIDAndRecordedAtAndVitalType(ctx, vital.FindVitalByPatientIDAndRecordedAtAndVitalTypeParam{
 		PatientID:  request.PatientID,
 		RecordedAt: request.RecordedAt,
 		VitalType:  request.VitalType,
 	})
+	if err != nil {
+		return nil, pkgError.Wrap(err)
+	}
+
+	return &vital.VitalResponse{
+		PatientID:   model.PatientID,
+		VitalType:   model.VitalType,
+		RecordedAt:  model.RecordedAt,
+		Value:       model.Value,
+		EncounterID: model.EncounterID,
+		Version:     model.Version,
+	}, nil
+}
 
-	now := time.Now().UTC()
+func (v *vitalService) ExportVitals(ctx context.Context, request vital.ExportVitalsRequest, fn func(*vital.Vital) error) error {
+	ctx, span := tracing.Start(ctx, "VitalService.ExportVitals")
+	defer span.End()
 
-	// 존재하지 않으면 INSERT
+	from, to, err := parseVitalTimeRange(request.From, request.To)
 	if err != nil {
-		if pkgError.CompareBusinessError(err, pkgError.NotFound) {
-			// INSERT: version은 1부터 시작
-			if request.Version != 1 {
-				return pkgError.WrapWithCode(pkgError.EmptyBusinessError(), pkgError.WrongParam, "version must be 1 for new record")
-			}
+		return pkgError.Wrap(err)
+	}
+	if err := validateExportRange(from, to); err != nil {
+		return err
+	}
 
-			if err := v.repo.CreateVital(ctx, &vital.Vital{
-				PatientID:  request.PatientID,
-				RecordedAt: request.RecordedAt,
-				VitalType:  request.VitalType,
-				Value:      request.Value,
-				Version:    1,
-				CreatedAt:  now,
-				UpdatedAt:  &now,
-			}); err != nil {
-				return pkgError.Wrap(err)
-			}
+	if err := v.repo.StreamVitalsByPatientIDsAndDateRange(ctx, vital.StreamVitalsByPatientIDsAndDateRangeParam{
+		PatientIDs: request.PatientIDs,
+		From:       from,
+		To:         to,
+		VitalTypes: request.VitalTypes,
+	}, fn); err != nil {
+		return pkgError.Wrap(err)
+	}
 
-			return nil
+	return nil
+}
+
+// linkEncounter
+// encounter_id 가 지정되면 recorded_at 이 encounter 기간에 포함되는지 검증하고,
+// 지정되지 않으면 recorded_at 을 포함하는 encounter 를 찾아 연결합니다. (없으면 nil)
+func (v *vitalService) linkEncounter(ctx context.Context, request vital.UpsertVitalRequest) (*string, error) {
+	if request.EncounterID != "" {
+		model, err := resolveEncounter(ctx, v.encounterRepo, request.PatientID, request.EncounterID)
+		if err != nil {
+			return nil, pkgError.Wrap(err)
 		}
 
-		// 다른 에러는 그대로 반환
-		return pkgError.Wrap(err)
+		if !model.Contains(request.RecordedAt) {
+			return nil, pkgError.WrapWithCode(pkgError.EmptyBusinessError(), pkgError.WrongParam, "recorded_at is outside of encounter")
+		}
+
+		return &model.ID, nil
 	}
 
-	// 존재하면 UPDATE (Optimistic Lock 적용)
-	if existingVital.Version != request.Version {
-		return pkgError.WrapWithCode(pkgError.EmptyBusinessError(), pkgError.Conflict, "version mismatch")
+	model, err := v.encounterRepo.FindEncounterByPatientIDAndTime(ctx, encounter.FindEncounterByPatientIDAndTimeParam{
+		PatientID: request.PatientID,
+		At:        request.RecordedAt,
+	})
+	if err != nil {
+		if pkgError.CompareBusinessError(err, pkgError.NotFound) {
+			return nil, nil
+		}
+		return nil, pkgError.Wrap(err)
 	}
 
-	existingVital.Value = request.Value
-	existingVital.Version = request.Version + 1
-	existingVital.UpdatedAt = &now
+	return &model.ID, nil
+}
 
-	if err := v.repo.UpdateVital(ctx, existingVital); err != nil {
-		return pkgError.Wrap(err)
+// parseVitalTimeRange
+// Query Parameter 의 from / to (RFC3339) 를 파싱합니다.
+func parseVitalTimeRange(rawFrom, rawTo string) (time.Time, time.Time, error) {
+	from, err := time.Parse(time.RFC3339, rawFrom)
+	if err != nil {
+		return time.Time{}, time.Time{}, pkgError.WrapWithCode(err, pkgError.WrongParam, "invalid from date format")
 	}
 
+	to, err := time.Parse(time.RFC3339, rawTo)
+	if err != nil {
+		return time.Time{}, time.Time{}, pkgError.WrapWithCode(err, pkgError.WrongParam, "invalid to date format")
+	}
+
+	return from, to, nil
+}
+
+// validateExportRange
+// export 는 전체 구간을 스트리밍하므로 역순 구간을 거부합니다. (기존 조회 API 는 빈 결과를 그대로 응답)
+func validateExportRange(from, to time.Time) error {
+	if from.After(to) {
+		return pkgError.WrapWithCode(pkgError.EmptyBusinessError(), pkgError.WrongParam, "from must be before to")
+	}
 	return nil
 }
 
-func NewVitalService(repo vital.VitalRepository, patientRepo patient.PatientRepository) vital.VitalService {
-	return &vitalService{repo, patientRepo}
+func (v *vitalService) PurgeDeletedVitals(ctx context.Context, before time.Time) (int64, error) {
+	ctx, span := tracing.Start(ctx, "VitalService.PurgeDeletedVitals")
+	defer span.End()
+
+	purged, err := v.repo.PurgeDeletedVitals(ctx, before)
+	if err != nil {
+		return 0, pkgError.Wrap(err)
+	}
+	return purged, nil
+}
+
+func NewVitalService(repo vital.VitalRepository, patientRepo patient.PatientRepository, encounterRepo encounter.EncounterRepository, transactor domain.Transactor) vital.VitalService {
+	return &vitalService{repo, patientRepo, encounterRepo, transactor}
 }
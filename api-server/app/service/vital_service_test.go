@@ -1,16 +1,23 @@
 package service
 
 import (
+	"aitrics-vital-signs/api-server/domain"
+	"aitrics-vital-signs/api-server/domain/encounter"
 	"aitrics-vital-signs/api-server/domain/mock"
 	"aitrics-vital-signs/api-server/domain/patient"
 	"aitrics-vital-signs/api-server/domain/vital"
+	"aitrics-vital-signs/api-server/internal/metrics"
+	"aitrics-vital-signs/api-server/pkg/constant"
 	pkgError "aitrics-vital-signs/library/error"
 	"context"
+	"database/sql"
 	"testing"
 	"time"
 
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
 )
 
 var (
@@ -23,20 +30,33 @@ func beforeEachVital(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	mockVitalRepository = mock.NewMockVitalRepository(ctrl)
 	mockPatientRepository = mock.NewMockPatientRepository(ctrl)
-	vitalSvc = NewVitalService(mockVitalRepository, mockPatientRepository)
+	mockEncounterRepository = mock.NewMockEncounterRepository(ctrl)
+	mockTransactor = mock.NewMockTransactor(ctrl)
+	mockTransactor.EXPECT().WithinTransaction(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, fn func(ctx context.Context) error, opts ...domain.TxOption) error {
+			return fn(ctx)
+		}).AnyTimes()
+	vitalSvc = NewVitalService(mockVitalRepository, mockPatientRepository, mockEncounterRepository, mockTransactor)
 }
 
-func Test_UpsertVital_Insert(t *testing.T) {
-	beforeEachVital(t)
+// expectNoEncounterAt recorded_at 을 포함하는 encounter 가 없는 경우
+func expectNoEncounterAt() {
+	mockEncounterRepository.EXPECT().
+		FindEncounterByPatientIDAndTime(gomock.Any(), gomock.Any()).
+		Return(nil, notFoundErr()).
+		AnyTimes()
+}
 
+func Test_UpsertVital_Insert(t *testing.T) {
 	recordedAt := time.Date(2025, 12, 1, 10, 15, 0, 0, time.UTC)
 
 	tests := []struct {
-		name        string
-		req         vital.UpsertVitalRequest
-		setupMock   func()
-		wantErr     bool
-		expectedErr error
+		name         string
+		req          vital.UpsertVitalRequest
+		setupMock    func()
+		wantErr      bool
+		expectedErr  error
+		wantInserted float64
 	}{
 		{
 			name: "성공 - INSERT (새 데이터)",
@@ -53,31 +73,23 @@ func Test_UpsertVital_Insert(t *testing.T) {
 					Return(&patient.Patient{
 						PatientID: "P00001234",
 					}, nil)
-				// FindVital → Record Not Found
-				mockVitalRepository.EXPECT().
-					FindVitalByPatientIDAndRecordedAtAndVitalType(
-						gomock.Any(), vital.FindVitalByPatientIDAndRecordedAtAndVitalTypeParam{
-							PatientID:  "P00001234",
-							RecordedAt: recordedAt,
-							VitalType:  "HR",
-						}).
-					Return(nil, pkgError.WrapWithCode(pkgError.EmptyBusinessError(), pkgError.NotFound))
 
-				// CreateVital 호출
+				// 조회 없이 version 확인과 저장을 한 번에 요청
 				mockVitalRepository.EXPECT().
-					CreateVital(gomock.Any(), gomock.Any()).
-					DoAndReturn(func(_ context.Context, v *vital.Vital) error {
-						require.Equal(t, "P00001234", v.PatientID)
-						require.Equal(t, recordedAt, v.RecordedAt)
-						require.Equal(t, "HR", v.VitalType)
-						require.Equal(t, 110.0, v.Value)
-						require.Equal(t, 1, v.Version)
-						require.NotNil(t, v.CreatedAt)
-						require.NotNil(t, v.UpdatedAt)
-						return nil
+					UpsertVital(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, param vital.UpsertVitalParam) (vital.UpsertResult, error) {
+						require.Equal(t, "P00001234", param.PatientID)
+						require.Equal(t, recordedAt, param.RecordedAt)
+						require.Equal(t, "HR", param.VitalType)
+						require.Equal(t, 110.0, param.Value)
+						require.Equal(t, 1, param.Version)
+						require.False(t, param.RelinkEncounter)
+						require.False(t, param.Now.IsZero())
+						return vital.UpsertInserted, nil
 					})
 			},
-			wantErr: false,
+			wantErr:      false,
+			wantInserted: 1,
 		},
 		{
 			name: "실패 - INSERT 시 version이 1이 아님",
@@ -96,14 +108,21 @@ func Test_UpsertVital_Insert(t *testing.T) {
 					}, nil)
 
 				mockVitalRepository.EXPECT().
-					FindVitalByPatientIDAndRecordedAtAndVitalType(
-						gomock.Any(), vital.FindVitalByPatientIDAndRecordedAtAndVitalTypeParam{
-							PatientID:  "P00001234",
-							RecordedAt: recordedAt,
-							VitalType:  "HR",
-						}).
-					Return(nil, pkgError.WrapWithCode(pkgError.EmptyBusinessError(), pkgError.NotFound))
+					UpsertVital(gomock.Any(), gomock.Any()).
+					Return(vital.UpsertResult(0), notFoundErr())
+			},
+			wantErr:     true,
+			expectedErr: pkgError.WrapWithCode(pkgError.EmptyBusinessError(), pkgError.WrongParam),
+		},
+		{
+			name: "실패 - strict 정책에서 version 없음",
+			req: vital.UpsertVitalRequest{
+				PatientID:  "P00001234",
+				RecordedAt: recordedAt,
+				VitalType:  "HR",
+				Value:      110.0,
 			},
+			setupMock:   func() {},
 			wantErr:     true,
 			expectedErr: pkgError.WrapWithCode(pkgError.EmptyBusinessError(), pkgError.WrongParam),
 		},
@@ -131,8 +150,14 @@ func Test_UpsertVital_Insert(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			beforeEachVital(t)
 			tt.setupMock()
+			expectNoEncounterAt()
+
+			inserted := metrics.VitalsUpserted.WithLabelValues(metrics.ResultInserted)
+			insertedBefore := testutil.ToFloat64(inserted)
+
+			_, err := vitalSvc.UpsertVital(ctx, tt.req)
 
-			err := vitalSvc.UpsertVital(ctx, tt.req)
+			require.Equal(t, tt.wantInserted, testutil.ToFloat64(inserted)-insertedBefore)
 
 			if tt.wantErr {
 				require.Error(t, err)
@@ -149,10 +174,7 @@ func Test_UpsertVital_Insert(t *testing.T) {
 }
 
 func Test_UpsertVital_Update(t *testing.T) {
-	beforeEachVital(t)
-
 	recordedAt := time.Date(2025, 12, 1, 10, 15, 0, 0, time.UTC)
-	now := time.Now().UTC()
 
 	tests := []struct {
 		name        string
@@ -160,6 +182,9 @@ func Test_UpsertVital_Update(t *testing.T) {
 		setupMock   func()
 		wantErr     bool
 		expectedErr error
+		// metric 증가량
+		wantUpdated   float64
+		wantConflicts float64
 	}{
 		{
 			name: "성공 - UPDATE (기존 데이터)",
@@ -168,19 +193,9 @@ func Test_UpsertVital_Update(t *testing.T) {
 				RecordedAt: recordedAt,
 				VitalType:  "HR",
 				Value:      115.0,
-				Version:    1,
+				Version:    2,
 			},
 			setupMock: func() {
-				existingVital := &vital.Vital{
-					PatientID:  "P00001234",
-					RecordedAt: recordedAt,
-					VitalType:  "HR",
-					Value:      110.0,
-					Version:    1,
-					CreatedAt:  now,
-					UpdatedAt:  &now,
-				}
-
 				mockPatientRepository.EXPECT().
 					FindPatientByID(gomock.Any(), "P00001234").
 					Return(&patient.Patient{
@@ -188,24 +203,15 @@ func Test_UpsertVital_Update(t *testing.T) {
 					}, nil)
 
 				mockVitalRepository.EXPECT().
-					FindVitalByPatientIDAndRecordedAtAndVitalType(
-						gomock.Any(), vital.FindVitalByPatientIDAndRecordedAtAndVitalTypeParam{
-							PatientID:  "P00001234",
-							RecordedAt: recordedAt,
-							VitalType:  "HR",
-						}).
-					Return(existingVital, nil)
-
-				mockVitalRepository.EXPECT().
-					UpdateVital(gomock.Any(), gomock.Any()).
-					DoAndReturn(func(_ context.Context, v *vital.Vital) error {
-						require.Equal(t, 115.0, v.Value)
-						require.Equal(t, 2, v.Version)
-						require.NotNil(t, v.UpdatedAt)
-						return nil
+					UpsertVital(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, param vital.UpsertVitalParam) (vital.UpsertResult, error) {
+						require.Equal(t, 115.0, param.Value)
+						require.Equal(t, 2, param.Version)
+						return vital.UpsertUpdated, nil
 					})
 			},
-			wantErr: false,
+			wantErr:     false,
+			wantUpdated: 1,
 		},
 		{
 			name: "실패 - Version Conflict (Optimistic Lock)",
@@ -217,36 +223,23 @@ func Test_UpsertVital_Update(t *testing.T) {
 				Version:    1,
 			},
 			setupMock: func() {
-				existingVital := &vital.Vital{
-					PatientID:  "P00001234",
-					RecordedAt: recordedAt,
-					VitalType:  "HR",
-					Value:      115.0,
-					Version:    2, // DB의 version은 이미 2
-					CreatedAt:  now,
-					UpdatedAt:  &now,
-				}
-
 				mockPatientRepository.EXPECT().
 					FindPatientByID(gomock.Any(), "P00001234").
 					Return(&patient.Patient{
 						PatientID: "P00001234",
 					}, nil)
 
+				// DB 의 version 은 이미 2
 				mockVitalRepository.EXPECT().
-					FindVitalByPatientIDAndRecordedAtAndVitalType(
-						gomock.Any(), vital.FindVitalByPatientIDAndRecordedAtAndVitalTypeParam{
-							PatientID:  "P00001234",
-							RecordedAt: recordedAt,
-							VitalType:  "HR",
-						}).
-					Return(existingVital, nil)
+					UpsertVital(gomock.Any(), gomock.Any()).
+					Return(vital.UpsertResult(0), pkgError.WrapWithCode(pkgError.EmptyBusinessError(), pkgError.Conflict, "version conflict in db upsert"))
 			},
-			wantErr:     true,
-			expectedErr: pkgError.WrapWithCode(pkgError.EmptyBusinessError(), pkgError.Conflict),
+			wantErr:       true,
+			expectedErr:   pkgError.WrapWithCode(pkgError.EmptyBusinessError(), pkgError.Conflict),
+			wantConflicts: 1,
 		},
 		{
-			name: "실패 - Repository에서 Version Conflict",
+			name: "실패 - DB 오류",
 			req: vital.UpsertVitalRequest{
 				PatientID:  "P00001234",
 				RecordedAt: recordedAt,
@@ -255,16 +248,6 @@ func Test_UpsertVital_Update(t *testing.T) {
 				Version:    1,
 			},
 			setupMock: func() {
-				existingVital := &vital.Vital{
-					PatientID:  "P00001234",
-					RecordedAt: recordedAt,
-					VitalType:  "HR",
-					Value:      110.0,
-					Version:    1,
-					CreatedAt:  now,
-					UpdatedAt:  &now,
-				}
-
 				mockPatientRepository.EXPECT().
 					FindPatientByID(gomock.Any(), "P00001234").
 					Return(&patient.Patient{
@@ -272,21 +255,11 @@ func Test_UpsertVital_Update(t *testing.T) {
 					}, nil)
 
 				mockVitalRepository.EXPECT().
-					FindVitalByPatientIDAndRecordedAtAndVitalType(
-						gomock.Any(), vital.FindVitalByPatientIDAndRecordedAtAndVitalTypeParam{
-							PatientID:  "P00001234",
-							RecordedAt: recordedAt,
-							VitalType:  "HR",
-						}).
-					Return(existingVital, nil)
-
-				// Repository에서 Conflict 반환 (DB level 동시성 제어)
-				mockVitalRepository.EXPECT().
-					UpdateVital(gomock.Any(), gomock.Any()).
-					Return(pkgError.WrapWithCode(pkgError.EmptyBusinessError(), pkgError.Conflict, "version conflict in db update"))
+					UpsertVital(gomock.Any(), gomock.Any()).
+					Return(vital.UpsertResult(0), pkgError.WrapWithCode(pkgError.EmptyBusinessError(), pkgError.Upsert))
 			},
 			wantErr:     true,
-			expectedErr: pkgError.WrapWithCode(pkgError.EmptyBusinessError(), pkgError.Conflict),
+			expectedErr: pkgError.WrapWithCode(pkgError.EmptyBusinessError(), pkgError.Upsert),
 		},
 	}
 
@@ -295,19 +268,443 @@ func Test_UpsertVital_Update(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			beforeEachVital(t)
 			tt.setupMock()
+			expectNoEncounterAt()
+
+			updated := metrics.VitalsUpserted.WithLabelValues(metrics.ResultUpdated)
+			conflicts := metrics.OptimisticLockConflicts.WithLabelValues(metrics.EntityVital)
+			updatedBefore, conflictsBefore := testutil.ToFloat64(updated), testutil.ToFloat64(conflicts)
 
-			err := vitalSvc.UpsertVital(ctx, tt.req)
+			_, err := vitalSvc.UpsertVital(ctx, tt.req)
+
+			require.Equal(t, tt.wantUpdated, testutil.ToFloat64(updated)-updatedBefore)
+			require.Equal(t, tt.wantConflicts, testutil.ToFloat64(conflicts)-conflictsBefore)
 
 			if tt.wantErr {
 				require.Error(t, err)
-				if tt.expectedErr != nil {
-					if pkgError.CompareBusinessError(tt.expectedErr, pkgError.Conflict) {
-						require.True(t, pkgError.CompareBusinessError(err, pkgError.Conflict))
-					}
+				expectedBE, _ := pkgError.CastBusinessError(tt.expectedErr)
+				require.True(t, pkgError.CompareBusinessError(err, pkgError.Code(expectedBE.Status.Code)))
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func Test_UpsertVital_ConflictPolicy(t *testing.T) {
+	recordedAt := time.Date(2025, 12, 1, 10, 15, 0, 0, time.UTC)
+	current := func(value float64, version int) *vital.Vital {
+		return &vital.Vital{PatientID: "P00001234", RecordedAt: recordedAt, VitalType: "HR", Value: value, Version: version}
+	}
+	conflictErr := func() error {
+		return pkgError.WrapWithCode(pkgError.EmptyBusinessError(), pkgError.Conflict, "version conflict in db update")
+	}
+
+	tests := []struct {
+		name   string
+		policy string
+		value  float64
+		// 시도별 조회 결과 (nil 이면 NotFound)
+		finds []*vital.Vital
+		// 시도별 저장 결과 (조회 결과로 저장하지 않는 경우 생략)
+		upserts      []error
+		wantParams   []vital.UpsertVitalParam
+		wantResponse *vital.UpsertVitalResponse
+		wantCode     pkgError.Code
+	}{
+		{
+			name:         "성공 - last_write_wins 는 현재 version 으로 덮어씀",
+			policy:       "last_write_wins",
+			value:        110,
+			finds:        []*vital.Vital{current(120, 3)},
+			upserts:      []error{nil},
+			wantParams:   []vital.UpsertVitalParam{{Version: 3}},
+			wantResponse: &vital.UpsertVitalResponse{ConflictPolicy: "last_write_wins", Result: "updated", Value: 110, Attempts: 1},
+		},
+		{
+			name:         "성공 - 데이터가 없으면 신규 저장만 수행",
+			policy:       "keep_first",
+			value:        110,
+			finds:        []*vital.Vital{nil},
+			upserts:      []error{nil},
+			wantParams:   []vital.UpsertVitalParam{{Version: 1, InsertOnly: true}},
+			wantResponse: &vital.UpsertVitalResponse{ConflictPolicy: "keep_first", Result: "inserted", Value: 110, Attempts: 1},
+		},
+		{
+			name:         "성공 - keep_first 는 기존 값 유지",
+			policy:       "keep_first",
+			value:        110,
+			finds:        []*vital.Vital{current(120, 2)},
+			wantResponse: &vital.UpsertVitalResponse{ConflictPolicy: "keep_first", Result: "unchanged", Value: 120, Attempts: 1},
+		},
+		{
+			name:         "성공 - max_of 는 더 큰 값이면 저장",
+			policy:       "max_of",
+			value:        130,
+			finds:        []*vital.Vital{current(120, 2)},
+			upserts:      []error{nil},
+			wantParams:   []vital.UpsertVitalParam{{Version: 2}},
+			wantResponse: &vital.UpsertVitalResponse{ConflictPolicy: "max_of", Result: "updated", Value: 130, Attempts: 1},
+		},
+		{
+			name:         "성공 - max_of 는 작거나 같은 값이면 유지",
+			policy:       "max_of",
+			value:        120,
+			finds:        []*vital.Vital{current(120, 2)},
+			wantResponse: &vital.UpsertVitalResponse{ConflictPolicy: "max_of", Result: "unchanged", Value: 120, Attempts: 1},
+		},
+		{
+			name:         "성공 - min_of 는 더 작은 값이면 저장",
+			policy:       "min_of",
+			value:        90,
+			finds:        []*vital.Vital{current(120, 2)},
+			upserts:      []error{nil},
+			wantParams:   []vital.UpsertVitalParam{{Version: 2}},
+			wantResponse: &vital.UpsertVitalResponse{ConflictPolicy: "min_of", Result: "updated", Value: 90, Attempts: 1},
+		},
+		{
+			name:         "성공 - 동시 신규 저장과 충돌하면 다시 조회해 정책 적용",
+			policy:       "max_of",
+			value:        130,
+			finds:        []*vital.Vital{nil, current(120, 1)},
+			upserts:      []error{conflictErr(), nil},
+			wantParams:   []vital.UpsertVitalParam{{Version: 1, InsertOnly: true}, {Version: 1}},
+			wantResponse: &vital.UpsertVitalResponse{ConflictPolicy: "max_of", Result: "updated", Value: 130, Attempts: 2},
+		},
+		{
+			name:         "성공 - 재조회 결과 정책상 유지",
+			policy:       "min_of",
+			value:        100,
+			finds:        []*vital.Vital{current(120, 2), current(90, 3)},
+			upserts:      []error{conflictErr()},
+			wantParams:   []vital.UpsertVitalParam{{Version: 2}},
+			wantResponse: &vital.UpsertVitalResponse{ConflictPolicy: "min_of", Result: "unchanged", Value: 90, Attempts: 2},
+		},
+		{
+			name:       "실패 - 재시도 횟수 초과",
+			policy:     "last_write_wins",
+			value:      110,
+			finds:      []*vital.Vital{current(120, 2), current(120, 3), current(120, 4)},
+			upserts:    []error{conflictErr(), conflictErr(), conflictErr()},
+			wantParams: []vital.UpsertVitalParam{{Version: 2}, {Version: 3}, {Version: 4}},
+			wantCode:   pkgError.Conflict,
+		},
+		{
+			name:       "실패 - 충돌이 아닌 DB 오류는 재시도하지 않음",
+			policy:     "last_write_wins",
+			value:      110,
+			finds:      []*vital.Vital{current(120, 2)},
+			upserts:    []error{pkgError.WrapWithCode(pkgError.EmptyBusinessError(), pkgError.Update)},
+			wantParams: []vital.UpsertVitalParam{{Version: 2}},
+			wantCode:   pkgError.Update,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			beforeEachVital(t)
+			expectNoEncounterAt()
+			mockPatientRepository.EXPECT().FindPatientByID(gomock.Any(), "P00001234").Return(&patient.Patient{PatientID: "P00001234"}, nil)
+
+			var finds []*gomock.Call
+			for _, model := range tt.finds {
+				call := mockVitalRepository.EXPECT().FindVitalByPatientIDAndRecordedAtAndVitalType(gomock.Any(), gomock.Any())
+				if model == nil {
+					call.Return(nil, notFoundErr())
+				} else {
+					call.Return(model, nil)
 				}
+				finds = append(finds, call)
+			}
+			var upserts []*gomock.Call
+			for i, upsertErr := range tt.upserts {
+				want, upsertErr := tt.wantParams[i], upsertErr
+				call := mockVitalRepository.EXPECT().UpsertVital(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, param vital.UpsertVitalParam) (vital.UpsertResult, error) {
+						require.Equal(t, want.Version, param.Version)
+						require.Equal(t, want.InsertOnly, param.InsertOnly)
+						require.Equal(t, tt.value, param.Value)
+						if upsertErr != nil {
+							return 0, upsertErr
+						}
+						if param.InsertOnly {
+							return vital.UpsertInserted, nil
+						}
+						return vital.UpsertUpdated, nil
+					})
+				upserts = append(upserts, call)
+			}
+			// 조회 → 저장 순서로 번갈아 호출
+			var order []any
+			for i := range finds {
+				order = append(order, finds[i])
+				if i < len(upserts) {
+					order = append(order, upserts[i])
+				}
+			}
+			gomock.InOrder(order...)
+
+			response, err := vitalSvc.UpsertVital(context.Background(), vital.UpsertVitalRequest{
+				PatientID:      "P00001234",
+				RecordedAt:     recordedAt,
+				VitalType:      "HR",
+				Value:          tt.value,
+				ConflictPolicy: tt.policy,
+			})
+			if tt.wantCode != 0 {
+				require.True(t, pkgError.CompareBusinessError(err, tt.wantCode), err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.wantResponse, response)
+		})
+	}
+}
+
+func Test_UpsertVital_Transaction(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockVitalRepository = mock.NewMockVitalRepository(ctrl)
+	mockPatientRepository = mock.NewMockPatientRepository(ctrl)
+	mockEncounterRepository = mock.NewMockEncounterRepository(ctrl)
+	mockTransactor = mock.NewMockTransactor(ctrl)
+	vitalSvc = NewVitalService(mockVitalRepository, mockPatientRepository, mockEncounterRepository, mockTransactor)
+
+	// 충돌 재시도가 최신 commit 을 읽을 수 있도록 READ COMMITTED 트랜잭션 하나로 수행
+	mockTransactor.EXPECT().WithinTransaction(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, fn func(ctx context.Context) error, opts ...domain.TxOption) error {
+			options := &sql.TxOptions{}
+			for _, opt := range opts {
+				opt(options)
+			}
+			require.Equal(t, sql.LevelReadCommitted, options.Isolation)
+			return fn(domain.ContextWithTx(ctx, &gorm.DB{}))
+		})
+
+	inTx := func(ctx context.Context) bool {
+		_, ok := domain.TxFromContext(ctx)
+		return ok
+	}
+	mockPatientRepository.EXPECT().FindPatientByID(gomock.Any(), "P00001234").
+		DoAndReturn(func(ctx context.Context, _ string) (*patient.Patient, error) {
+			require.True(t, inTx(ctx))
+			return &patient.Patient{PatientID: "P00001234"}, nil
+		})
+	mockEncounterRepository.EXPECT().FindEncounterByPatientIDAndTime(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, _ encounter.FindEncounterByPatientIDAndTimeParam) (*encounter.Encounter, error) {
+			require.True(t, inTx(ctx))
+			return nil, notFoundErr()
+		})
+	mockVitalRepository.EXPECT().FindVitalByPatientIDAndRecordedAtAndVitalType(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, _ vital.FindVitalByPatientIDAndRecordedAtAndVitalTypeParam) (*vital.Vital, error) {
+			require.True(t, inTx(ctx))
+			return nil, notFoundErr()
+		})
+	mockVitalRepository.EXPECT().UpsertVital(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, _ vital.UpsertVitalParam) (vital.UpsertResult, error) {
+			require.True(t, inTx(ctx))
+			return vital.UpsertInserted, nil
+		})
+
+	_, err := vitalSvc.UpsertVital(context.Background(), vital.UpsertVitalRequest{
+		PatientID:      "P00001234",
+		RecordedAt:     time.Date(2025, 12, 1, 10, 0, 0, 0, time.UTC),
+		VitalType:      "HR",
+		Value:          110.0,
+		ConflictPolicy: constant.ConflictPolicyMaxOf.String(),
+	})
+	require.NoError(t, err)
+}
+
+func Test_GetVital(t *testing.T) {
+	recordedAt := time.Date(2025, 12, 1, 10, 15, 0, 0, time.UTC)
+	req := vital.GetVitalRequest{PatientID: "P00001234", VitalType: "HR", RecordedAt: recordedAt}
+
+	tests := []struct {
+		name        string
+		setupMock   func()
+		wantVersion int
+		wantErr     bool
+		expectedErr error
+	}{
+		{
+			name: "성공",
+			setupMock: func() {
+				mockVitalRepository.EXPECT().
+					FindVitalByPatientIDAndRecordedAtAndVitalType(gomock.Any(), vital.FindVitalByPatientIDAndRecordedAtAndVitalTypeParam{
+						PatientID:  "P00001234",
+						RecordedAt: recordedAt,
+						VitalType:  "HR",
+					}).
+					Return(&vital.Vital{PatientID: "P00001234", VitalType: "HR", RecordedAt: recordedAt, Value: 110, Version: 3}, nil)
+			},
+			wantVersion: 3,
+		},
+		{
+			name: "실패 - 데이터 없음",
+			setupMock: func() {
+				mockVitalRepository.EXPECT().
+					FindVitalByPatientIDAndRecordedAtAndVitalType(gomock.Any(), gomock.Any()).
+					Return(nil, notFoundErr())
+			},
+			wantErr:     true,
+			expectedErr: notFoundErr(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			beforeEachVital(t)
+			tt.setupMock()
+
+			result, err := vitalSvc.GetVital(context.Background(), req)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				expectedBE, _ := pkgError.CastBusinessError(tt.expectedErr)
+				actualBE, _ := pkgError.CastBusinessError(err)
+				require.Equal(t, expectedBE.Status.Code, actualBE.Status.Code)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.wantVersion, result.Version)
+			}
+		})
+	}
+}
+
+func Test_ExportVitals(t *testing.T) {
+	tests := []struct {
+		name        string
+		req         vital.ExportVitalsRequest
+		setupMock   func()
+		wantErr     bool
+		expectedErr error
+	}{
+		{
+			name: "성공 - 다수 환자 export",
+			req: vital.ExportVitalsRequest{
+				PatientIDs: []string{"P00001234", "P00005678"},
+				From:       "2025-12-01T10:00:00Z",
+				To:         "2025-12-01T12:00:00Z",
+			},
+			setupMock: func() {
+				mockVitalRepository.EXPECT().
+					StreamVitalsByPatientIDsAndDateRange(gomock.Any(), vital.StreamVitalsByPatientIDsAndDateRangeParam{
+						PatientIDs: []string{"P00001234", "P00005678"},
+						From:       time.Date(2025, 12, 1, 10, 0, 0, 0, time.UTC),
+						To:         time.Date(2025, 12, 1, 12, 0, 0, 0, time.UTC),
+					}, gomock.Any()).
+					Return(nil)
+			},
+		},
+		{
+			name: "실패 - 잘못된 to 날짜 형식",
+			req: vital.ExportVitalsRequest{
+				PatientIDs: []string{"P00001234"},
+				From:       "2025-12-01T10:00:00Z",
+				To:         "2025-12-01",
+			},
+			setupMock:   func() {},
+			wantErr:     true,
+			expectedErr: pkgError.WrapWithCode(pkgError.EmptyBusinessError(), pkgError.WrongParam),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			beforeEachVital(t)
+			tt.setupMock()
+
+			err := vitalSvc.ExportVitals(context.Background(), tt.req, func(*vital.Vital) error { return nil })
+
+			if tt.wantErr {
+				require.Error(t, err)
+				expectedBE, _ := pkgError.CastBusinessError(tt.expectedErr)
+				actualBE, _ := pkgError.CastBusinessError(err)
+				require.Equal(t, expectedBE.Status.Code, actualBE.Status.Code)
 			} else {
 				require.NoError(t, err)
 			}
 		})
 	}
 }
+
+func Test_UpsertVital_LinkEncounter(t *testing.T) {
+	recordedAt := time.Date(2025, 12, 1, 10, 0, 0, 0, time.UTC)
+	encounterModel := &encounter.Encounter{ID: "enc-1", PatientID: "P00001234", AdmittedAt: recordedAt.Add(-time.Hour)}
+
+	tests := []struct {
+		name            string
+		encounterID     string
+		setupMock       func()
+		wantEncounterID *string
+		wantCode        pkgError.Code
+	}{
+		{
+			name: "성공 - recorded_at 으로 encounter 자동 연결",
+			setupMock: func() {
+				mockEncounterRepository.EXPECT().
+					FindEncounterByPatientIDAndTime(gomock.Any(), encounter.FindEncounterByPatientIDAndTimeParam{PatientID: "P00001234", At: recordedAt}).
+					Return(encounterModel, nil)
+			},
+			wantEncounterID: &encounterModel.ID,
+		},
+		{
+			name:        "성공 - encounter 명시",
+			encounterID: "enc-1",
+			setupMock: func() {
+				mockEncounterRepository.EXPECT().FindEncounterByID(gomock.Any(), "enc-1").Return(encounterModel, nil)
+			},
+			wantEncounterID: &encounterModel.ID,
+		},
+		{
+			name: "성공 - 해당 시각의 encounter 없음",
+			setupMock: func() {
+				mockEncounterRepository.EXPECT().
+					FindEncounterByPatientIDAndTime(gomock.Any(), gomock.Any()).
+					Return(nil, notFoundErr())
+			},
+		},
+		{
+			name:        "실패 - encounter 기간 밖의 recorded_at",
+			encounterID: "enc-1",
+			setupMock: func() {
+				dischargedAt := recordedAt.Add(-30 * time.Minute)
+				mockEncounterRepository.EXPECT().FindEncounterByID(gomock.Any(), "enc-1").
+					Return(&encounter.Encounter{ID: "enc-1", PatientID: "P00001234", AdmittedAt: recordedAt.Add(-time.Hour), DischargedAt: &dischargedAt}, nil)
+			},
+			wantCode: pkgError.WrongParam,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			beforeEachVital(t)
+			mockPatientRepository.EXPECT().FindPatientByID(gomock.Any(), "P00001234").Return(&patient.Patient{PatientID: "P00001234"}, nil)
+			tt.setupMock()
+
+			if tt.wantCode == 0 {
+				mockVitalRepository.EXPECT().
+					UpsertVital(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, param vital.UpsertVitalParam) (vital.UpsertResult, error) {
+						require.Equal(t, tt.wantEncounterID, param.EncounterID)
+						// 명시된 encounter 만 기존 연결을 덮어씀
+						require.Equal(t, tt.encounterID != "", param.RelinkEncounter)
+						return vital.UpsertInserted, nil
+					})
+			}
+
+			_, err := vitalSvc.UpsertVital(context.Background(), vital.UpsertVitalRequest{
+				PatientID:   "P00001234",
+				RecordedAt:  recordedAt,
+				VitalType:   "HR",
+				Value:       90,
+				Version:     1,
+				EncounterID: tt.encounterID,
+			})
+			if tt.wantCode != 0 {
+				require.True(t, pkgError.CompareBusinessError(err, tt.wantCode))
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,405 @@
+package service
+
+import (
+	"aitrics-vital-signs/api-server/domain"
+	"aitrics-vital-signs/api-server/domain/encounter"
+	"aitrics-vital-signs/api-server/domain/patient"
+	"aitrics-vital-signs/api-server/domain/vital"
+	"aitrics-vital-signs/api-server/domain/ward"
+	"aitrics-vital-signs/api-server/internal/tracing"
+	internalVital "aitrics-vital-signs/api-server/internal/vital"
+	"aitrics-vital-signs/api-server/pkg/constant"
+	"aitrics-vital-signs/library/envs"
+	pkgError "aitrics-vital-signs/library/error"
+	"context"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type wardService struct {
+	repo        ward.WardRepository
+	patientRepo patient.PatientRepository
+	vitalRepo   vital.VitalRepository
+	transactor  domain.Transactor
+}
+
+func (w *wardService) CreateWard(ctx context.Context, request ward.CreateWardRequest) (*ward.WardResponse, error) {
+	ctx, span := tracing.Start(ctx, "WardService.CreateWard")
+	defer span.End()
+
+	now := time.Now().UTC()
+	model := &ward.Ward{
+		ID:        uuid.NewString(),
+		Code:      request.Code,
+		Name:      request.Name,
+		CreatedAt: now,
+		UpdatedAt: &now,
+	}
+	if err := w.repo.CreateWard(ctx, model); err != nil {
+		return nil, pkgError.Wrap(err)
+	}
+
+	return &ward.WardResponse{ID: model.ID, Code: model.Code, Name: model.Name}, nil
+}
+
+func (w *wardService) ListWards(ctx context.Context) ([]ward.WardResponse, error) {
+	ctx, span := tracing.Start(ctx, "WardService.ListWards")
+	defer span.End()
+
+	wards, err := w.repo.FindWards(ctx)
+	if err != nil {
+		return nil, pkgError.Wrap(err)
+	}
+
+	results := make([]ward.WardResponse, 0, len(wards))
+	for _, item := range wards {
+		results = append(results, ward.WardResponse{ID: item.ID, Code: item.Code, Name: item.Name})
+	}
+
+	return results, nil
+}
+
+func (w *wardService) CreateBed(ctx context.Context, wardID string, request ward.CreateBedRequest) (*ward.BedResponse, error) {
+	ctx, span := tracing.Start(ctx, "WardService.CreateBed")
+	defer span.End()
+	// 직전에 등록한 병동도 확인할 수 있도록 primary 에서 조회
+	ctx = domain.ReadForWrite(ctx)
+
+	if _, err := w.repo.FindWardByID(ctx, wardID); err != nil {
+		return nil, pkgError.Wrap(err)
+	}
+
+	now := time.Now().UTC()
+	model := &ward.Bed{
+		ID:        uuid.NewString(),
+		WardID:    wardID,
+		Label:     request.Label,
+		CreatedAt: now,
+		UpdatedAt: &now,
+	}
+	if err := w.repo.CreateBed(ctx, model); err != nil {
+		return nil, pkgError.Wrap(err)
+	}
+
+	return &ward.BedResponse{ID: model.ID, WardID: model.WardID, Label: model.Label}, nil
+}
+
+func (w *wardService) GetWardPatients(ctx context.Context, wardID string) (*ward.GetWardPatientsResponse, error) {
+	ctx, span := tracing.Start(ctx, "WardService.GetWardPatients")
+	defer span.End()
+
+	if _, err := w.repo.FindWardByID(ctx, wardID); err != nil {
+		return nil, pkgError.Wrap(err)
+	}
+
+	assignments, err := w.repo.FindActiveBedAssignmentsByWardID(ctx, wardID)
+	if err != nil {
+		return nil, pkgError.Wrap(err)
+	}
+
+	items := make([]ward.WardPatientResponse, 0, len(assignments))
+	if len(assignments) == 0 {
+		return &ward.GetWardPatientsResponse{WardID: wardID, Items: items}, nil
+	}
+
+	beds, err := w.repo.FindBedsByWardID(ctx, wardID)
+	if err != nil {
+		return nil, pkgError.Wrap(err)
+	}
+	bedLabels := make(map[string]string, len(beds))
+	for _, bed := range beds {
+		bedLabels[bed.ID] = bed.Label
+	}
+
+	patientIDs := make([]string, 0, len(assignments))
+	encounterIDs := make([]string, 0, len(assignments))
+	for _, assignment := range assignments {
+		patientIDs = append(patientIDs, assignment.PatientID)
+		encounterIDs = append(encounterIDs, assignment.EncounterID)
+	}
+
+	patients, err := w.patientRepo.FindPatientsByPatientIDs(ctx, patientIDs)
+	if err != nil {
+		return nil, pkgError.Wrap(err)
+	}
+	patientNames := make(map[string]string, len(patients))
+	for _, p := range patients {
+		patientNames[p.PatientID] = p.Name
+	}
+
+	// inference 와 같이 진행 중인 encounter 의 VitalRiskTimeWindowHours 이내 vital 만 사용 (이전 입원 기록 제외)
+	latestVitals, err := w.vitalRepo.FindLatestVitalsByPatientIDs(ctx, vital.FindLatestVitalsByPatientIDsParam{
+		PatientIDs:   patientIDs,
+		EncounterIDs: encounterIDs,
+		From:         time.Now().UTC().Add(-time.Duration(envs.VitalRiskTimeWindowHours) * time.Hour),
+	})
+	if err != nil {
+		return nil, pkgError.Wrap(err)
+	}
+	vitalsByPatient := make(map[string]map[string]ward.LatestVitalResponse, len(patientIDs))
+	// rule 평가는 반올림 전 원본 값 기준 (표시용 반올림으로 threshold 판정이 inference 와 달라지지 않도록)
+	valuesByPatient := make(map[string]map[string]float64, len(patientIDs))
+	for _, v := range latestVitals {
+		if vitalsByPatient[v.PatientID] == nil {
+			vitalsByPatient[v.PatientID] = make(map[string]ward.LatestVitalResponse)
+			valuesByPatient[v.PatientID] = make(map[string]float64)
+		}
+		vitalsByPatient[v.PatientID][v.VitalType] = ward.LatestVitalResponse{
+			Value:      math.Round(v.Value*10) / 10,
+			RecordedAt: v.RecordedAt,
+		}
+		valuesByPatient[v.PatientID][v.VitalType] = v.Value
+	}
+
+	for _, assignment := range assignments {
+		latest := vitalsByPatient[assignment.PatientID]
+		if latest == nil {
+			latest = make(map[string]ward.LatestVitalResponse)
+		}
+
+		// 병합 / 삭제 등으로 환자를 찾지 못하면 해당 row 만 위험도를 UNKNOWN 으로 응답
+		riskLevel, triggeredRules := constant.RiskLevelUnknown, []string{}
+		if _, ok := patientNames[assignment.PatientID]; ok {
+			riskLevel, triggeredRules = internalVital.Evaluate(valuesByPatient[assignment.PatientID])
+		}
+
+		items = append(items, ward.WardPatientResponse{
+			BedID:          assignment.BedID,
+			BedLabel:       bedLabels[assignment.BedID],
+			PatientID:      assignment.PatientID,
+			Name:           patientNames[assignment.PatientID],
+			AssignedAt:     assignment.AssignedAt,
+			RiskLevel:      riskLevel.String(),
+			TriggeredRules: triggeredRules,
+			LatestVitals:   latest,
+		})
+	}
+
+	// 위험도 높은 순 → 충족한 rule 이 많은 순 → 병상 순
+	sort.SliceStable(items, func(i, j int) bool {
+		si, sj := constant.RiskLevel(items[i].RiskLevel).Severity(), constant.RiskLevel(items[j].RiskLevel).Severity()
+		if si != sj {
+			return si > sj
+		}
+		if len(items[i].TriggeredRules) != len(items[j].TriggeredRules) {
+			return len(items[i].TriggeredRules) > len(items[j].TriggeredRules)
+		}
+		return items[i].BedLabel < items[j].BedLabel
+	})
+
+	return &ward.GetWardPatientsResponse{WardID: wardID, Items: items}, nil
+}
+
+func (w *wardService) AdmitPatient(ctx context.Context, patientID string, request ward.AssignBedRequest) (*ward.BedAssignmentResponse, error) {
+	ctx, span := tracing.Start(ctx, "WardService.AdmitPatient")
+	defer span.End()
+	// 배정 / 병상 상태 확인은 primary 의 최신 데이터 기준
+	ctx = domain.ReadForWrite(ctx)
+
+	if _, err := w.patientRepo.FindPatientByID(ctx, patientID); err != nil {
+		return nil, pkgError.Wrap(err)
+	}
+
+	if _, err := w.repo.FindActiveBedAssignmentByPatientID(ctx, patientID); err == nil {
+		return nil, pkgError.WrapWithCode(pkgError.EmptyBusinessError(), pkgError.Conflict, "patient is already admitted")
+	} else if !pkgError.CompareBusinessError(err, pkgError.NotFound) {
+		return nil, pkgError.Wrap(err)
+	}
+
+	bed, err := w.findVacantBed(ctx, request.BedID)
+	if err != nil {
+		return nil, pkgError.Wrap(err)
+	}
+
+	encounterType := constant.EncounterTypeInpatient.String()
+	if request.EncounterType != "" {
+		encounterType = request.EncounterType
+	}
+
+	// 입원 시 새로운 encounter 시작
+	now := time.Now().UTC()
+	activePatientID, wardID := patientID, bed.WardID
+	newEncounter := &encounter.Encounter{
+		ID:              uuid.NewString(),
+		PatientID:       patientID,
+		Type:            encounterType,
+		WardID:          &wardID,
+		ActivePatientID: &activePatientID,
+		AdmittedAt:      now,
+		CreatedAt:       now,
+		UpdatedAt:       &now,
+	}
+
+	model := newBedAssignment(patientID, bed, newEncounter.ID, constant.BedAssignmentReasonAdmit, now)
+	if err := w.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		return w.repo.CreateBedAssignment(ctx, model, newEncounter)
+	}); err != nil {
+		return nil, pkgError.Wrap(err)
+	}
+
+	return newBedAssignmentResponse(model), nil
+}
+
+func (w *wardService) TransferPatient(ctx context.Context, patientID string, request ward.AssignBedRequest) (*ward.BedAssignmentResponse, error) {
+	ctx, span := tracing.Start(ctx, "WardService.TransferPatient")
+	defer span.End()
+	// 배정 / 병상 상태 확인은 primary 의 최신 데이터 기준
+	ctx = domain.ReadForWrite(ctx)
+
+	current, err := w.findAdmission(ctx, patientID)
+	if err != nil {
+		return nil, pkgError.Wrap(err)
+	}
+
+	if current.BedID == request.BedID {
+		return nil, pkgError.WrapWithCode(pkgError.EmptyBusinessError(), pkgError.WrongParam, "patient is already in the bed")
+	}
+
+	bed, err := w.findVacantBed(ctx, request.BedID)
+	if err != nil {
+		return nil, pkgError.Wrap(err)
+	}
+
+	now := time.Now().UTC()
+	releaseCurrent(current, constant.BedAssignmentReasonTransfer, now)
+	next := newBedAssignment(patientID, bed, current.EncounterID, constant.BedAssignmentReasonTransfer, now)
+
+	if err := w.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		return w.repo.TransferBedAssignment(ctx, current, next)
+	}); err != nil {
+		return nil, pkgError.Wrap(err)
+	}
+
+	return newBedAssignmentResponse(next), nil
+}
+
+func (w *wardService) DischargePatient(ctx context.Context, patientID string) (*ward.BedAssignmentResponse, error) {
+	ctx, span := tracing.Start(ctx, "WardService.DischargePatient")
+	defer span.End()
+	// 배정 / 병상 상태 확인은 primary 의 최신 데이터 기준
+	ctx = domain.ReadForWrite(ctx)
+
+	current, err := w.findAdmission(ctx, patientID)
+	if err != nil {
+		return nil, pkgError.Wrap(err)
+	}
+
+	now := time.Now().UTC()
+	releaseCurrent(current, constant.BedAssignmentReasonDischarge, now)
+
+	// 퇴원 시 encounter 종료
+	closedEncounter := &encounter.Encounter{ID: current.EncounterID, DischargedAt: &now, UpdatedAt: &now}
+	if err := w.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		return w.repo.ReleaseBedAssignment(ctx, current, closedEncounter)
+	}); err != nil {
+		return nil, pkgError.Wrap(err)
+	}
+
+	return newBedAssignmentResponse(current), nil
+}
+
+func (w *wardService) GetPatientBedAssignments(ctx context.Context, patientID string) ([]ward.BedAssignmentResponse, error) {
+	ctx, span := tracing.Start(ctx, "WardService.GetPatientBedAssignments")
+	defer span.End()
+
+	if _, err := w.patientRepo.FindPatientByID(ctx, patientID); err != nil {
+		return nil, pkgError.Wrap(err)
+	}
+
+	assignments, err := w.repo.FindBedAssignmentsByPatientID(ctx, patientID)
+	if err != nil {
+		return nil, pkgError.Wrap(err)
+	}
+
+	results := make([]ward.BedAssignmentResponse, 0, len(assignments))
+	for i := range assignments {
+		results = append(results, *newBedAssignmentResponse(&assignments[i]))
+	}
+
+	return results, nil
+}
+
+// findAdmission 입원 중인 환자의 현재 배정을 조회합니다.
+func (w *wardService) findAdmission(ctx context.Context, patientID string) (*ward.BedAssignment, error) {
+	current, err := w.repo.FindActiveBedAssignmentByPatientID(ctx, patientID)
+	if err != nil {
+		if pkgError.CompareBusinessError(err, pkgError.NotFound) {
+			return nil, pkgError.WrapWithCode(err, pkgError.Conflict, "patient is not admitted")
+		}
+		return nil, pkgError.Wrap(err)
+	}
+	return current, nil
+}
+
+// findVacantBed 병상이 존재하고 비어있는지 확인합니다.
+func (w *wardService) findVacantBed(ctx context.Context, bedID string) (*ward.Bed, error) {
+	bed, err := w.repo.FindBedByID(ctx, bedID)
+	if err != nil {
+		return nil, pkgError.Wrap(err)
+	}
+
+	if _, err := w.repo.FindActiveBedAssignmentByBedID(ctx, bedID); err == nil {
+		return nil, pkgError.WrapWithCode(pkgError.EmptyBusinessError(), pkgError.Conflict, "bed is occupied")
+	} else if !pkgError.CompareBusinessError(err, pkgError.NotFound) {
+		return nil, pkgError.Wrap(err)
+	}
+
+	return bed, nil
+}
+
+func newBedAssignment(patientID string, bed *ward.Bed, encounterID string, reason constant.BedAssignmentReason, now time.Time) *ward.BedAssignment {
+	bedID, activePatientID := bed.ID, patientID
+	return &ward.BedAssignment{
+		ID:              uuid.NewString(),
+		PatientID:       patientID,
+		WardID:          bed.WardID,
+		BedID:           bed.ID,
+		EncounterID:     encounterID,
+		ActiveBedID:     &bedID,
+		ActivePatientID: &activePatientID,
+		AssignReason:    reason.String(),
+		AssignedAt:      now,
+		CreatedAt:       now,
+		UpdatedAt:       &now,
+	}
+}
+
+func releaseCurrent(model *ward.BedAssignment, reason constant.BedAssignmentReason, now time.Time) {
+	releaseReason := reason.String()
+	model.ActiveBedID = nil
+	model.ActivePatientID = nil
+	model.ReleaseReason = &releaseReason
+	model.ReleasedAt = &now
+	model.UpdatedAt = &now
+}
+
+func newBedAssignmentResponse(model *ward.BedAssignment) *ward.BedAssignmentResponse {
+	return &ward.BedAssignmentResponse{
+		ID:            model.ID,
+		PatientID:     model.PatientID,
+		WardID:        model.WardID,
+		BedID:         model.BedID,
+		EncounterID:   model.EncounterID,
+		AssignReason:  model.AssignReason,
+		ReleaseReason: model.ReleaseReason,
+		AssignedAt:    model.AssignedAt,
+		ReleasedAt:    model.ReleasedAt,
+	}
+}
+
+func NewWardService(
+	repo ward.WardRepository,
+	patientRepo patient.PatientRepository,
+	vitalRepo vital.VitalRepository,
+	transactor domain.Transactor,
+) ward.WardService {
+	return &wardService{
+		repo:        repo,
+		patientRepo: patientRepo,
+		vitalRepo:   vitalRepo,
+		transactor:  transactor,
+	}
+}
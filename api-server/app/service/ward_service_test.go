@@ -0,0 +1,220 @@
+package service
+
+import (
+	"aitrics-vital-signs/api-server/domain"
+	"aitrics-vital-signs/api-server/domain/encounter"
+	"aitrics-vital-signs/api-server/domain/mock"
+	"aitrics-vital-signs/api-server/domain/patient"
+	"aitrics-vital-signs/api-server/domain/vital"
+	"aitrics-vital-signs/api-server/domain/ward"
+	"aitrics-vital-signs/api-server/internal/metrics"
+	"aitrics-vital-signs/api-server/pkg/constant"
+	"aitrics-vital-signs/library/envs"
+	pkgError "aitrics-vital-signs/library/error"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+var (
+	mockWardRepository *mock.MockWardRepository
+	wardSvc            ward.WardService
+)
+
+func beforeEachWard(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockWardRepository = mock.NewMockWardRepository(ctrl)
+	mockPatientRepository = mock.NewMockPatientRepository(ctrl)
+	mockVitalRepository = mock.NewMockVitalRepository(ctrl)
+	mockTransactor = mock.NewMockTransactor(ctrl)
+	mockTransactor.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, fn func(ctx context.Context) error, opts ...domain.TxOption) error {
+			return fn(ctx)
+		}).AnyTimes()
+	wardSvc = NewWardService(mockWardRepository, mockPatientRepository, mockVitalRepository, mockTransactor)
+}
+
+func notFoundErr() error {
+	return pkgError.WrapWithCode(pkgError.EmptyBusinessError(), pkgError.NotFound)
+}
+
+func Test_AdmitPatient(t *testing.T) {
+	bed := &ward.Bed{ID: "bed-1", WardID: "ward-1", Label: "101-A"}
+
+	tests := []struct {
+		name      string
+		setupMock func()
+		wantCode  pkgError.Code
+	}{
+		{
+			name: "성공 - 빈 병상에 입원",
+			setupMock: func() {
+				mockPatientRepository.EXPECT().FindPatientByID(gomock.Any(), "P00001").Return(&patient.Patient{PatientID: "P00001"}, nil)
+				mockWardRepository.EXPECT().FindActiveBedAssignmentByPatientID(gomock.Any(), "P00001").Return(nil, notFoundErr())
+				mockWardRepository.EXPECT().FindBedByID(gomock.Any(), "bed-1").Return(bed, nil)
+				mockWardRepository.EXPECT().FindActiveBedAssignmentByBedID(gomock.Any(), "bed-1").Return(nil, notFoundErr())
+				mockWardRepository.EXPECT().
+					CreateBedAssignment(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, model *ward.BedAssignment, newEncounter *encounter.Encounter) error {
+						// 입원 시 INPATIENT encounter 가 함께 생성되고 배정에 연결
+						require.Equal(t, newEncounter.ID, model.EncounterID)
+						require.Equal(t, constant.EncounterTypeInpatient.String(), newEncounter.Type)
+						require.Equal(t, "P00001", *newEncounter.ActivePatientID)
+						require.Equal(t, "ward-1", model.WardID)
+						require.Equal(t, "bed-1", *model.ActiveBedID)
+						require.Equal(t, "P00001", *model.ActivePatientID)
+						require.Equal(t, constant.BedAssignmentReasonAdmit.String(), model.AssignReason)
+						return nil
+					})
+			},
+		},
+		{
+			name: "실패 - 이미 입원 중인 환자",
+			setupMock: func() {
+				mockPatientRepository.EXPECT().FindPatientByID(gomock.Any(), "P00001").Return(&patient.Patient{PatientID: "P00001"}, nil)
+				mockWardRepository.EXPECT().FindActiveBedAssignmentByPatientID(gomock.Any(), "P00001").Return(&ward.BedAssignment{ID: "a-1"}, nil)
+			},
+			wantCode: pkgError.Conflict,
+		},
+		{
+			name: "실패 - 다른 환자가 사용 중인 병상",
+			setupMock: func() {
+				mockPatientRepository.EXPECT().FindPatientByID(gomock.Any(), "P00001").Return(&patient.Patient{PatientID: "P00001"}, nil)
+				mockWardRepository.EXPECT().FindActiveBedAssignmentByPatientID(gomock.Any(), "P00001").Return(nil, notFoundErr())
+				mockWardRepository.EXPECT().FindBedByID(gomock.Any(), "bed-1").Return(bed, nil)
+				mockWardRepository.EXPECT().FindActiveBedAssignmentByBedID(gomock.Any(), "bed-1").Return(&ward.BedAssignment{ID: "a-2"}, nil)
+			},
+			wantCode: pkgError.Conflict,
+		},
+		{
+			name: "실패 - 존재하지 않는 환자",
+			setupMock: func() {
+				mockPatientRepository.EXPECT().FindPatientByID(gomock.Any(), "P00001").Return(nil, notFoundErr())
+			},
+			wantCode: pkgError.NotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			beforeEachWard(t)
+			tt.setupMock()
+
+			result, err := wardSvc.AdmitPatient(context.Background(), "P00001", ward.AssignBedRequest{BedID: "bed-1"})
+			if tt.wantCode != 0 {
+				require.True(t, pkgError.CompareBusinessError(err, tt.wantCode))
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, "bed-1", result.BedID)
+			require.Nil(t, result.ReleasedAt)
+		})
+	}
+}
+
+func Test_TransferPatient(t *testing.T) {
+	beforeEachWard(t)
+
+	current := &ward.BedAssignment{ID: "a-1", PatientID: "P00001", WardID: "ward-1", BedID: "bed-1"}
+	mockWardRepository.EXPECT().FindActiveBedAssignmentByPatientID(gomock.Any(), "P00001").Return(current, nil)
+	mockWardRepository.EXPECT().FindBedByID(gomock.Any(), "bed-2").Return(&ward.Bed{ID: "bed-2", WardID: "ward-2"}, nil)
+	mockWardRepository.EXPECT().FindActiveBedAssignmentByBedID(gomock.Any(), "bed-2").Return(nil, notFoundErr())
+	mockWardRepository.EXPECT().
+		TransferBedAssignment(gomock.Any(), current, gomock.Any()).
+		DoAndReturn(func(_ context.Context, released *ward.BedAssignment, next *ward.BedAssignment) error {
+			// 기존 배정은 TRANSFER 사유로 해제되고 신규 배정은 같은 시각에 시작
+			require.Nil(t, released.ActiveBedID)
+			require.Equal(t, constant.BedAssignmentReasonTransfer.String(), *released.ReleaseReason)
+			require.Equal(t, *released.ReleasedAt, next.AssignedAt)
+			require.Equal(t, "ward-2", next.WardID)
+			return nil
+		})
+
+	result, err := wardSvc.TransferPatient(context.Background(), "P00001", ward.AssignBedRequest{BedID: "bed-2"})
+	require.NoError(t, err)
+	require.Equal(t, "bed-2", result.BedID)
+	require.Equal(t, constant.BedAssignmentReasonTransfer.String(), result.AssignReason)
+}
+
+func Test_DischargePatient_NotAdmitted(t *testing.T) {
+	beforeEachWard(t)
+
+	mockWardRepository.EXPECT().FindActiveBedAssignmentByPatientID(gomock.Any(), "P00001").Return(nil, notFoundErr())
+
+	_, err := wardSvc.DischargePatient(context.Background(), "P00001")
+	require.True(t, pkgError.CompareBusinessError(err, pkgError.Conflict))
+}
+
+func Test_GetWardPatients(t *testing.T) {
+	beforeEachWard(t)
+
+	now := time.Now().UTC()
+	mockWardRepository.EXPECT().FindWardByID(gomock.Any(), "ward-1").Return(&ward.Ward{ID: "ward-1"}, nil)
+	mockWardRepository.EXPECT().FindActiveBedAssignmentsByWardID(gomock.Any(), "ward-1").Return([]ward.BedAssignment{
+		{PatientID: "P00001", BedID: "bed-1", EncounterID: "enc-1"},
+		{PatientID: "P00002", BedID: "bed-2", EncounterID: "enc-2"},
+		{PatientID: "P00003", BedID: "bed-3", EncounterID: "enc-3"},
+		{PatientID: "P00004", BedID: "bed-4", EncounterID: "enc-4"},
+	}, nil)
+	mockWardRepository.EXPECT().FindBedsByWardID(gomock.Any(), "ward-1").Return([]ward.Bed{
+		{ID: "bed-1", Label: "101-A"},
+		{ID: "bed-2", Label: "101-B"},
+		{ID: "bed-3", Label: "102-A"},
+		{ID: "bed-4", Label: "102-B"},
+	}, nil)
+	// P00004 는 병합으로 조회되지 않는 환자
+	mockPatientRepository.EXPECT().FindPatientsByPatientIDs(gomock.Any(), []string{"P00001", "P00002", "P00003", "P00004"}).Return([]patient.Patient{
+		{PatientID: "P00001", Name: "홍길동"},
+		{PatientID: "P00002", Name: "김철수"},
+		{PatientID: "P00003", Name: "이영희"},
+	}, nil)
+	// 진행 중인 encounter 의 위험도 계산 기간 내 vital 만 조회
+	mockVitalRepository.EXPECT().FindLatestVitalsByPatientIDs(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, param vital.FindLatestVitalsByPatientIDsParam) ([]vital.Vital, error) {
+		require.Equal(t, []string{"P00001", "P00002", "P00003", "P00004"}, param.PatientIDs)
+		require.Equal(t, []string{"enc-1", "enc-2", "enc-3", "enc-4"}, param.EncounterIDs)
+		require.WithinDuration(t, now.Add(-time.Duration(envs.VitalRiskTimeWindowHours)*time.Hour), param.From, time.Minute)
+		return []vital.Vital{
+			{PatientID: "P00001", VitalType: "HR", Value: 80, RecordedAt: now},
+			{PatientID: "P00002", VitalType: "HR", Value: 131.04, RecordedAt: now},
+			{PatientID: "P00002", VitalType: "SBP", Value: 85, RecordedAt: now},
+			{PatientID: "P00002", VitalType: "SpO2", Value: 88, RecordedAt: now},
+			{PatientID: "P00003", VitalType: "SBP", Value: 82, RecordedAt: now},
+			// 표시 값은 90.0 으로 반올림되지만 rule 은 원본 값으로 판정
+			{PatientID: "P00003", VitalType: "SpO2", Value: 89.96, RecordedAt: now},
+			{PatientID: "P00004", VitalType: "HR", Value: 140, RecordedAt: now},
+		}, nil
+	})
+
+	// 병동 조회는 inference metric 을 기록하지 않음
+	highBefore := testutil.ToFloat64(metrics.InferenceRuns.WithLabelValues(constant.RiskLevelHigh.String()))
+	runsBefore := testutil.CollectAndCount(metrics.InferenceRuns)
+
+	result, err := wardSvc.GetWardPatients(context.Background(), "ward-1")
+	require.NoError(t, err)
+	require.Len(t, result.Items, 4)
+	require.Equal(t, highBefore, testutil.ToFloat64(metrics.InferenceRuns.WithLabelValues(constant.RiskLevelHigh.String())))
+	require.Equal(t, runsBefore, testutil.CollectAndCount(metrics.InferenceRuns))
+
+	// 위험도 높은 순 정렬 (위험도를 알 수 없는 환자는 마지막)
+	require.Equal(t, "P00002", result.Items[0].PatientID)
+	require.Equal(t, "101-B", result.Items[0].BedLabel)
+	require.Equal(t, "김철수", result.Items[0].Name)
+	require.Equal(t, 131.0, result.Items[0].LatestVitals["HR"].Value)
+	require.Equal(t, constant.RiskLevelHigh.String(), result.Items[0].RiskLevel)
+	require.Equal(t, []string{"HR > 120", "SBP < 90", "SpO2 < 90"}, result.Items[0].TriggeredRules)
+	require.Equal(t, "P00003", result.Items[1].PatientID)
+	require.Equal(t, constant.RiskLevelMedium.String(), result.Items[1].RiskLevel)
+	require.Equal(t, []string{"SBP < 90", "SpO2 < 90"}, result.Items[1].TriggeredRules)
+	require.Equal(t, 90.0, result.Items[1].LatestVitals["SpO2"].Value)
+	require.Equal(t, "P00001", result.Items[2].PatientID)
+	require.Equal(t, constant.RiskLevelLow.String(), result.Items[2].RiskLevel)
+	require.Empty(t, result.Items[2].TriggeredRules)
+	require.Equal(t, "P00004", result.Items[3].PatientID)
+	require.Equal(t, constant.RiskLevelUnknown.String(), result.Items[3].RiskLevel)
+	require.Equal(t, 140.0, result.Items[3].LatestVitals["HR"].Value)
+}
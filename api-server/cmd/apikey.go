@@ -0,0 +1,105 @@
+package main
+
+import (
+	"aitrics-vital-signs/api-server/domain/apikey"
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/gin-gonic/gin/binding"
+)
+
+// runAPIKey
+// apikey create --name --scopes [--expires-at] / apikey list / apikey revoke --id
+// 키 교체(rotate) 는 새 키 발급 후 기존 키 revoke 로 수행합니다.
+func runAPIKey(args []string) int {
+	if len(args) == 0 {
+		fmt.Fprintln(os.Stderr, "usage: apikey create|list|revoke [flags]")
+		return exitUsage
+	}
+
+	switch args[0] {
+	case "create":
+		return runAPIKeyCreate(args[1:])
+	case "list":
+		return runAPIKeyList(args[1:])
+	case "revoke":
+		return runAPIKeyRevoke(args[1:])
+	default:
+		fmt.Fprintf(os.Stderr, "unknown apikey command: %s\n", args[0])
+		return exitUsage
+	}
+}
+
+func runAPIKeyCreate(args []string) int {
+	flags := newFlagSet("apikey create")
+	name := flags.String("name", "", "키 이름 (필수)")
+	scopes := flags.String("scopes", "", "scope 목록 (comma separated, 필수)")
+	expiresAt := flags.String("expires-at", "", "만료 시간 (RFC3339)")
+	conflictPolicy := flags.String("conflict-policy", "", "vital 저장 기본 충돌 정책 (strict / last_write_wins / keep_first / max_of / min_of, 기본값 strict)")
+	if err := flags.Parse(args); err != nil {
+		return exitUsage
+	}
+
+	request := apikey.CreateAPIKeyRequest{
+		Name:           *name,
+		Scopes:         splitList(*scopes),
+		ConflictPolicy: *conflictPolicy,
+	}
+	if *expiresAt != "" {
+		t, err := time.Parse(time.RFC3339, *expiresAt)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err.Error())
+			return exitUsage
+		}
+		request.ExpiresAt = &t
+	}
+	if err := binding.Validator.ValidateStruct(request); err != nil {
+		fmt.Fprintln(os.Stderr, err.Error())
+		flags.Usage()
+		return exitUsage
+	}
+
+	deps := mustDependencies(context.Background())
+	response, err := deps.apiKeyService.CreateAPIKey(context.Background(), request)
+	if err != nil {
+		return failWith("apikey create", err)
+	}
+
+	return printJSON(response)
+}
+
+func runAPIKeyList(args []string) int {
+	flags := newFlagSet("apikey list")
+	if err := flags.Parse(args); err != nil {
+		return exitUsage
+	}
+
+	deps := mustDependencies(context.Background())
+	response, err := deps.apiKeyService.ListAPIKeys(context.Background())
+	if err != nil {
+		return failWith("apikey list", err)
+	}
+
+	return printJSON(response)
+}
+
+func runAPIKeyRevoke(args []string) int {
+	flags := newFlagSet("apikey revoke")
+	id := flags.String("id", "", "폐기할 키 ID (필수)")
+	if err := flags.Parse(args); err != nil {
+		return exitUsage
+	}
+	if *id == "" {
+		flags.Usage()
+		return exitUsage
+	}
+
+	deps := mustDependencies(context.Background())
+	if err := deps.apiKeyService.RevokeAPIKey(context.Background(), *id); err != nil {
+		return failWith("apikey revoke", err)
+	}
+
+	return printJSON(map[string]string{"id": *id, "status": "revoked"})
+}
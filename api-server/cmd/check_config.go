@@ -0,0 +1,64 @@
+package main
+
+import (
+	"aitrics-vital-signs/api-server/app/external"
+	"aitrics-vital-signs/library/envs"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+)
+
+type checkConfigResult struct {
+	Valid    bool         `json:"valid"`
+	Problems []string     `json:"problems"`
+	Config   *envs.Config `json:"config,omitempty"` // --print 지정 시 secret 마스킹된 적용 설정
+}
+
+// runCheckConfig 설정 파일 / 환경 변수 / --set 을 검증하고 발견된 문제를 한 번에 출력합니다. (--ping 지정 시 DB 연결까지 확인)
+func runCheckConfig(args []string) int {
+	flags := newFlagSet("check-config")
+	ping := flags.Bool("ping", false, "DB 연결 확인")
+	printConfig := flags.Bool("print", false, "적용된 설정 출력 (secret 마스킹)")
+	if err := flags.Parse(args); err != nil {
+		return exitUsage
+	}
+
+	result := checkConfigResult{Problems: []string{}}
+
+	config, err := envs.Load(configOptions)
+	var validationErr *envs.ValidationError
+	if errors.As(err, &validationErr) {
+		result.Problems = append(result.Problems, validationErr.Problems...)
+	} else if err != nil {
+		result.Problems = append(result.Problems, err.Error())
+	}
+
+	if *ping && len(result.Problems) == 0 {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := external.PingDB(ctx); err != nil {
+			result.Problems = append(result.Problems, "DB ping failed: "+err.Error())
+		}
+	}
+
+	if config != nil {
+		if config.Auth.Token == "" && config.Auth.JWKSSource == "" {
+			fmt.Fprintln(os.Stderr, "warning: neither auth.token nor auth.jwks_source is set, only API keys are accepted")
+		}
+		if *printConfig {
+			redacted := config.Redacted()
+			result.Config = &redacted
+		}
+	}
+
+	result.Valid = len(result.Problems) == 0
+	if code := printJSON(result); code != exitOK {
+		return code
+	}
+	if !result.Valid {
+		return exitInvalidConfig
+	}
+	return exitOK
+}
@@ -0,0 +1,129 @@
+package main
+
+import (
+	"aitrics-vital-signs/library/envs"
+	pkgError "aitrics-vital-signs/library/error"
+	pkgLogger "aitrics-vital-signs/library/logger"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// 명령 종료 코드 (스크립트에서 분기할 수 있도록 고정값으로 유지)
+const (
+	exitOK            = 0
+	exitError         = 1 // DB 등 내부 오류
+	exitUsage         = 2 // 잘못된 명령 / 인자
+	exitInvalidConfig = 3
+	exitNotFound      = 4
+	exitConflict      = 5
+	exitPartial       = 6 // 일부 항목만 처리됨 (import)
+)
+
+// configOptions main 에서 사용한 설정 로드 옵션 (check-config 에서 재사용)
+var configOptions envs.LoadOptions
+
+// overrideFlag --set key=value 를 반복해서 받습니다.
+type overrideFlag map[string]string
+
+func (o overrideFlag) String() string {
+	return fmt.Sprint(map[string]string(o))
+}
+
+func (o overrideFlag) Set(value string) error {
+	key, val, ok := strings.Cut(value, "=")
+	if !ok || strings.TrimSpace(key) == "" {
+		return fmt.Errorf("expected key=value: %q", value)
+	}
+	o[strings.TrimSpace(key)] = val
+	return nil
+}
+
+type command struct {
+	name    string
+	summary string
+	run     func(args []string) int
+}
+
+var commands = []command{
+	{name: "serve", summary: "HTTP API 서버 기동 (기본 명령)", run: runServe},
+	{name: "migrate", summary: "스키마 migration (up / down / to / status / force)", run: runMigrate},
+	{name: "seed", summary: "데모용 환자 생성 (--patients N)", run: runSeed},
+	{name: "generate", summary: "데모 / 부하 테스트용 환자 + vital 시계열 생성 (db / ndjson / hl7)", run: runGenerate},
+	{name: "import", summary: "vital NDJSON / CSV 가져오기", run: runImport},
+	{name: "export", summary: "vital CSV / NDJSON / Parquet 내보내기", run: runExport},
+	{name: "purge-deleted", summary: "soft delete 된 환자 / vital 영구 삭제", run: runPurgeDeleted},
+	{name: "apikey", summary: "API Key 발급 / 목록 / 폐기 (create / list / revoke)", run: runAPIKey},
+	{name: "healthcheck", summary: "실행 중인 서버의 readiness 확인 (--live: liveness)", run: runHealthcheck},
+	{name: "check-config", summary: "설정 검증 / 적용된 설정 출력 (--print)", run: runCheckConfig},
+}
+
+func findCommand(name string) (command, bool) {
+	for _, cmd := range commands {
+		if cmd.name == name {
+			return cmd, true
+		}
+	}
+	return command{}, false
+}
+
+func printUsage(w io.Writer) {
+	fmt.Fprintln(w, "usage: aitrics-vital-signs [--config file] [--set key=value ...] <command> [flags]")
+	fmt.Fprintln(w)
+	for _, cmd := range commands {
+		fmt.Fprintf(w, "  %-14s %s\n", cmd.name, cmd.summary)
+	}
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "exit codes: 0 ok, 1 error, 2 usage, 3 invalid config, 4 not found, 5 conflict, 6 partial")
+}
+
+func newFlagSet(name string) *flag.FlagSet {
+	flags := flag.NewFlagSet(name, flag.ContinueOnError)
+	flags.SetOutput(os.Stderr)
+	return flags
+}
+
+// exitCodeFromError business error code 를 종료 코드로 변환합니다.
+func exitCodeFromError(err error) int {
+	switch {
+	case err == nil:
+		return exitOK
+	case pkgError.CompareBusinessError(err, pkgError.WrongParam):
+		return exitUsage
+	case pkgError.CompareBusinessError(err, pkgError.NotFound):
+		return exitNotFound
+	case pkgError.CompareBusinessError(err, pkgError.Conflict):
+		return exitConflict
+	default:
+		return exitError
+	}
+}
+
+// printJSON 명령 결과는 stdout 에 JSON 한 줄로 출력합니다.
+func printJSON(v interface{}) int {
+	if err := json.NewEncoder(os.Stdout).Encode(v); err != nil {
+		fmt.Fprintln(os.Stderr, err.Error())
+		return exitError
+	}
+	return exitOK
+}
+
+// splitList comma separated flag 값을 분리합니다. (빈 값 제외)
+func splitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// failWith 에러를 stderr 로그로 남기고 종료 코드를 반환합니다.
+func failWith(command string, err error) int {
+	pkgLogger.ZapLogger.Logger.Error(command + " failed: " + err.Error())
+	return exitCodeFromError(err)
+}
@@ -0,0 +1,120 @@
+package main
+
+import (
+	"aitrics-vital-signs/api-server/domain/vital"
+	"aitrics-vital-signs/api-server/internal/output"
+	pkgError "aitrics-vital-signs/library/error"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_exitCodeFromError(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		expected int
+	}{
+		{name: "성공", err: nil, expected: exitOK},
+		{name: "잘못된 인자", err: pkgError.WrapWithCode(pkgError.EmptyBusinessError(), pkgError.WrongParam), expected: exitUsage},
+		{name: "데이터 없음", err: pkgError.WrapWithCode(pkgError.EmptyBusinessError(), pkgError.NotFound), expected: exitNotFound},
+		{name: "충돌", err: pkgError.Wrap(pkgError.WrapWithCode(pkgError.EmptyBusinessError(), pkgError.Conflict)), expected: exitConflict},
+		{name: "내부 오류", err: errors.New("db error"), expected: exitError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, exitCodeFromError(tt.err))
+		})
+	}
+}
+
+func Test_readVitalRows(t *testing.T) {
+	recordedAt := time.Date(2025, 12, 1, 10, 15, 0, 0, time.UTC)
+
+	tests := []struct {
+		name          string
+		format        output.Format
+		input         string
+		expectedLines []int
+		expected      []vital.UpsertVitalRequest
+		expectedFails []int
+		expectedError bool
+	}{
+		{
+			name:          "성공 - ndjson (빈 줄 무시)",
+			format:        output.FormatNDJSON,
+			input:         `{"patient_id":"P00001234","recorded_at":"2025-12-01T10:15:00Z","vital_type":"HR","value":110,"version":1}` + "\n\n" + `{"patient_id":"P00001234","recorded_at":"2025-12-01T10:15:00Z","vital_type":"RR","value":20,"version":2}` + "\n",
+			expectedLines: []int{1, 3},
+			expected: []vital.UpsertVitalRequest{
+				{PatientID: "P00001234", RecordedAt: recordedAt, VitalType: "HR", Value: 110, Version: 1},
+				{PatientID: "P00001234", RecordedAt: recordedAt, VitalType: "RR", Value: 20, Version: 2},
+			},
+		},
+		{
+			name:          "성공 - csv (version 생략 시 1)",
+			format:        output.FormatCSV,
+			input:         "patient_id,vital_type,recorded_at,value,encounter_id\nP00001234,SpO2,2025-12-01T10:15:00Z,97.5,E1\n",
+			expectedLines: []int{2},
+			expected: []vital.UpsertVitalRequest{
+				{PatientID: "P00001234", RecordedAt: recordedAt, VitalType: "SpO2", Value: 97.5, Version: 1, EncounterID: "E1"},
+			},
+		},
+		{
+			name:          "실패 - csv 필수 컬럼 누락",
+			format:        output.FormatCSV,
+			input:         "patient_id,vital_type,value\nP00001234,HR,110\n",
+			expectedError: true,
+		},
+		{
+			name:          "성공 - csv 형식 오류 행은 실패 행으로 전달하고 계속 처리",
+			format:        output.FormatCSV,
+			input:         "patient_id,vital_type,recorded_at,value\nP00001234,HR,2025-12-01T10:15:00Z,abc\nP00001234,HR,yesterday,110\nP0000\"1234,HR,2025-12-01T10:15:00Z,110\nP00001234,RR,2025-12-01T10:15:00Z,20\n",
+			expectedLines: []int{5},
+			expected: []vital.UpsertVitalRequest{
+				{PatientID: "P00001234", RecordedAt: recordedAt, VitalType: "RR", Value: 20, Version: 1},
+			},
+			expectedFails: []int{2, 3, 4},
+		},
+		{
+			name:          "성공 - ndjson 형식 오류 행은 실패 행으로 전달하고 계속 처리",
+			format:        output.FormatNDJSON,
+			input:         "{not json}\n" + `{"patient_id":"P00001234","recorded_at":"2025-12-01T10:15:00Z","vital_type":"HR","value":110,"version":1}` + "\n",
+			expectedLines: []int{2},
+			expected: []vital.UpsertVitalRequest{
+				{PatientID: "P00001234", RecordedAt: recordedAt, VitalType: "HR", Value: 110, Version: 1},
+			},
+			expectedFails: []int{1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var lines, fails []int
+			var requests []vital.UpsertVitalRequest
+			err := readVitalRows(strings.NewReader(tt.input), tt.format, func(line int, request vital.UpsertVitalRequest, parseErr error) error {
+				if parseErr != nil {
+					require.True(t, pkgError.CompareBusinessError(parseErr, pkgError.WrongParam))
+					fails = append(fails, line)
+					return nil
+				}
+				lines = append(lines, line)
+				requests = append(requests, request)
+				return nil
+			})
+
+			if tt.expectedError {
+				require.Error(t, err)
+				require.True(t, pkgError.CompareBusinessError(err, pkgError.WrongParam))
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedLines, lines)
+			require.Equal(t, tt.expected, requests)
+			require.Equal(t, tt.expectedFails, fails)
+		})
+	}
+}
@@ -0,0 +1,73 @@
+package main
+
+import (
+	"aitrics-vital-signs/api-server/app/external"
+	"aitrics-vital-signs/api-server/app/repository"
+	"aitrics-vital-signs/api-server/app/service"
+	"aitrics-vital-signs/api-server/domain"
+	"aitrics-vital-signs/api-server/domain/admin"
+	"aitrics-vital-signs/api-server/domain/apikey"
+	"aitrics-vital-signs/api-server/domain/audit"
+	"aitrics-vital-signs/api-server/domain/encounter"
+	"aitrics-vital-signs/api-server/domain/idempotency"
+	"aitrics-vital-signs/api-server/domain/inference"
+	"aitrics-vital-signs/api-server/domain/patient"
+	"aitrics-vital-signs/api-server/domain/vital"
+	"aitrics-vital-signs/api-server/domain/ward"
+	"aitrics-vital-signs/library/envs"
+	"context"
+	"time"
+)
+
+// dependencies serve 와 관리 명령이 같은 repository / service 구성을 사용하도록 한 곳에서 생성합니다.
+type dependencies struct {
+	dbClient   domain.ExternalDBClient
+	transactor domain.Transactor
+
+	patientRepository     patient.PatientRepository
+	vitalRepository       vital.VitalRepository
+	apiKeyRepository      apikey.APIKeyRepository
+	wardRepository        ward.WardRepository
+	encounterRepository   encounter.EncounterRepository
+	auditRepository       audit.AuditRepository
+	idempotencyRepository idempotency.IdempotencyRepository
+
+	patientService     patient.PatientService
+	vitalService       vital.VitalService
+	inferenceService   inference.InferenceService
+	apiKeyService      apikey.APIKeyService
+	wardService        ward.WardService
+	encounterService   encounter.EncounterService
+	auditService       audit.AuditService
+	adminService       admin.AdminService
+	idempotencyService idempotency.IdempotencyService
+}
+
+// mustDependencies ctx 는 replica health check 등 background 작업의 수명으로 사용합니다.
+func mustDependencies(ctx context.Context) *dependencies {
+	d := &dependencies{dbClient: external.MustExternalDB(ctx)}
+
+	// db.transaction_isolation 은 설정 검증에서 이미 확인된 값입니다.
+	isolation, _ := repository.ParseIsolationLevel(envs.DBTransactionIsolation)
+	d.transactor = repository.NewTransactor(d.dbClient, isolation)
+
+	d.patientRepository = repository.NewPatientRepository(d.dbClient)
+	d.vitalRepository = repository.NewVitalRepository(d.dbClient)
+	d.apiKeyRepository = repository.NewAPIKeyRepository(d.dbClient)
+	d.wardRepository = repository.NewWardRepository(d.dbClient)
+	d.encounterRepository = repository.NewEncounterRepository(d.dbClient)
+	d.auditRepository = repository.NewAuditRepository(d.dbClient)
+	d.idempotencyRepository = repository.NewIdempotencyRepository(d.dbClient)
+
+	d.patientService = service.NewPatientService(d.patientRepository, d.vitalRepository, d.encounterRepository, d.transactor)
+	d.vitalService = service.NewVitalService(d.vitalRepository, d.patientRepository, d.encounterRepository, d.transactor)
+	d.inferenceService = service.NewInferenceService(d.vitalRepository, d.patientRepository, d.encounterRepository)
+	d.apiKeyService = service.NewAPIKeyService(d.apiKeyRepository)
+	d.wardService = service.NewWardService(d.wardRepository, d.patientRepository, d.vitalRepository, d.transactor)
+	d.encounterService = service.NewEncounterService(d.encounterRepository, d.patientRepository)
+	d.auditService = service.NewAuditService(d.auditRepository)
+	d.adminService = service.NewAdminService(envs.Current)
+	d.idempotencyService = service.NewIdempotencyService(d.idempotencyRepository, time.Duration(envs.IdempotencyTTLHours)*time.Hour)
+
+	return d
+}
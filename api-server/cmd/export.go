@@ -0,0 +1,105 @@
+package main
+
+import (
+	"aitrics-vital-signs/api-server/app/controller"
+	"aitrics-vital-signs/api-server/domain/vital"
+	"aitrics-vital-signs/api-server/internal/output"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/gin-gonic/gin/binding"
+)
+
+type exportResult struct {
+	Rows   int    `json:"rows"`
+	Format string `json:"format"`
+	Out    string `json:"out"`
+}
+
+// runExport HTTP export(GET /api/v1/vitals/export) 와 같은 조건 / 컬럼으로 파일(기본 stdout)에 기록합니다.
+func runExport(args []string) int {
+	flags := newFlagSet("export")
+	patientIDs := flags.String("patients", "", "환자 ID 목록 (comma separated, 최대 100, 필수)")
+	from := flags.String("from", "", "조회 시작 시간 (RFC3339, 필수)")
+	to := flags.String("to", "", "조회 종료 시간 (RFC3339, 필수)")
+	vitalTypes := flags.String("vital-types", "", "Vital 타입 (comma separated)")
+	format := flags.String("format", "", "csv / ndjson / parquet (기본값: out 확장자, 없으면 ndjson)")
+	out := flags.String("out", "-", "출력 파일 경로 (- 는 stdout)")
+	if err := flags.Parse(args); err != nil {
+		return exitUsage
+	}
+
+	request := vital.ExportVitalsRequest{
+		PatientIDs: splitList(*patientIDs),
+		From:       *from,
+		To:         *to,
+		VitalTypes: splitList(*vitalTypes),
+	}
+	if err := binding.Validator.ValidateStruct(request); err != nil {
+		fmt.Fprintln(os.Stderr, err.Error())
+		flags.Usage()
+		return exitUsage
+	}
+
+	outputFormat, ok := resolveFileFormat(*format, *out, output.FormatNDJSON, output.FormatCSV, output.FormatNDJSON, output.FormatParquet)
+	if !ok {
+		fmt.Fprintf(os.Stderr, "unsupported format: %s\n", *format)
+		return exitUsage
+	}
+
+	var w io.Writer = os.Stdout
+	if *out != "-" {
+		file, err := os.Create(*out)
+		if err != nil {
+			return failWith("export", err)
+		}
+		defer file.Close()
+		w = file
+	}
+
+	rw, err := output.NewRecordWriter(w, outputFormat, controller.VitalExportColumns)
+	if err != nil {
+		return failWith("export", err)
+	}
+
+	deps := mustDependencies(context.Background())
+	result := exportResult{Format: outputFormat.String(), Out: *out}
+	if err := deps.vitalService.ExportVitals(context.Background(), request, func(model *vital.Vital) error {
+		result.Rows++
+		return rw.Write(controller.NewVitalExportRecord(model))
+	}); err != nil {
+		return failWith("export", err)
+	}
+
+	if err := rw.Close(); err != nil {
+		return failWith("export", err)
+	}
+
+	// stdout 으로 데이터를 내보낸 경우 결과 요약은 stderr 로 출력
+	if *out == "-" {
+		fmt.Fprintf(os.Stderr, "exported %d rows\n", result.Rows)
+		return exitOK
+	}
+	return printJSON(result)
+}
+
+// resolveFileFormat format 값이 없으면 파일 확장자로, 확장자도 없으면 fallback 으로 결정합니다.
+func resolveFileFormat(format, path string, fallback output.Format, offered ...output.Format) (output.Format, bool) {
+	if format == "" {
+		format = strings.TrimPrefix(filepath.Ext(path), ".")
+		if format == "" {
+			return fallback, true
+		}
+	}
+
+	for _, f := range offered {
+		if f.String() == format {
+			return f, true
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,163 @@
+package main
+
+import (
+	"aitrics-vital-signs/api-server/domain/vital"
+	"aitrics-vital-signs/api-server/internal/generator"
+	pkgError "aitrics-vital-signs/library/error"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"time"
+)
+
+type generateResult struct {
+	Patients      int `json:"patients"`
+	Skipped       int `json:"skipped"` // 이미 존재하는 환자 (sink=db)
+	Deteriorating int `json:"deteriorating"`
+	Vitals        int `json:"vitals"`
+}
+
+// runGenerate
+// 데모 / 부하 테스트용 환자와 vital 시계열을 생성합니다.
+// sink=db 는 repository 로 직접 저장하고, ndjson(import 명령 입력 형식) / hl7(ORU^R01) 은 파일(기본 stdout)로 출력합니다.
+func runGenerate(args []string) int {
+	flags := newFlagSet("generate")
+	patients := flags.Int("patients", 10, "생성할 환자 수")
+	prefix := flags.String("prefix", "G", "환자 ID prefix")
+	start := flags.Int("start", 1, "환자 ID 시작 번호")
+	seed := flags.Int64("seed", 1, "난수 seed (seed 와 from 이 같으면 같은 결과)")
+	from := flags.String("from", "", "첫 측정 시간 (RFC3339, 기본값: 현재 시각(정시) - duration)")
+	duration := flags.Duration("duration", 24*time.Hour, "생성 기간")
+	interval := flags.Duration("interval", 5*time.Minute, "측정 간격")
+	scenario := flags.String("scenario", "none", "악화 시나리오 (none / sepsis / hemorrhage / respiratory_failure)")
+	onset := flags.Duration("onset", 12*time.Hour, "from 기준 악화 시작 시점")
+	ramp := flags.Duration("ramp", 6*time.Hour, "최대 악화까지 걸리는 시간")
+	ratio := flags.Float64("ratio", 1, "시나리오를 적용할 환자 비율 (0 ~ 1)")
+	sink := flags.String("sink", "ndjson", "출력 대상 (db / ndjson / hl7)")
+	out := flags.String("out", "-", "출력 파일 경로 (- 는 stdout, sink=db 에서는 무시)")
+	if err := flags.Parse(args); err != nil {
+		return exitUsage
+	}
+
+	startAt := time.Now().UTC().Truncate(time.Hour).Add(-*duration)
+	if *from != "" {
+		t, err := time.Parse(time.RFC3339, *from)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err.Error())
+			return exitUsage
+		}
+		startAt = t.UTC()
+	}
+
+	parsedScenario, err := generator.ParseScenario(*scenario)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err.Error())
+		return exitUsage
+	}
+
+	g, err := generator.NewGenerator(generator.Config{
+		Seed:            *seed,
+		Patients:        *patients,
+		PatientIDPrefix: *prefix,
+		PatientIDStart:  *start,
+		Start:           startAt,
+		Duration:        *duration,
+		Interval:        *interval,
+		Scenario:        parsedScenario,
+		Onset:           *onset,
+		Ramp:            *ramp,
+		Ratio:           *ratio,
+	})
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err.Error())
+		return exitUsage
+	}
+
+	var result generateResult
+	switch *sink {
+	case "db":
+		err = generateToDB(g, &result)
+	case "ndjson", "hl7":
+		err = generateToFile(g, *sink, *out, &result)
+	default:
+		fmt.Fprintf(os.Stderr, "unsupported sink: %s\n", *sink)
+		return exitUsage
+	}
+	if err != nil {
+		return failWith("generate", err)
+	}
+
+	// 생성 데이터가 stdout 으로 나간 경우 결과 요약은 stderr 로 출력
+	if *sink != "db" && *out == "-" {
+		fmt.Fprintf(os.Stderr, "generated %d patients, %d vitals\n", result.Patients, result.Vitals)
+		return exitOK
+	}
+	return printJSON(result)
+}
+
+// generateToDB 이미 존재하는 환자는 vital 까지 모두 건너뛰므로 같은 설정으로 여러 번 실행해도 안전합니다.
+func generateToDB(g *generator.Generator, result *generateResult) error {
+	ctx := context.Background()
+	deps := mustDependencies(ctx)
+
+	return g.Generate(func(series *generator.Series) error {
+		_, err := deps.patientRepository.FindPatientByID(ctx, series.Patient.PatientID)
+		if err == nil {
+			result.Skipped++
+			return nil
+		}
+		if !pkgError.CompareBusinessError(err, pkgError.NotFound) {
+			return pkgError.Wrap(err)
+		}
+
+		if err := deps.patientRepository.CreatePatient(ctx, &series.Patient); err != nil {
+			return pkgError.Wrap(err)
+		}
+
+		models := make([]*vital.Vital, len(series.Vitals))
+		for i := range series.Vitals {
+			models[i] = &series.Vitals[i]
+		}
+		if err := deps.vitalRepository.CreateVitals(ctx, models); err != nil {
+			return pkgError.Wrap(err)
+		}
+
+		countSeries(result, series)
+		return nil
+	})
+}
+
+func generateToFile(g *generator.Generator, sink, out string, result *generateResult) error {
+	var w io.Writer = os.Stdout
+	if out != "-" {
+		file, err := os.Create(out)
+		if err != nil {
+			return pkgError.Wrap(err)
+		}
+		defer file.Close()
+		w = file
+	}
+
+	writer := generator.NewNDJSONWriter(w)
+	if sink == "hl7" {
+		writer = generator.NewHL7Writer(w)
+	}
+
+	if err := g.Generate(func(series *generator.Series) error {
+		countSeries(result, series)
+		return writer.Write(series)
+	}); err != nil {
+		return pkgError.Wrap(err)
+	}
+
+	return writer.Flush()
+}
+
+func countSeries(result *generateResult, series *generator.Series) {
+	result.Patients++
+	result.Vitals += len(series.Vitals)
+	if series.OnsetAt != nil {
+		result.Deteriorating++
+	}
+}
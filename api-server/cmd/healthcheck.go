@@ -0,0 +1,40 @@
+package main
+
+import (
+	"aitrics-vital-signs/library/envs"
+	"fmt"
+	"net/http"
+	"os"
+	"time"
+)
+
+// runHealthcheck
+// 실행 중인 서버의 /readyz (--live 지정 시 /healthz) 를 호출해 종료 코드로 결과를 반환합니다.
+// scratch 이미지에는 curl 이 없으므로 docker-compose healthcheck 에서 사용합니다.
+func runHealthcheck(args []string) int {
+	flags := newFlagSet("healthcheck")
+	live := flags.Bool("live", false, "liveness(/healthz) 확인")
+	timeout := flags.Duration("timeout", 3*time.Second, "요청 timeout")
+	if err := flags.Parse(args); err != nil {
+		return exitUsage
+	}
+
+	path := "/readyz"
+	if *live {
+		path = "/healthz"
+	}
+
+	client := &http.Client{Timeout: *timeout}
+	resp, err := client.Get(fmt.Sprintf("http://127.0.0.1:%s%s", envs.ServerPort, path))
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err.Error())
+		return exitError
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		fmt.Fprintf(os.Stderr, "%s returned %d\n", path, resp.StatusCode)
+		return exitError
+	}
+	return exitOK
+}
@@ -0,0 +1,231 @@
+package main
+
+import (
+	"aitrics-vital-signs/api-server/domain/vital"
+	"aitrics-vital-signs/api-server/internal/output"
+	pkgError "aitrics-vital-signs/library/error"
+	pkgLogger "aitrics-vital-signs/library/logger"
+	"bufio"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin/binding"
+	"go.uber.org/zap"
+)
+
+type importResult struct {
+	Imported int `json:"imported"`
+	Failed   int `json:"failed"`
+}
+
+// runImport
+// NDJSON / CSV 파일의 각 행을 POST /api/v1/vitals 와 같은 검증 / upsert 로직으로 저장합니다.
+// 실패한 행은 줄 번호와 함께 stderr 로 기록하고 다음 행을 계속 처리합니다.
+func runImport(args []string) int {
+	flags := newFlagSet("import")
+	file := flags.String("file", "", "가져올 파일 경로 (- 는 stdin, 필수)")
+	format := flags.String("format", "", "ndjson / csv (기본값: 파일 확장자, 없으면 ndjson)")
+	conflictPolicy := flags.String("conflict-policy", "", "행에 conflict_policy 가 없을 때 사용할 정책 (strict / last_write_wins / keep_first / max_of / min_of)")
+	if err := flags.Parse(args); err != nil {
+		return exitUsage
+	}
+	if *file == "" {
+		flags.Usage()
+		return exitUsage
+	}
+
+	inputFormat, ok := resolveFileFormat(*format, *file, output.FormatNDJSON, output.FormatCSV, output.FormatNDJSON)
+	if !ok {
+		fmt.Fprintf(os.Stderr, "unsupported format: %s\n", *format)
+		return exitUsage
+	}
+
+	var r io.Reader = os.Stdin
+	if *file != "-" {
+		f, err := os.Open(*file)
+		if err != nil {
+			return failWith("import", err)
+		}
+		defer f.Close()
+		r = f
+	}
+
+	ctx := context.Background()
+	deps := mustDependencies(ctx)
+
+	var result importResult
+	if err := readVitalRows(r, inputFormat, func(line int, request vital.UpsertVitalRequest, parseErr error) error {
+		if parseErr != nil {
+			result.Failed++
+			pkgLogger.ZapLogger.Logger.Warn("import row failed", zap.Int("line", line), zap.Error(parseErr))
+			return nil
+		}
+
+		if request.ConflictPolicy == "" {
+			request.ConflictPolicy = *conflictPolicy
+		}
+		if err := binding.Validator.ValidateStruct(request); err != nil {
+			err = pkgError.WrapWithCode(err, pkgError.WrongParam)
+			result.Failed++
+			pkgLogger.ZapLogger.Logger.Warn("import row failed", zap.Int("line", line), zap.Error(err))
+			return nil
+		}
+
+		if _, err := deps.vitalService.UpsertVital(ctx, request); err != nil {
+			result.Failed++
+			pkgLogger.ZapLogger.Logger.Warn("import row failed", zap.Int("line", line), zap.Error(err))
+			return nil
+		}
+
+		result.Imported++
+		return nil
+	}); err != nil {
+		return failWith("import", err)
+	}
+
+	if code := printJSON(result); code != exitOK {
+		return code
+	}
+	if result.Failed > 0 {
+		return exitPartial
+	}
+	return exitOK
+}
+
+// readVitalRows
+// 파일의 각 행을 UpsertVitalRequest 로 변환해 fn 에 전달합니다. (line 은 1부터 시작하는 파일 기준 줄 번호)
+// 변환할 수 없는 행은 parseErr(WrongParam) 와 함께 전달하고 다음 행을 계속 읽으며, 읽기 실패 / CSV header 오류에서만 중단합니다.
+func readVitalRows(r io.Reader, format output.Format, fn func(line int, request vital.UpsertVitalRequest, parseErr error) error) error {
+	switch format {
+	case output.FormatNDJSON:
+		return readVitalNDJSON(r, fn)
+	case output.FormatCSV:
+		return readVitalCSV(r, fn)
+	default:
+		return pkgError.WrapWithCode(pkgError.EmptyBusinessError(), pkgError.WrongParam, "unsupported format: "+format.String())
+	}
+}
+
+func readVitalNDJSON(r io.Reader, fn func(line int, request vital.UpsertVitalRequest, parseErr error) error) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+
+	line := 0
+	for scanner.Scan() {
+		line++
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" {
+			continue
+		}
+
+		var request vital.UpsertVitalRequest
+		var parseErr error
+		if err := json.Unmarshal([]byte(text), &request); err != nil {
+			parseErr = pkgError.WrapWithCode(err, pkgError.WrongParam, fmt.Sprintf("line %d", line))
+		}
+		if err := fn(line, request, parseErr); err != nil {
+			return pkgError.Wrap(err)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return pkgError.Wrap(err)
+	}
+
+	return nil
+}
+
+func readVitalCSV(r io.Reader, fn func(line int, request vital.UpsertVitalRequest, parseErr error) error) error {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil
+	}
+	if err != nil {
+		return pkgError.WrapWithCode(err, pkgError.WrongParam, "line 1")
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[strings.TrimSpace(name)] = i
+	}
+	for _, required := range []string{"patient_id", "vital_type", "recorded_at", "value"} {
+		if _, ok := columns[required]; !ok {
+			return pkgError.WrapWithCode(pkgError.EmptyBusinessError(), pkgError.WrongParam, "missing column: "+required)
+		}
+	}
+
+	field := func(record []string, name string) string {
+		i, ok := columns[name]
+		if !ok || i >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[i])
+	}
+
+	line := 1
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			return nil
+		}
+		line++
+
+		// 따옴표 오류 등 행 단위 parse 오류는 실패 행으로 처리하고, 그 외 읽기 오류는 중단
+		var request vital.UpsertVitalRequest
+		var parseErr error
+		var csvErr *csv.ParseError
+		switch {
+		case errors.As(err, &csvErr):
+			parseErr = pkgError.WrapWithCode(err, pkgError.WrongParam, fmt.Sprintf("line %d", line))
+		case err != nil:
+			return pkgError.Wrap(err)
+		default:
+			if request, err = parseVitalCSVRecord(func(name string) string { return field(record, name) }); err != nil {
+				parseErr = pkgError.WrapWithCode(err, pkgError.WrongParam, fmt.Sprintf("line %d", line))
+			}
+		}
+		if err := fn(line, request, parseErr); err != nil {
+			return pkgError.Wrap(err)
+		}
+	}
+}
+
+// parseVitalCSVRecord version 컬럼이 없거나 비어 있으면 1 로 간주합니다.
+func parseVitalCSVRecord(field func(name string) string) (vital.UpsertVitalRequest, error) {
+	recordedAt, err := time.Parse(time.RFC3339Nano, field("recorded_at"))
+	if err != nil {
+		return vital.UpsertVitalRequest{}, err
+	}
+
+	value, err := strconv.ParseFloat(field("value"), 64)
+	if err != nil {
+		return vital.UpsertVitalRequest{}, err
+	}
+
+	version := 1
+	if raw := field("version"); raw != "" {
+		if version, err = strconv.Atoi(raw); err != nil {
+			return vital.UpsertVitalRequest{}, err
+		}
+	}
+
+	return vital.UpsertVitalRequest{
+		PatientID:      field("patient_id"),
+		RecordedAt:     recordedAt,
+		VitalType:      field("vital_type"),
+		Value:          value,
+		Version:        version,
+		EncounterID:    field("encounter_id"),
+		ConflictPolicy: field("conflict_policy"),
+	}, nil
+}
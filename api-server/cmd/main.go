@@ -1,30 +1,12 @@
 package main
 
 import (
-	"aitrics-vital-signs/api-server/app/controller"
-	"aitrics-vital-signs/api-server/app/external"
-	"aitrics-vital-signs/api-server/app/repository"
-	"aitrics-vital-signs/api-server/app/router"
-	"aitrics-vital-signs/api-server/app/service"
-	"aitrics-vital-signs/api-server/internal/middleware"
 	"aitrics-vital-signs/library/envs"
 	pkgLogger "aitrics-vital-signs/library/logger"
-	"context"
-	"errors"
+	"flag"
 	"fmt"
 	"log"
-	"net/http"
 	"os"
-	"os/signal"
-	"syscall"
-	"time"
-
-	"github.com/gin-contrib/cors"
-	"github.com/gin-gonic/gin"
-	"golang.org/x/sync/errgroup"
-
-	swaggerFiles "github.com/swaggo/files"
-	ginSwagger "github.com/swaggo/gin-swagger"
 
 	_ "aitrics-vital-signs/api-server/docs"
 )
@@ -39,81 +21,56 @@ import (
 // @in header
 // @name Authorization
 func main() {
-	pkgLogger.MustInitZapLogger()
-	if pkgLogger.ZapLogger == nil {
-		log.Fatal("logger is nil")
+	// 전역 flag 는 명령 이름 앞에 지정합니다. (예: --config /etc/aitrics.yaml --set server.port=9090 serve)
+	globalFlags := flag.NewFlagSet("aitrics-vital-signs", flag.ContinueOnError)
+	globalFlags.SetOutput(os.Stderr)
+	globalFlags.Usage = func() { printUsage(os.Stderr) }
+	configFile := globalFlags.String("config", "", "설정 파일 경로 (YAML / TOML, 기본값: CONFIG_FILE 환경 변수)")
+	overrides := overrideFlag{}
+	globalFlags.Var(overrides, "set", "설정 덮어쓰기 key=value (반복 가능, 예: --set db.host=mysql)")
+	if err := globalFlags.Parse(os.Args[1:]); err != nil {
+		if err == flag.ErrHelp {
+			os.Exit(exitOK)
+		}
+		os.Exit(exitUsage)
 	}
 
-	bCtx, cancelFunc := context.WithCancel(context.Background())
-	defer cancelFunc()
-	group, _ := errgroup.WithContext(bCtx)
-
-	engine := gin.New()
-	engine.Use(gin.Recovery(), middleware.GinBusinessErrLogger())
-
-	conf := &cors.Config{
-		AllowMethods:     []string{"GET", "POST", "PUT", "PATCH", "DELETE", "HEAD", "OPTIONS", "UPDATE"},
-		AllowHeaders:     []string{"X-Request-Id", "X-Forwarded-Proto", "X-Forwarded-Host", "Origin", "Content-Length", "Access-Control-Allow-Origin", "Content-Type", "Accept-Encoding", "origin", "accept", "X-Requested-With", " X-CSRF-Token", "Cache-Control", "Baggage"},
-		AllowCredentials: false,
-		ExposeHeaders:    []string{"Content-Length", "Access-Control-Allow-Headers", "Cache-Control", "Content-Language", "Content-Type"},
-		MaxAge:           12 * time.Hour,
-		AllowOrigins:     []string{"*"},
+	name, args := "serve", globalFlags.Args()
+	if len(args) > 0 {
+		name, args = args[0], args[1:]
 	}
-	engine.Use(cors.New(*conf))
-	engine.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
-
-	dbClient := external.MustExternalDB()
-
-	patientRepository := repository.NewPatientRepository(dbClient)
-	vitalRepository := repository.NewVitalRepository(dbClient)
-
-	patientService := service.NewPatientService(patientRepository, vitalRepository)
-	vitalService := service.NewVitalService(vitalRepository, patientRepository)
-	inferenceService := service.NewInferenceService(vitalRepository, patientRepository)
-
-	patientController := controller.NewPatientController(patientService)
-	vitalController := controller.NewVitalController(vitalService)
-	inferenceController := controller.NewInferenceController(inferenceService)
 
-	router.NewPatientRouter(engine, patientController)
-	router.NewVitalRouter(engine, vitalController)
-	router.NewInferenceRouter(engine, inferenceController)
-
-	s := &http.Server{
-		Addr:    fmt.Sprintf(":%s", envs.ServerPort),
-		Handler: engine,
+	if name == "help" || name == "-h" || name == "--help" {
+		printUsage(os.Stdout)
+		os.Exit(exitOK)
 	}
 
-	group.Go(func() error {
-		err := s.ListenAndServe()
-		if errors.Is(err, http.ErrServerClosed) {
-			pkgLogger.ZapLogger.Logger.Info("server closed gracefully")
-			return nil
-		}
-		return err
-	})
-
-	interrupt := make(chan os.Signal, 1)
-	signal.Notify(interrupt, os.Interrupt, syscall.SIGTERM)
-	defer close(interrupt)
-
-	select {
-	case <-interrupt:
-		pkgLogger.ZapLogger.Logger.Info("received shutdown signal")
-
-		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
-		defer cancel()
+	cmd, ok := findCommand(name)
+	if !ok {
+		printUsage(os.Stderr)
+		os.Exit(exitUsage)
+	}
 
-		if err := s.Shutdown(ctx); err != nil {
-			pkgLogger.ZapLogger.Logger.Error("server shutdown failed: " + err.Error())
-		} else {
-			pkgLogger.ZapLogger.Logger.Info("server gracefully stopped")
-		}
+	// check-config 는 문제 목록을 직접 출력하므로 검증 실패로 종료하지 않음
+	configOptions = envs.LoadOptions{File: *configFile, Overrides: overrides}
+	config, err := envs.Load(configOptions)
+	if err != nil && cmd.name != "check-config" {
+		fmt.Fprintln(os.Stderr, err.Error())
+		os.Exit(exitInvalidConfig)
+	}
+	if config != nil {
+		envs.Apply(*config)
 	}
 
-	if err := group.Wait(); err != nil {
-		pkgLogger.ZapLogger.Logger.Fatal(err.Error())
+	// serve 외 명령은 stdout 을 결과 출력에 사용하므로 로그는 stderr 로만 기록
+	if cmd.name == "serve" {
+		pkgLogger.MustInitZapLogger()
+	} else {
+		pkgLogger.MustInitStderrZapLogger()
+	}
+	if pkgLogger.ZapLogger == nil {
+		log.Fatal("logger is nil")
 	}
 
-	pkgLogger.ZapLogger.Logger.Info("API Server End")
+	os.Exit(cmd.run(args))
 }
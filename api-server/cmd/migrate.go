@@ -0,0 +1,117 @@
+package main
+
+import (
+	"aitrics-vital-signs/api-server/app/external"
+	"aitrics-vital-signs/api-server/ddl"
+	"aitrics-vital-signs/api-server/internal/migration"
+	"aitrics-vital-signs/library/envs"
+	pkgLogger "aitrics-vital-signs/library/logger"
+	"context"
+	"fmt"
+	"io/fs"
+	"os"
+	"strconv"
+	"text/tabwriter"
+	"time"
+)
+
+const migrateUsage = `usage: migrate <command>
+  up                 적용되지 않은 migration 을 모두 적용
+  down [steps]       최근 migration 부터 steps 개 롤백 (기본 1)
+  to <version>       지정 버전까지 적용 / 롤백 (0 은 전체 롤백)
+  status             migration 적용 현황
+  force <version>    SQL 실행 없이 적용 버전만 기록 (dirty 복구 / 기존 DB 전환용)`
+
+func runMigrate(args []string) int {
+	if len(args) == 0 {
+		fmt.Fprintln(os.Stderr, migrateUsage)
+		return exitUsage
+	}
+
+	migrations, err := loadMigrations()
+	if err != nil {
+		pkgLogger.ZapLogger.Logger.Error("fail to load migrations: " + err.Error())
+		return exitError
+	}
+
+	db := external.MustMigrationDB()
+	defer db.Close()
+
+	migrator := migration.NewMigrator(db, migrations, time.Duration(envs.MigrationLockTimeoutSeconds)*time.Second)
+	ctx := context.Background()
+
+	var executed []migration.Migration
+	switch args[0] {
+	case "up":
+		executed, err = migrator.Up(ctx)
+	case "down":
+		steps := 1
+		if len(args) > 1 {
+			if steps, err = strconv.Atoi(args[1]); err != nil || steps < 1 {
+				fmt.Fprintln(os.Stderr, migrateUsage)
+				return exitUsage
+			}
+		}
+		executed, err = migrator.Down(ctx, steps)
+	case "to", "force":
+		if len(args) < 2 {
+			fmt.Fprintln(os.Stderr, migrateUsage)
+			return exitUsage
+		}
+		version, parseErr := strconv.ParseUint(args[1], 10, 64)
+		if parseErr != nil {
+			fmt.Fprintln(os.Stderr, migrateUsage)
+			return exitUsage
+		}
+		if args[0] == "to" {
+			executed, err = migrator.To(ctx, version)
+		} else {
+			err = migrator.Force(ctx, version)
+		}
+	case "status":
+		err = printMigrationStatus(ctx, migrator)
+	default:
+		fmt.Fprintln(os.Stderr, migrateUsage)
+		return exitUsage
+	}
+
+	for _, m := range executed {
+		pkgLogger.ZapLogger.Logger.Info(fmt.Sprintf("migration %d_%s done", m.Version, m.Name))
+	}
+
+	if err != nil {
+		pkgLogger.ZapLogger.Logger.Error("migrate " + args[0] + " failed: " + err.Error())
+		return exitError
+	}
+
+	return exitOK
+}
+
+func loadMigrations() ([]migration.Migration, error) {
+	sub, err := fs.Sub(ddl.Migrations, "migrations")
+	if err != nil {
+		return nil, err
+	}
+	return migration.Load(sub)
+}
+
+func printMigrationStatus(ctx context.Context, migrator *migration.Migrator) error {
+	statuses, err := migrator.Status(ctx)
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "VERSION\tNAME\tSTATUS\tAPPLIED_AT")
+	for _, status := range statuses {
+		state, appliedAt := "pending", "-"
+		if status.AppliedAt != nil {
+			state, appliedAt = "applied", status.AppliedAt.UTC().Format(time.RFC3339)
+			if status.Dirty {
+				state = "dirty"
+			}
+		}
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s\n", status.Version, status.Name, state, appliedAt)
+	}
+	return w.Flush()
+}
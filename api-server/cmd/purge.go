@@ -0,0 +1,52 @@
+package main
+
+import (
+	"context"
+	"time"
+)
+
+type purgeResult struct {
+	Before          time.Time `json:"before"`
+	Patients        int64     `json:"patients"`
+	Vitals          int64     `json:"vitals"`
+	IdempotencyKeys int64     `json:"idempotency_keys"`
+}
+
+// runPurgeDeleted deleted_at 이 older-than 보다 오래된 soft delete 행과 만료된 Idempotency-Key 를 영구 삭제합니다.
+func runPurgeDeleted(args []string) int {
+	flags := newFlagSet("purge-deleted")
+	olderThan := flags.Duration("older-than", 30*24*time.Hour, "삭제 후 보관 기간 (예: 720h)")
+	if err := flags.Parse(args); err != nil {
+		return exitUsage
+	}
+	if *olderThan < 0 {
+		flags.Usage()
+		return exitUsage
+	}
+
+	ctx := context.Background()
+	deps := mustDependencies(ctx)
+	result := purgeResult{Before: time.Now().UTC().Add(-*olderThan)}
+
+	// vital 을 먼저 정리해 환자 삭제 중 실패해도 고아 vital 이 남지 않도록 합니다.
+	vitals, err := deps.vitalService.PurgeDeletedVitals(ctx, result.Before)
+	if err != nil {
+		return failWith("purge-deleted", err)
+	}
+	result.Vitals = vitals
+
+	patients, err := deps.patientService.PurgeDeletedPatients(ctx, result.Before)
+	if err != nil {
+		return failWith("purge-deleted", err)
+	}
+	result.Patients = patients
+
+	// Idempotency-Key 는 보관 기간과 관계없이 만료 즉시 정리
+	idempotencyKeys, err := deps.idempotencyService.PurgeExpiredIdempotencyKeys(ctx, time.Now().UTC())
+	if err != nil {
+		return failWith("purge-deleted", err)
+	}
+	result.IdempotencyKeys = idempotencyKeys
+
+	return printJSON(result)
+}
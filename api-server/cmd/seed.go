@@ -0,0 +1,69 @@
+package main
+
+import (
+	"aitrics-vital-signs/api-server/internal/generator"
+	pkgError "aitrics-vital-signs/library/error"
+	"context"
+	"fmt"
+	"os"
+	"time"
+)
+
+type seedResult struct {
+	Created int `json:"created"`
+	Skipped int `json:"skipped"` // 이미 존재하는 환자 ID
+}
+
+// runSeed
+// {prefix}{start + i} 형식의 환자 ID 로 데모용 환자를 생성합니다. 이미 존재하는 ID 는 건너뛰므로 여러 번 실행해도 안전합니다.
+// vital 시계열까지 필요하면 generate 명령을 사용합니다.
+func runSeed(args []string) int {
+	flags := newFlagSet("seed")
+	count := flags.Int("patients", 0, "생성할 환자 수 (필수)")
+	prefix := flags.String("prefix", "S", "환자 ID prefix")
+	start := flags.Int("start", 1, "환자 ID 시작 번호")
+	seed := flags.Int64("seed", 1, "이름 / 성별 / 생년월일 생성 seed (같은 값이면 같은 결과)")
+	if err := flags.Parse(args); err != nil {
+		return exitUsage
+	}
+
+	g, err := generator.NewGenerator(generator.Config{
+		Seed:            *seed,
+		Patients:        *count,
+		PatientIDPrefix: *prefix,
+		PatientIDStart:  *start,
+		Start:           time.Now().UTC(),
+	})
+	if err != nil || *start < 0 {
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err.Error())
+		}
+		flags.Usage()
+		return exitUsage
+	}
+
+	ctx := context.Background()
+	deps := mustDependencies(ctx)
+
+	var result seedResult
+	if err := g.Generate(func(series *generator.Series) error {
+		_, err := deps.patientRepository.FindPatientByID(ctx, series.Patient.PatientID)
+		if err == nil {
+			result.Skipped++
+			return nil
+		}
+		if !pkgError.CompareBusinessError(err, pkgError.NotFound) {
+			return pkgError.Wrap(err)
+		}
+
+		if err := deps.patientRepository.CreatePatient(ctx, &series.Patient); err != nil {
+			return pkgError.Wrap(err)
+		}
+		result.Created++
+		return nil
+	}); err != nil {
+		return failWith("seed", err)
+	}
+
+	return printJSON(result)
+}
@@ -0,0 +1,255 @@
+package main
+
+import (
+	"aitrics-vital-signs/api-server/app/controller"
+	"aitrics-vital-signs/api-server/app/router"
+	"aitrics-vital-signs/api-server/app/service"
+	"aitrics-vital-signs/api-server/domain"
+	"aitrics-vital-signs/api-server/domain/audit"
+	"aitrics-vital-signs/api-server/domain/auth"
+	"aitrics-vital-signs/api-server/domain/health"
+	internalAudit "aitrics-vital-signs/api-server/internal/audit"
+	internalHealth "aitrics-vital-signs/api-server/internal/health"
+	"aitrics-vital-signs/api-server/internal/metrics"
+	"aitrics-vital-signs/api-server/internal/middleware"
+	"aitrics-vital-signs/api-server/internal/migration"
+	"aitrics-vital-signs/api-server/internal/tracing"
+	"aitrics-vital-signs/library/envs"
+	pkgLogger "aitrics-vital-signs/library/logger"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/gin-contrib/cors"
+	"github.com/gin-gonic/gin"
+	"golang.org/x/sync/errgroup"
+
+	swaggerFiles "github.com/swaggo/files"
+	ginSwagger "github.com/swaggo/gin-swagger"
+)
+
+// shutdownStepTimeout 서버 종료 / 감사 기록 저장 / span 전송 각 단계에 허용하는 최대 시간
+const shutdownStepTimeout = 5 * time.Second
+
+// runServe HTTP API 서버를 기동하고 종료 신호를 받으면 graceful shutdown 합니다.
+func runServe(args []string) int {
+	flags := newFlagSet("serve")
+	if err := flags.Parse(args); err != nil {
+		return exitUsage
+	}
+
+	bCtx, cancelFunc := context.WithCancel(context.Background())
+	defer cancelFunc()
+	group, _ := errgroup.WithContext(bCtx)
+
+	shutdownTracing, err := tracing.Init(bCtx, tracing.Config{
+		Endpoint:    envs.TracingEndpoint,
+		ServiceName: envs.ServerName,
+		Environment: envs.ServiceType,
+		SampleRatio: envs.TracingSampleRatio,
+	})
+	if err != nil {
+		pkgLogger.ZapLogger.Logger.Error("fail to init tracing: " + err.Error())
+		return exitError
+	}
+
+	engine := gin.New()
+	// controller 가 *gin.Context 를 context 로 전달하므로 request context 의 span 을 조회할 수 있도록 설정
+	engine.ContextWithFallback = true
+	// panic 으로 인한 500 도 access log / metric 에 기록되도록 Recovery 를 뒤에 둡니다.
+	engine.Use(
+		middleware.RequestIDMiddleware(),
+		middleware.TracingMiddleware(),
+		middleware.ReadRouteMiddleware(),
+		middleware.AccessLogMiddleware(),
+		middleware.MetricsMiddleware(),
+		gin.Recovery(),
+		middleware.GinBusinessErrLogger(),
+	)
+
+	conf := &cors.Config{
+		AllowMethods:     []string{"GET", "POST", "PUT", "PATCH", "DELETE", "HEAD", "OPTIONS", "UPDATE"},
+		AllowHeaders:     []string{"X-Request-Id", "X-Forwarded-Proto", "X-Forwarded-Host", "Origin", "Content-Length", "Access-Control-Allow-Origin", "Content-Type", "Accept-Encoding", "origin", "accept", "X-Requested-With", " X-CSRF-Token", "Cache-Control", "Baggage", "Traceparent", "Tracestate", "If-Match", "If-None-Match", "Accept-Language", "Idempotency-Key", "X-Read-Your-Writes"},
+		AllowCredentials: false,
+		ExposeHeaders:    []string{"Content-Length", "Access-Control-Allow-Headers", "Cache-Control", "Content-Language", "Content-Type", "ETag", "Idempotent-Replayed", "Traceparent", "X-Request-Id"},
+		MaxAge:           12 * time.Hour,
+		AllowOrigins:     []string{"*"},
+	}
+	engine.Use(cors.New(*conf))
+	engine.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
+
+	// replica health check 는 종료 단계에서 bCtx 를 취소하면 중단됩니다.
+	deps := mustDependencies(bCtx)
+	warnPendingMigrations(deps.dbClient)
+	registerDBStats(deps.dbClient)
+
+	patientController := controller.NewPatientController(deps.patientService, envs.PatientReplaceOnPut)
+	vitalController := controller.NewVitalController(deps.vitalService)
+	inferenceController := controller.NewInferenceController(deps.inferenceService)
+	apiKeyController := controller.NewAPIKeyController(deps.apiKeyService)
+	wardController := controller.NewWardController(deps.wardService)
+	encounterController := controller.NewEncounterController(deps.encounterService)
+	auditController := controller.NewAuditController(deps.auditService)
+	adminController := controller.NewAdminController(deps.adminService)
+
+	// /api/v1 요청 감사 기록 (라우터 등록 전에 적용)
+	auditWriter := internalAudit.NewBufferedWriter(deps.auditRepository, internalAudit.BufferedWriterConfig{
+		BufferSize:    envs.AuditBufferSize,
+		BatchSize:     envs.AuditBatchSize,
+		FlushInterval: time.Duration(envs.AuditFlushIntervalMs) * time.Millisecond,
+	})
+	engine.Use(middleware.AuditMiddleware(auditWriter))
+
+	// readiness 는 DB, 스키마 migration, background worker 상태를 모두 확인
+	healthService := service.NewHealthService(readinessDependencies(deps.dbClient, auditWriter)...)
+	router.NewHealthRouter(engine, controller.NewHealthController(healthService))
+	router.NewMetricsRouter(engine)
+	router.NewErrorRouter(engine)
+
+	// JWKS 가 설정된 경우 IdP 에서 발급한 JWT 도 함께 허용
+	var jwtAuthenticator auth.Authenticator
+	if envs.JWKSSource != "" {
+		jwtAuthenticator = middleware.NewJWTAuthenticator(
+			middleware.NewJWKSProvider(envs.JWKSSource, time.Duration(envs.JWKSCacheTTLMinutes)*time.Minute),
+			middleware.JWTConfig{
+				Issuer:    envs.JWTIssuer,
+				Audience:  envs.JWTAudience,
+				RoleClaim: envs.JWTRoleClaim,
+			},
+		)
+	}
+	authenticator := middleware.NewBearerAuthenticator(deps.apiKeyService, jwtAuthenticator)
+
+	router.NewPatientRouter(engine, patientController, authenticator, deps.idempotencyService)
+	router.NewVitalRouter(engine, vitalController, authenticator, deps.idempotencyService)
+	router.NewInferenceRouter(engine, inferenceController, authenticator)
+	router.NewAPIKeyRouter(engine, apiKeyController, authenticator)
+	router.NewWardRouter(engine, wardController, authenticator)
+	router.NewEncounterRouter(engine, encounterController, authenticator)
+	router.NewAuditRouter(engine, auditController, authenticator)
+	router.NewAdminRouter(engine, adminController, authenticator)
+
+	s := &http.Server{
+		Addr:    fmt.Sprintf(":%s", envs.ServerPort),
+		Handler: engine,
+	}
+
+	group.Go(func() error {
+		err := s.ListenAndServe()
+		if errors.Is(err, http.ErrServerClosed) {
+			pkgLogger.ZapLogger.Logger.Info("server closed gracefully")
+			return nil
+		}
+		return err
+	})
+
+	interrupt := make(chan os.Signal, 1)
+	signal.Notify(interrupt, os.Interrupt, syscall.SIGTERM)
+	defer close(interrupt)
+
+	select {
+	case <-interrupt:
+		pkgLogger.ZapLogger.Logger.Info("received shutdown signal")
+
+		// 로드밸런서가 readiness 실패를 감지해 트래픽을 제외할 때까지 대기한 뒤 연결 종료
+		healthService.StartShutdown()
+		time.Sleep(time.Duration(envs.ShutdownDelaySeconds) * time.Second)
+
+		if err := shutdownStep(s.Shutdown); err != nil {
+			pkgLogger.ZapLogger.Logger.Error("server shutdown failed: " + err.Error())
+		} else {
+			pkgLogger.ZapLogger.Logger.Info("server gracefully stopped")
+		}
+
+		// 처리 완료된 요청의 감사 기록을 모두 저장한 뒤 종료
+		if err := shutdownStep(auditWriter.Close); err != nil {
+			pkgLogger.ZapLogger.Logger.Error("audit writer close failed: " + err.Error())
+		}
+
+		// 아직 내보내지 않은 span 전송
+		if err := shutdownStep(shutdownTracing); err != nil {
+			pkgLogger.ZapLogger.Logger.Error("tracer provider shutdown failed: " + err.Error())
+		}
+
+		// replica health check 등 background 작업 중단
+		cancelFunc()
+	}
+
+	if err := group.Wait(); err != nil {
+		pkgLogger.ZapLogger.Logger.Error(err.Error())
+		return exitError
+	}
+
+	pkgLogger.ZapLogger.Logger.Info("API Server End")
+	return exitOK
+}
+
+// shutdownStep 종료 단계마다 별도의 timeout 을 사용해, 앞 단계가 지연되어도 감사 기록 저장 / span 전송 시간을 보장합니다.
+func shutdownStep(fn func(ctx context.Context) error) error {
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownStepTimeout)
+	defer cancel()
+	return fn(ctx)
+}
+
+func readinessDependencies(dbClient domain.ExternalDBClient, auditWriter audit.AuditWriter) []health.Dependency {
+	dependencies := []health.Dependency{
+		{Name: "db", Checker: internalHealth.NewDBChecker(dbClient)},
+		{Name: "audit_writer", Checker: auditWriter},
+	}
+
+	// AutoMigrate 사용 시(개발 환경) 스키마 버전을 관리하지 않으므로 migration 상태는 확인하지 않음
+	if !envs.DBAutoMigrate {
+		migrations, err := loadMigrations()
+		if err != nil {
+			pkgLogger.ZapLogger.Logger.Sugar().Fatalf("failed to load migrations: %v", err)
+		}
+		dependencies = append(dependencies, health.Dependency{Name: "migration", Checker: internalHealth.NewMigrationChecker(dbClient, migrations)})
+	}
+
+	return dependencies
+}
+
+// registerDBStats connection pool 상태를 /metrics 로 노출합니다.
+func registerDBStats(dbClient domain.ExternalDBClient) {
+	sqlDB, err := dbClient.MySQL().DB()
+	if err == nil {
+		err = metrics.RegisterDBStats(sqlDB, envs.DBName)
+	}
+	if err != nil {
+		pkgLogger.ZapLogger.Logger.Warn("fail to register db stats metrics: " + err.Error())
+	}
+}
+
+// warnPendingMigrations 스키마는 migrate 명령으로만 변경하며, 기동 시에는 미적용 migration 여부만 확인합니다.
+func warnPendingMigrations(dbClient domain.ExternalDBClient) {
+	if envs.DBAutoMigrate {
+		return
+	}
+
+	sqlDB, err := dbClient.MySQL().DB()
+	if err != nil {
+		pkgLogger.ZapLogger.Logger.Warn("fail to check migrations: " + err.Error())
+		return
+	}
+
+	migrations, err := loadMigrations()
+	if err != nil {
+		pkgLogger.ZapLogger.Logger.Warn("fail to check migrations: " + err.Error())
+		return
+	}
+
+	pending, err := migration.NewMigrator(sqlDB, migrations, 0).Pending(context.Background())
+	if err != nil {
+		pkgLogger.ZapLogger.Logger.Warn("fail to check migrations: " + err.Error())
+		return
+	}
+
+	if len(pending) > 0 {
+		pkgLogger.ZapLogger.Logger.Warn(fmt.Sprintf("%d pending migration(s), run `migrate up`", len(pending)))
+	}
+}
@@ -0,0 +1,10 @@
+package ddl
+
+import "embed"
+
+// Migrations
+// 버전별 스키마 변경 SQL. 파일명은 {version}_{name}.up.sql / {version}_{name}.down.sql 형식이며,
+// 이미 배포된 파일은 수정하지 않고 새 버전 파일을 추가합니다.
+//
+//go:embed migrations/*.sql
+var Migrations embed.FS
@@ -0,0 +1,30 @@
+package ddl
+
+import (
+	"aitrics-vital-signs/api-server/internal/migration"
+	"io/fs"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Migrations(t *testing.T) {
+	sub, err := fs.Sub(Migrations, "migrations")
+	require.NoError(t, err)
+
+	migrations, err := migration.Load(sub)
+	require.NoError(t, err)
+	require.NotEmpty(t, migrations)
+
+	for idx, m := range migrations {
+		require.Equal(t, uint64(idx+1), m.Version, "migration versions must be contiguous")
+		require.NotEmpty(t, m.Down, "migration %d_%s has no down file", m.Version, m.Name)
+	}
+
+	// 기존 DB 가 `migrate force 1` 후 `migrate up` 으로 전환할 수 있도록 1 번은 최초 스키마 (patients / vitals) 만 생성
+	require.Equal(t, 2, strings.Count(migrations[0].Up, "CREATE TABLE"))
+	require.Contains(t, migrations[0].Up, "CREATE TABLE `patients`")
+	require.Contains(t, migrations[0].Up, "CREATE TABLE `vitals`")
+	require.NotContains(t, migrations[0].Up, "encounter_id")
+}
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS `vitals`;
+DROP TABLE IF EXISTS `patients`;
@@ -26,4 +26,4 @@ CREATE TABLE `vitals` (
                           `updated_at` datetime(3) DEFAULT NULL COMMENT '데이터 수정일',
                           `deleted_at` datetime(3) DEFAULT NULL COMMENT '데이터 삭제일',
                           PRIMARY KEY (`patient_id`,`recorded_at`,`vital_type`)
-) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;
\ No newline at end of file
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;
@@ -0,0 +1,15 @@
+-- aitrics_db.api_keys definition
+
+CREATE TABLE `api_keys` (
+                            `id` char(36) NOT NULL COMMENT 'PK',
+                            `name` varchar(100) NOT NULL COMMENT '키 이름',
+                            `prefix` varchar(16) NOT NULL COMMENT '키 식별용 prefix',
+                            `key_hash` char(64) NOT NULL COMMENT '키 SHA-256 해시',
+                            `scopes` varchar(255) NOT NULL COMMENT '권한 범위 (comma separated)',
+                            `expires_at` datetime(3) DEFAULT NULL COMMENT '만료일',
+                            `revoked_at` datetime(3) DEFAULT NULL COMMENT '폐기일',
+                            `created_at` datetime(3) NOT NULL COMMENT '데이터 생성일',
+                            `updated_at` datetime(3) DEFAULT NULL COMMENT '데이터 수정일',
+                            PRIMARY KEY (`id`),
+                            UNIQUE KEY `idx_api_keys_key_hash` (`key_hash`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS `bed_assignments`;
+DROP TABLE IF EXISTS `beds`;
+DROP TABLE IF EXISTS `wards`;
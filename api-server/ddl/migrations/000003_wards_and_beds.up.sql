@@ -0,0 +1,45 @@
+-- aitrics_db.wards definition
+
+CREATE TABLE `wards` (
+                         `id` char(36) NOT NULL COMMENT 'PK',
+                         `code` varchar(20) NOT NULL COMMENT '병동 코드',
+                         `name` varchar(100) NOT NULL COMMENT '병동 이름',
+                         `created_at` datetime(3) NOT NULL COMMENT '데이터 생성일',
+                         `updated_at` datetime(3) DEFAULT NULL COMMENT '데이터 수정일',
+                         PRIMARY KEY (`id`),
+                         UNIQUE KEY `idx_wards_code` (`code`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;
+
+-- aitrics_db.beds definition
+
+CREATE TABLE `beds` (
+                        `id` char(36) NOT NULL COMMENT 'PK',
+                        `ward_id` char(36) NOT NULL COMMENT '병동 ID',
+                        `label` varchar(20) NOT NULL COMMENT '병상 표시명',
+                        `created_at` datetime(3) NOT NULL COMMENT '데이터 생성일',
+                        `updated_at` datetime(3) DEFAULT NULL COMMENT '데이터 수정일',
+                        PRIMARY KEY (`id`),
+                        UNIQUE KEY `idx_beds_ward_id_label` (`ward_id`,`label`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;
+
+-- aitrics_db.bed_assignments definition
+
+CREATE TABLE `bed_assignments` (
+                                   `id` char(36) NOT NULL COMMENT 'PK',
+                                   `patient_id` varchar(20) NOT NULL COMMENT '외부 환자 ID',
+                                   `ward_id` char(36) NOT NULL COMMENT '병동 ID',
+                                   `bed_id` char(36) NOT NULL COMMENT '병상 ID',
+                                   `active_bed_id` char(36) DEFAULT NULL COMMENT '배정 중인 병상 ID',
+                                   `active_patient_id` varchar(20) DEFAULT NULL COMMENT '배정 중인 환자 ID',
+                                   `assign_reason` enum('ADMIT','TRANSFER') NOT NULL COMMENT '배정 사유',
+                                   `release_reason` enum('TRANSFER','DISCHARGE') DEFAULT NULL COMMENT '해제 사유',
+                                   `assigned_at` datetime(3) NOT NULL COMMENT '배정일',
+                                   `released_at` datetime(3) DEFAULT NULL COMMENT '해제일',
+                                   `created_at` datetime(3) NOT NULL COMMENT '데이터 생성일',
+                                   `updated_at` datetime(3) DEFAULT NULL COMMENT '데이터 수정일',
+                                   PRIMARY KEY (`id`),
+                                   UNIQUE KEY `idx_bed_assignments_active_bed_id` (`active_bed_id`),
+                                   UNIQUE KEY `idx_bed_assignments_active_patient_id` (`active_patient_id`),
+                                   KEY `idx_bed_assignments_patient_id` (`patient_id`),
+                                   KEY `idx_bed_assignments_ward_id` (`ward_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;
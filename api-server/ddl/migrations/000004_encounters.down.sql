@@ -0,0 +1,9 @@
+ALTER TABLE `bed_assignments`
+    DROP KEY `idx_bed_assignments_encounter_id`,
+    DROP COLUMN `encounter_id`;
+
+ALTER TABLE `vitals`
+    DROP KEY `idx_vitals_encounter_id`,
+    DROP COLUMN `encounter_id`;
+
+DROP TABLE IF EXISTS `encounters`;
@@ -0,0 +1,26 @@
+-- aitrics_db.encounters definition
+
+CREATE TABLE `encounters` (
+                              `id` char(36) NOT NULL COMMENT 'PK',
+                              `patient_id` varchar(20) NOT NULL COMMENT '외부 환자 ID',
+                              `type` enum('INPATIENT','EMERGENCY','OBSERVATION') NOT NULL COMMENT '내원 유형',
+                              `ward_id` char(36) DEFAULT NULL COMMENT '현재 병동 ID',
+                              `active_patient_id` varchar(20) DEFAULT NULL COMMENT '진행 중인 환자 ID',
+                              `admitted_at` datetime(3) NOT NULL COMMENT '입원일',
+                              `discharged_at` datetime(3) DEFAULT NULL COMMENT '퇴원일',
+                              `created_at` datetime(3) NOT NULL COMMENT '데이터 생성일',
+                              `updated_at` datetime(3) DEFAULT NULL COMMENT '데이터 수정일',
+                              PRIMARY KEY (`id`),
+                              UNIQUE KEY `idx_encounters_active_patient_id` (`active_patient_id`),
+                              KEY `idx_encounters_patient_id_admitted_at` (`patient_id`,`admitted_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;
+
+-- vital / 병상 배정을 encounter 에 연결
+
+ALTER TABLE `vitals`
+    ADD COLUMN `encounter_id` char(36) DEFAULT NULL COMMENT 'encounter ID' AFTER `value`,
+    ADD KEY `idx_vitals_encounter_id` (`encounter_id`);
+
+ALTER TABLE `bed_assignments`
+    ADD COLUMN `encounter_id` char(36) NOT NULL COMMENT 'encounter ID' AFTER `bed_id`,
+    ADD KEY `idx_bed_assignments_encounter_id` (`encounter_id`);
@@ -0,0 +1,3 @@
+DROP TRIGGER IF EXISTS `audit_events_block_delete`;
+DROP TRIGGER IF EXISTS `audit_events_block_update`;
+DROP TABLE IF EXISTS `audit_events`;
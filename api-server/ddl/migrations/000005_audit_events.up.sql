@@ -0,0 +1,33 @@
+-- aitrics_db.audit_events definition
+
+CREATE TABLE `audit_events` (
+                                `id` bigint unsigned NOT NULL AUTO_INCREMENT COMMENT 'PK',
+                                `request_id` varchar(64) NOT NULL COMMENT '요청 ID',
+                                `principal_id` varchar(255) NOT NULL COMMENT '인증 주체 ID',
+                                `principal_name` varchar(255) NOT NULL COMMENT '인증 주체 이름',
+                                `principal_type` varchar(20) NOT NULL COMMENT '인증 주체 유형',
+                                `action` enum('READ','WRITE') NOT NULL COMMENT '행위',
+                                `method` varchar(10) NOT NULL COMMENT 'HTTP method',
+                                `route` varchar(255) NOT NULL COMMENT 'route 패턴',
+                                `path` varchar(1024) NOT NULL COMMENT '요청 경로',
+                                `patient_id` varchar(20) DEFAULT NULL COMMENT '대상 환자 ID',
+                                `resource_key` varchar(255) NOT NULL DEFAULT '' COMMENT '대상 리소스 키 (vital_type@recorded_at 등)',
+                                `outcome` enum('SUCCESS','DENIED','FAILURE') NOT NULL COMMENT '처리 결과',
+                                `status_code` smallint NOT NULL COMMENT 'HTTP status',
+                                `error_code` int NOT NULL DEFAULT '0' COMMENT 'business error code',
+                                `client_ip` varchar(45) NOT NULL COMMENT '클라이언트 IP',
+                                `occurred_at` datetime(3) NOT NULL COMMENT '발생 시각',
+                                PRIMARY KEY (`id`),
+                                KEY `idx_audit_events_request_id` (`request_id`),
+                                KEY `idx_audit_events_occurred_at` (`occurred_at`),
+                                KEY `idx_audit_events_principal_id_occurred_at` (`principal_id`,`occurred_at`),
+                                KEY `idx_audit_events_patient_id_occurred_at` (`patient_id`,`occurred_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;
+
+-- audit_events 는 append-only: 수정 / 삭제 차단
+
+CREATE TRIGGER `audit_events_block_update` BEFORE UPDATE ON `audit_events`
+    FOR EACH ROW SIGNAL SQLSTATE '45000' SET MESSAGE_TEXT = 'audit_events is append-only';
+
+CREATE TRIGGER `audit_events_block_delete` BEFORE DELETE ON `audit_events`
+    FOR EACH ROW SIGNAL SQLSTATE '45000' SET MESSAGE_TEXT = 'audit_events is append-only';
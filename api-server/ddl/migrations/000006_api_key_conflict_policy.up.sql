@@ -0,0 +1,2 @@
+ALTER TABLE `api_keys`
+    ADD COLUMN `conflict_policy` varchar(20) NOT NULL DEFAULT 'strict' COMMENT 'vital 저장 기본 충돌 정책' AFTER `scopes`;
@@ -0,0 +1,14 @@
+CREATE TABLE `idempotency_keys` (
+                                    `principal_id` varchar(255) NOT NULL COMMENT '인증 주체 ID',
+                                    `idempotency_key` varchar(255) NOT NULL COMMENT 'Idempotency-Key 헤더 값',
+                                    `method` varchar(10) NOT NULL COMMENT 'HTTP method',
+                                    `path` varchar(1024) NOT NULL COMMENT '요청 경로',
+                                    `request_hash` char(64) NOT NULL COMMENT 'method / 경로 / body SHA-256 해시',
+                                    `status_code` smallint DEFAULT NULL COMMENT '응답 HTTP status (처리 중이면 NULL)',
+                                    `content_type` varchar(255) NOT NULL DEFAULT '' COMMENT '응답 Content-Type',
+                                    `response_body` mediumblob COMMENT '응답 body',
+                                    `created_at` datetime(3) NOT NULL COMMENT '데이터 생성일',
+                                    `expires_at` datetime(3) NOT NULL COMMENT '만료일',
+                                    PRIMARY KEY (`principal_id`,`idempotency_key`),
+                                    KEY `idx_idempotency_keys_expires_at` (`expires_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;
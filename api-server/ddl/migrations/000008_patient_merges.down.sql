@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS `patient_merge_vitals`;
+DROP TABLE IF EXISTS `patient_merges`;
@@ -0,0 +1,26 @@
+CREATE TABLE `patient_merges` (
+                                  `id` char(36) NOT NULL COMMENT 'PK',
+                                  `source_patient_ref` char(36) NOT NULL COMMENT 'source 환자 PK',
+                                  `target_patient_ref` char(36) NOT NULL COMMENT 'target 환자 PK',
+                                  `source_patient_id` varchar(20) NOT NULL COMMENT '병합 시점의 source 외부 환자 ID',
+                                  `target_patient_id` varchar(20) NOT NULL COMMENT '병합 시점의 target 외부 환자 ID',
+                                  `collision_policy` varchar(20) NOT NULL COMMENT '충돌 처리 정책',
+                                  `moved_count` int NOT NULL COMMENT '이동한 vital 수',
+                                  `collision_count` int NOT NULL COMMENT '충돌한 vital 수',
+                                  `merged_at` datetime(3) NOT NULL COMMENT '병합일',
+                                  `reverted_at` datetime(3) DEFAULT NULL COMMENT '되돌린 일시',
+                                  PRIMARY KEY (`id`),
+                                  KEY `idx_patient_merges_source_patient_ref` (`source_patient_ref`),
+                                  KEY `idx_patient_merges_target_patient_ref` (`target_patient_ref`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;
+
+CREATE TABLE `patient_merge_vitals` (
+                                        `merge_id` char(36) NOT NULL COMMENT '병합 ID',
+                                        `recorded_at` datetime(3) NOT NULL COMMENT '레코드 기록일',
+                                        `vital_type` enum('HR','RR','SBP','DBP','SpO2','BT') NOT NULL COMMENT '바이탈 유형',
+                                        `action` varchar(20) NOT NULL COMMENT '처리 결과 (moved / kept_target / replaced)',
+                                        `previous_value` double DEFAULT NULL COMMENT '교체 전 target 값',
+                                        `previous_encounter_id` char(36) DEFAULT NULL COMMENT '교체 전 target encounter ID',
+                                        `previous_deleted_at` datetime(3) DEFAULT NULL COMMENT '교체 전 target 삭제일',
+                                        PRIMARY KEY (`merge_id`,`recorded_at`,`vital_type`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;
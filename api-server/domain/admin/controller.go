@@ -0,0 +1,8 @@
+//go:generate mockgen -source=controller.go -destination=../mock/mock_admin_controller.go -package=mock
+package admin
+
+import "github.com/gin-gonic/gin"
+
+type AdminController interface {
+	GetConfig(ctx *gin.Context)
+}
@@ -0,0 +1,12 @@
+//go:generate mockgen -source=service.go -destination=../mock/mock_admin_service.go -package=mock
+package admin
+
+import (
+	"aitrics-vital-signs/library/envs"
+	"context"
+)
+
+type AdminService interface {
+	// GetConfig 현재 적용된 설정 (secret 마스킹)
+	GetConfig(ctx context.Context) envs.Config
+}
@@ -0,0 +1,10 @@
+//go:generate mockgen -source=controller.go -destination=../mock/mock_apikey_controller.go -package=mock
+package apikey
+
+import "github.com/gin-gonic/gin"
+
+type APIKeyController interface {
+	CreateAPIKey(ctx *gin.Context)
+	ListAPIKeys(ctx *gin.Context)
+	RevokeAPIKey(ctx *gin.Context)
+}
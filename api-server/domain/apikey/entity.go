@@ -0,0 +1,30 @@
+package apikey
+
+import (
+	"strings"
+	"time"
+)
+
+type APIKey struct {
+	ID             string     `gorm:"column:id;type:char(36);primaryKey;comment:PK"`
+	Name           string     `gorm:"column:name;type:varchar(100);not null;comment:키 이름"`
+	Prefix         string     `gorm:"column:prefix;type:varchar(16);not null;comment:키 식별용 prefix"`
+	KeyHash        string     `gorm:"column:key_hash;type:char(64);not null;uniqueIndex;comment:키 SHA-256 해시"`
+	Scopes         string     `gorm:"column:scopes;type:varchar(255);not null;comment:권한 범위 (comma separated)"`
+	ConflictPolicy string     `gorm:"column:conflict_policy;type:varchar(20);not null;default:strict;comment:vital 저장 기본 충돌 정책"`
+	ExpiresAt      *time.Time `gorm:"column:expires_at;type:datetime(3);comment:만료일"`
+	RevokedAt      *time.Time `gorm:"column:revoked_at;type:datetime(3);comment:폐기일"`
+	CreatedAt      time.Time  `gorm:"column:created_at;type:datetime(3);not null;comment:데이터 생성일"`
+	UpdatedAt      *time.Time `gorm:"column:updated_at;type:datetime(3);comment:데이터 수정일"`
+}
+
+func (a *APIKey) TableName() string {
+	return "api_keys"
+}
+
+func (a *APIKey) ScopeList() []string {
+	if a.Scopes == "" {
+		return []string{}
+	}
+	return strings.Split(a.Scopes, ",")
+}
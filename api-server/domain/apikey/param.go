@@ -0,0 +1,27 @@
+package apikey
+
+import "time"
+
+type CreateAPIKeyRequest struct {
+	Name      string     `json:"name" binding:"required,max=100"`
+	Scopes    []string   `json:"scopes" binding:"required,min=1,dive,oneof=patients:read patients:write vitals:read vitals:write inference:run admin"`
+	ExpiresAt *time.Time `json:"expires_at"`
+	// ConflictPolicy 이 키로 vital 을 저장할 때의 기본 conflict_policy (생략 시 strict)
+	ConflictPolicy string `json:"conflict_policy" binding:"omitempty,oneof=strict last_write_wins keep_first max_of min_of"`
+}
+
+type CreateAPIKeyResponse struct {
+	APIKeyResponse
+	Key string `json:"key"` // 평문 키는 생성 시 한 번만 노출
+}
+
+type APIKeyResponse struct {
+	ID             string     `json:"id"`
+	Name           string     `json:"name"`
+	Prefix         string     `json:"prefix"`
+	Scopes         []string   `json:"scopes"`
+	ConflictPolicy string     `json:"conflict_policy"`
+	ExpiresAt      *time.Time `json:"expires_at"`
+	RevokedAt      *time.Time `json:"revoked_at"`
+	CreatedAt      time.Time  `json:"created_at"`
+}
@@ -0,0 +1,15 @@
+//go:generate mockgen -source=repository.go -destination=../mock/mock_apikey_repository.go -package=mock
+package apikey
+
+import (
+	"context"
+	"time"
+)
+
+type APIKeyRepository interface {
+	CreateAPIKey(ctx context.Context, model *APIKey) error
+	FindAPIKeyByID(ctx context.Context, id string) (*APIKey, error)
+	FindAPIKeyByHash(ctx context.Context, keyHash string) (*APIKey, error)
+	FindAPIKeys(ctx context.Context) ([]APIKey, error)
+	RevokeAPIKey(ctx context.Context, id string, revokedAt time.Time) error
+}
@@ -0,0 +1,14 @@
+//go:generate mockgen -source=service.go -destination=../mock/mock_apikey_service.go -package=mock
+package apikey
+
+import (
+	"aitrics-vital-signs/api-server/domain/auth"
+	"context"
+)
+
+type APIKeyService interface {
+	auth.Authenticator
+	CreateAPIKey(ctx context.Context, request CreateAPIKeyRequest) (*CreateAPIKeyResponse, error)
+	ListAPIKeys(ctx context.Context) ([]APIKeyResponse, error)
+	RevokeAPIKey(ctx context.Context, id string) error
+}
@@ -0,0 +1,37 @@
+package audit
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ResourceContextKey controller 가 접근한 리소스를 감사 middleware 로 전달하는 gin context key
+const ResourceContextKey = "audit_resources"
+
+// Resource 감사 대상 리소스. Key 는 vital 의 경우 vital_type@recorded_at 형식입니다.
+type Resource struct {
+	PatientID string
+	Key       string
+}
+
+// AddResources
+// path parameter 로 알 수 없는 리소스(body 의 patient_id, 다건 조회 결과 등)를 감사 기록에 추가합니다.
+func AddResources(ctx *gin.Context, resources ...Resource) {
+	ctx.Set(ResourceContextKey, append(ResourcesFromContext(ctx), resources...))
+}
+
+func ResourcesFromContext(ctx *gin.Context) []Resource {
+	value, ok := ctx.Get(ResourceContextKey)
+	if !ok {
+		return nil
+	}
+	resources, _ := value.([]Resource)
+	return resources
+}
+
+// VitalResourceKey vital 한 건을 식별하는 감사 리소스 키
+func VitalResourceKey(vitalType string, recordedAt time.Time) string {
+	return fmt.Sprintf("%s@%s", vitalType, recordedAt.UTC().Format(time.RFC3339Nano))
+}
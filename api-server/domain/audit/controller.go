@@ -0,0 +1,9 @@
+//go:generate mockgen -source=controller.go -destination=../mock/mock_audit_controller.go -package=mock
+package audit
+
+import "github.com/gin-gonic/gin"
+
+type AuditController interface {
+	GetAuditEvents(ctx *gin.Context)
+	ExportAuditEvents(ctx *gin.Context)
+}
@@ -0,0 +1,29 @@
+package audit
+
+import "time"
+
+// AuditEvent
+// PHI 접근 / 변경 감사 기록. 추가(insert)만 허용되며 수정 / 삭제하지 않습니다.
+// 한 요청이 여러 환자의 데이터에 접근한 경우 환자별로 한 건씩 기록되며, request_id 로 묶입니다.
+type AuditEvent struct {
+	ID            uint64    `gorm:"column:id;type:bigint unsigned;primaryKey;autoIncrement;comment:PK"`
+	RequestID     string    `gorm:"column:request_id;type:varchar(64);not null;index;comment:요청 ID"`
+	PrincipalID   string    `gorm:"column:principal_id;type:varchar(255);not null;index:idx_audit_events_principal_id_occurred_at,priority:1;comment:인증 주체 ID"`
+	PrincipalName string    `gorm:"column:principal_name;type:varchar(255);not null;comment:인증 주체 이름"`
+	PrincipalType string    `gorm:"column:principal_type;type:varchar(20);not null;comment:인증 주체 유형"`
+	Action        string    `gorm:"column:action;type:enum('READ','WRITE');not null;comment:행위"`
+	Method        string    `gorm:"column:method;type:varchar(10);not null;comment:HTTP method"`
+	Route         string    `gorm:"column:route;type:varchar(255);not null;comment:route 패턴"`
+	Path          string    `gorm:"column:path;type:varchar(1024);not null;comment:요청 경로"`
+	PatientID     *string   `gorm:"column:patient_id;type:varchar(20);index:idx_audit_events_patient_id_occurred_at,priority:1;comment:대상 환자 ID" phi:"hash"`
+	ResourceKey   string    `gorm:"column:resource_key;type:varchar(255);not null;default:'';comment:대상 리소스 키 (vital_type@recorded_at 등)"`
+	Outcome       string    `gorm:"column:outcome;type:enum('SUCCESS','DENIED','FAILURE');not null;comment:처리 결과"`
+	StatusCode    int       `gorm:"column:status_code;type:smallint;not null;comment:HTTP status"`
+	ErrorCode     int       `gorm:"column:error_code;type:int;not null;default:0;comment:business error code"`
+	ClientIP      string    `gorm:"column:client_ip;type:varchar(45);not null;comment:클라이언트 IP"`
+	OccurredAt    time.Time `gorm:"column:occurred_at;type:datetime(3);not null;index;index:idx_audit_events_principal_id_occurred_at,priority:2;index:idx_audit_events_patient_id_occurred_at,priority:2;comment:발생 시각"`
+}
+
+func (a *AuditEvent) TableName() string {
+	return "audit_events"
+}
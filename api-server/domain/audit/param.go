@@ -0,0 +1,44 @@
+package audit
+
+import "time"
+
+type GetAuditEventsRequest struct {
+	PatientID   string `form:"patient_id"`
+	PrincipalID string `form:"principal_id"`
+	From        string `form:"from"` // RFC3339 format
+	To          string `form:"to"`   // RFC3339 format
+	Cursor      uint64 `form:"cursor"`
+	Limit       int    `form:"limit" binding:"omitempty,min=1,max=1000"`
+}
+
+type ExportAuditEventsRequest struct {
+	PatientID   string `form:"patient_id"`
+	PrincipalID string `form:"principal_id"`
+	From        string `form:"from" binding:"required"` // RFC3339 format
+	To          string `form:"to" binding:"required"`   // RFC3339 format
+}
+
+type GetAuditEventsResponse struct {
+	Items []AuditEventResponse `json:"items"`
+	// 다음 페이지 조회 시 cursor 로 전달 (마지막 페이지면 null)
+	NextCursor *uint64 `json:"next_cursor"`
+}
+
+type AuditEventResponse struct {
+	ID            uint64    `json:"id"`
+	RequestID     string    `json:"request_id"`
+	PrincipalID   string    `json:"principal_id"`
+	PrincipalName string    `json:"principal_name"`
+	PrincipalType string    `json:"principal_type"`
+	Action        string    `json:"action"`
+	Method        string    `json:"method"`
+	Route         string    `json:"route"`
+	Path          string    `json:"path"`
+	PatientID     *string   `json:"patient_id"`
+	ResourceKey   string    `json:"resource_key"`
+	Outcome       string    `json:"outcome"`
+	StatusCode    int       `json:"status_code"`
+	ErrorCode     int       `json:"error_code"`
+	ClientIP      string    `json:"client_ip"`
+	OccurredAt    time.Time `json:"occurred_at"`
+}
@@ -0,0 +1,19 @@
+package audit
+
+import "time"
+
+type FindAuditEventsParam struct {
+	PatientID   string
+	PrincipalID string
+	From        *time.Time
+	To          *time.Time
+	BeforeID    uint64 // 0 이면 최신부터 조회
+	Limit       int
+}
+
+type StreamAuditEventsParam struct {
+	PatientID   string
+	PrincipalID string
+	From        time.Time
+	To          time.Time
+}
@@ -0,0 +1,11 @@
+//go:generate mockgen -source=repository.go -destination=../mock/mock_audit_repository.go -package=mock
+package audit
+
+import "context"
+
+// AuditRepository 감사 기록은 append-only 이므로 수정 / 삭제 메서드를 두지 않습니다.
+type AuditRepository interface {
+	CreateAuditEvents(ctx context.Context, models []AuditEvent) error
+	FindAuditEvents(ctx context.Context, param FindAuditEventsParam) ([]AuditEvent, error)
+	StreamAuditEvents(ctx context.Context, param StreamAuditEventsParam, fn func(*AuditEvent) error) error
+}
@@ -0,0 +1,9 @@
+//go:generate mockgen -source=service.go -destination=../mock/mock_audit_service.go -package=mock
+package audit
+
+import "context"
+
+type AuditService interface {
+	GetAuditEvents(ctx context.Context, request GetAuditEventsRequest) (*GetAuditEventsResponse, error)
+	ExportAuditEvents(ctx context.Context, request ExportAuditEventsRequest, fn func(*AuditEvent) error) error
+}
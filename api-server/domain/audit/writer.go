@@ -0,0 +1,15 @@
+//go:generate mockgen -source=writer.go -destination=../mock/mock_audit_writer.go -package=mock
+package audit
+
+import (
+	"aitrics-vital-signs/api-server/domain/health"
+	"context"
+)
+
+// AuditWriter 요청 처리 경로를 막지 않도록 감사 기록을 비동기로 저장합니다.
+type AuditWriter interface {
+	health.Checker
+	Write(event AuditEvent)
+	// Close 버퍼에 남은 기록을 모두 저장한 뒤 종료합니다.
+	Close(ctx context.Context) error
+}
@@ -0,0 +1,8 @@
+//go:generate mockgen -source=authenticator.go -destination=../mock/mock_authenticator.go -package=mock
+package auth
+
+import "context"
+
+type Authenticator interface {
+	Authenticate(ctx context.Context, token string) (*Principal, error)
+}
@@ -0,0 +1,41 @@
+package auth
+
+import (
+	"aitrics-vital-signs/api-server/pkg/constant"
+	"context"
+	"slices"
+)
+
+// principalContextKey 다른 package 의 context key 와 충돌하지 않도록 unexported 타입을 사용합니다.
+type principalContextKey struct{}
+
+type Principal struct {
+	ID     string   `json:"id"`
+	Name   string   `json:"name"`
+	Type   string   `json:"type"`
+	Roles  []string `json:"roles,omitempty"`
+	Scopes []string `json:"scopes"`
+	// ConflictPolicy vital 저장 시 요청에 conflict_policy 가 없을 때 사용할 기본 정책 (없으면 strict)
+	ConflictPolicy string `json:"conflict_policy,omitempty"`
+}
+
+// HasScope admin scope 는 모든 scope 를 포함합니다.
+func (p *Principal) HasScope(scope constant.Scope) bool {
+	if p == nil {
+		return false
+	}
+	return slices.Contains(p.Scopes, constant.ScopeAdmin.String()) || slices.Contains(p.Scopes, scope.String())
+}
+
+// WithPrincipal 인증된 principal 을 request context 에 담습니다. (gin.Context 는 ctx.Request.Context() 로 조회)
+func WithPrincipal(ctx context.Context, principal *Principal) context.Context {
+	return context.WithValue(ctx, principalContextKey{}, principal)
+}
+
+func PrincipalFromContext(ctx context.Context) (*Principal, bool) {
+	if ctx == nil {
+		return nil, false
+	}
+	principal, ok := ctx.Value(principalContextKey{}).(*Principal)
+	return principal, ok && principal != nil
+}
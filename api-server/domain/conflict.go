@@ -0,0 +1,7 @@
+package domain
+
+// DuplicateResource unique key 중복으로 Conflict 가 발생한 경우 응답 data 로 이미 존재하는 resource 를 알려줍니다.
+type DuplicateResource struct {
+	Key        string `json:"key"`         // 중복된 unique key (index) 이름
+	ExistingID string `json:"existing_id"` // 이미 존재하는 resource 의 key 값 (복합 key 는 '-' 로 연결)
+}
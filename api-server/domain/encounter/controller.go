@@ -0,0 +1,8 @@
+//go:generate mockgen -source=controller.go -destination=../mock/mock_encounter_controller.go -package=mock
+package encounter
+
+import "github.com/gin-gonic/gin"
+
+type EncounterController interface {
+	GetPatientEncounters(ctx *gin.Context)
+}
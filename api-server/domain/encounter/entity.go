@@ -0,0 +1,32 @@
+package encounter
+
+import (
+	"time"
+)
+
+// Encounter
+// 환자의 내원(입원) 단위. discharged_at 이 NULL 이면 진행 중인 encounter 이며,
+// active_patient_id 의 unique index 로 환자당 하나의 진행 중 encounter 만 허용합니다.
+type Encounter struct {
+	ID              string     `gorm:"column:id;type:char(36);primaryKey;comment:PK"`
+	PatientID       string     `gorm:"column:patient_id;type:varchar(20);not null;index:idx_encounters_patient_id_admitted_at,priority:1;comment:외부 환자 ID" phi:"hash"`
+	Type            string     `gorm:"column:type;type:enum('INPATIENT','EMERGENCY','OBSERVATION');not null;comment:내원 유형"`
+	WardID          *string    `gorm:"column:ward_id;type:char(36);comment:현재 병동 ID"`
+	ActivePatientID *string    `gorm:"column:active_patient_id;type:varchar(20);uniqueIndex;comment:진행 중인 환자 ID" phi:"hash"`
+	AdmittedAt      time.Time  `gorm:"column:admitted_at;type:datetime(3);not null;index:idx_encounters_patient_id_admitted_at,priority:2;comment:입원일"`
+	DischargedAt    *time.Time `gorm:"column:discharged_at;type:datetime(3);comment:퇴원일"`
+	CreatedAt       time.Time  `gorm:"column:created_at;type:datetime(3);not null;comment:데이터 생성일"`
+	UpdatedAt       *time.Time `gorm:"column:updated_at;type:datetime(3);comment:데이터 수정일"`
+}
+
+func (e *Encounter) TableName() string {
+	return "encounters"
+}
+
+// Contains 기록 시각이 encounter 기간에 포함되는지 여부
+func (e *Encounter) Contains(at time.Time) bool {
+	if at.Before(e.AdmittedAt) {
+		return false
+	}
+	return e.DischargedAt == nil || !at.After(*e.DischargedAt)
+}
@@ -0,0 +1,12 @@
+package encounter
+
+import "time"
+
+type EncounterResponse struct {
+	ID           string     `json:"id"`
+	PatientID    string     `json:"patient_id" phi:"hash"`
+	Type         string     `json:"type"`
+	WardID       *string    `json:"ward_id"`
+	AdmittedAt   time.Time  `json:"admitted_at"`
+	DischargedAt *time.Time `json:"discharged_at"`
+}
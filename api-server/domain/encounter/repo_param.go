@@ -0,0 +1,8 @@
+package encounter
+
+import "time"
+
+type FindEncounterByPatientIDAndTimeParam struct {
+	PatientID string
+	At        time.Time
+}
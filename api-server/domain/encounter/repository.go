@@ -0,0 +1,11 @@
+//go:generate mockgen -source=repository.go -destination=../mock/mock_encounter_repository.go -package=mock
+package encounter
+
+import "context"
+
+type EncounterRepository interface {
+	FindEncounterByID(ctx context.Context, encounterID string) (*Encounter, error)
+	FindActiveEncounterByPatientID(ctx context.Context, patientID string) (*Encounter, error)
+	FindEncounterByPatientIDAndTime(ctx context.Context, param FindEncounterByPatientIDAndTimeParam) (*Encounter, error)
+	FindEncountersByPatientID(ctx context.Context, patientID string) ([]Encounter, error)
+}
@@ -0,0 +1,8 @@
+//go:generate mockgen -source=service.go -destination=../mock/mock_encounter_service.go -package=mock
+package encounter
+
+import "context"
+
+type EncounterService interface {
+	GetPatientEncounters(ctx context.Context, patientID string) ([]EncounterResponse, error)
+}
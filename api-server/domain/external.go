@@ -2,9 +2,88 @@
 package domain
 
 import (
+	"context"
+	"database/sql"
+	"sync/atomic"
+
 	"gorm.io/gorm"
 )
 
 type ExternalDBClient interface {
 	MySQL() *gorm.DB
 }
+
+// Transactor
+// fn 을 하나의 트랜잭션으로 실행합니다. 트랜잭션은 fn 에 전달되는 context 로 전파되며, repository 는 이 context 로 호출하면 같은 트랜잭션을 사용합니다.
+// fn 이 error 를 반환하면 rollback 되며, 이미 트랜잭션 안에서 호출하면 savepoint 로 중첩됩니다. (중첩 시 opts 는 무시)
+type Transactor interface {
+	WithinTransaction(ctx context.Context, fn func(ctx context.Context) error, opts ...TxOption) error
+}
+
+// TxOption 트랜잭션 시작 옵션 (기본값은 db.transaction_isolation 설정)
+type TxOption func(options *sql.TxOptions)
+
+// WithIsolation 이번 트랜잭션의 isolation level 을 지정합니다.
+func WithIsolation(level sql.IsolationLevel) TxOption {
+	return func(options *sql.TxOptions) {
+		options.Isolation = level
+	}
+}
+
+// WithReadOnly 읽기 전용 트랜잭션으로 시작합니다.
+func WithReadOnly() TxOption {
+	return func(options *sql.TxOptions) {
+		options.ReadOnly = true
+	}
+}
+
+type txContextKey struct{}
+
+// ContextWithTx tx 를 context 에 담습니다. (Transactor 구현에서 사용)
+func ContextWithTx(ctx context.Context, tx *gorm.DB) context.Context {
+	return context.WithValue(ctx, txContextKey{}, tx)
+}
+
+// TxFromContext 진행 중인 트랜잭션이 있으면 반환합니다.
+func TxFromContext(ctx context.Context) (*gorm.DB, bool) {
+	tx, ok := ctx.Value(txContextKey{}).(*gorm.DB)
+	return tx, ok && tx != nil
+}
+
+type readRouteContextKey struct{}
+
+// readRoute 요청 단위 읽기 경로 (한 번 primary 로 바뀌면 되돌리지 않습니다)
+type readRoute struct {
+	primary atomic.Bool
+}
+
+// WithReadRoute read-your-writes 를 위한 요청 단위 읽기 경로를 context 에 담습니다.
+// primary 가 true 면 처음부터 primary 에서 읽고, false 면 이 context 로 쓰기가 발생한 이후부터 primary 에서 읽습니다.
+func WithReadRoute(ctx context.Context, primary bool) context.Context {
+	route := &readRoute{}
+	route.primary.Store(primary)
+	return context.WithValue(ctx, readRouteContextKey{}, route)
+}
+
+// MarkWritten 쓰기가 발생했음을 기록해 이후 읽기를 primary 로 보냅니다. (WithReadRoute 가 없는 context 는 무시)
+func MarkWritten(ctx context.Context) {
+	if route, ok := ctx.Value(readRouteContextKey{}).(*readRoute); ok {
+		route.primary.Store(true)
+	}
+}
+
+// ReadForWrite 쓰기 전 검증용 조회(version 확인 등)가 replica 지연으로 이전 데이터를 읽지 않도록 이후 읽기를 primary 로 보냅니다.
+// 요청의 읽기 경로가 있으면 그 경로를 primary 로 바꿔 같은 요청의 이후 읽기에도 적용합니다.
+func ReadForWrite(ctx context.Context) context.Context {
+	if route, ok := ctx.Value(readRouteContextKey{}).(*readRoute); ok {
+		route.primary.Store(true)
+		return ctx
+	}
+	return WithReadRoute(ctx, true)
+}
+
+// ReadFromPrimary replica 대신 primary 에서 읽어야 하는지 반환합니다.
+func ReadFromPrimary(ctx context.Context) bool {
+	route, ok := ctx.Value(readRouteContextKey{}).(*readRoute)
+	return ok && route.primary.Load()
+}
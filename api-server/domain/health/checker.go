@@ -0,0 +1,20 @@
+package health
+
+import "context"
+
+// Checker readiness 판단에 사용하는 의존성(DB, migration, background worker 등) 상태 확인
+type Checker interface {
+	Check(ctx context.Context) error
+}
+
+// CheckerFunc 함수를 Checker 로 사용합니다.
+type CheckerFunc func(ctx context.Context) error
+
+func (f CheckerFunc) Check(ctx context.Context) error {
+	return f(ctx)
+}
+
+type Dependency struct {
+	Name    string
+	Checker Checker
+}
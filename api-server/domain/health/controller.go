@@ -0,0 +1,9 @@
+//go:generate mockgen -source=controller.go -destination=../mock/mock_health_controller.go -package=mock
+package health
+
+import "github.com/gin-gonic/gin"
+
+type HealthController interface {
+	Healthz(ctx *gin.Context)
+	Readyz(ctx *gin.Context)
+}
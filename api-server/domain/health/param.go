@@ -0,0 +1,30 @@
+package health
+
+type LivenessResponse struct {
+	Status        string `json:"status"`
+	UptimeSeconds int64  `json:"uptime_seconds"`
+}
+
+type ReadinessResponse struct {
+	Status       string                      `json:"status"` // ready / not_ready
+	ShuttingDown bool                        `json:"shutting_down"`
+	Checks       map[string]DependencyStatus `json:"checks"`
+}
+
+func (r ReadinessResponse) Ready() bool {
+	return r.Status == StatusReady
+}
+
+type DependencyStatus struct {
+	Status    string `json:"status"` // up / down
+	Error     string `json:"error,omitempty"`
+	LatencyMs int64  `json:"latency_ms"`
+}
+
+const (
+	StatusAlive    = "alive"
+	StatusReady    = "ready"
+	StatusNotReady = "not_ready"
+	StatusUp       = "up"
+	StatusDown     = "down"
+)
@@ -0,0 +1,11 @@
+//go:generate mockgen -source=service.go -destination=../mock/mock_health_service.go -package=mock
+package health
+
+import "context"
+
+type HealthService interface {
+	Liveness(ctx context.Context) LivenessResponse
+	Readiness(ctx context.Context) ReadinessResponse
+	// StartShutdown graceful shutdown 시작 시 호출하며, 이후 readiness 는 항상 not_ready 입니다.
+	StartShutdown()
+}
@@ -0,0 +1,28 @@
+package idempotency
+
+import "time"
+
+// IdempotencyKey
+// Idempotency-Key 헤더로 전달된 요청의 응답을 보관합니다. 인증 주체별로 key 를 구분합니다.
+// StatusCode 가 nil 이면 첫 요청이 아직 처리 중이며, 처리 중인 key 는 짧은 만료일을 가져 비정상 종료 시에도 다시 사용할 수 있습니다.
+type IdempotencyKey struct {
+	PrincipalID  string    `gorm:"column:principal_id;type:varchar(255);not null;primaryKey;comment:인증 주체 ID"`
+	Key          string    `gorm:"column:idempotency_key;type:varchar(255);not null;primaryKey;comment:Idempotency-Key 헤더 값"`
+	Method       string    `gorm:"column:method;type:varchar(10);not null;comment:HTTP method"`
+	Path         string    `gorm:"column:path;type:varchar(1024);not null;comment:요청 경로"`
+	RequestHash  string    `gorm:"column:request_hash;type:char(64);not null;comment:method / 경로 / body SHA-256 해시"`
+	StatusCode   *int      `gorm:"column:status_code;type:smallint;comment:응답 HTTP status (처리 중이면 NULL)"`
+	ContentType  string    `gorm:"column:content_type;type:varchar(255);not null;default:'';comment:응답 Content-Type"`
+	ResponseBody []byte    `gorm:"column:response_body;type:mediumblob;comment:응답 body"`
+	CreatedAt    time.Time `gorm:"column:created_at;type:datetime(3);not null;comment:데이터 생성일"`
+	ExpiresAt    time.Time `gorm:"column:expires_at;type:datetime(3);not null;index;comment:만료일"`
+}
+
+func (i *IdempotencyKey) TableName() string {
+	return "idempotency_keys"
+}
+
+// Completed 첫 요청의 응답이 저장되었는지 확인합니다.
+func (i *IdempotencyKey) Completed() bool {
+	return i.StatusCode != nil
+}
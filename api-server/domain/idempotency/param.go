@@ -0,0 +1,19 @@
+package idempotency
+
+// BeginRequest
+// RequestHash 는 method / 경로 / body 로 계산하며, 같은 key 로 다른 요청을 보냈는지 확인하는 데 사용합니다.
+type BeginRequest struct {
+	PrincipalID string
+	Key         string
+	Method      string
+	Path        string
+	RequestHash string
+}
+
+type CompleteRequest struct {
+	PrincipalID  string
+	Key          string
+	StatusCode   int
+	ContentType  string
+	ResponseBody []byte
+}
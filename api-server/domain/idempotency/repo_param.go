@@ -0,0 +1,12 @@
+package idempotency
+
+import "time"
+
+type CompleteIdempotencyKeyParam struct {
+	PrincipalID  string
+	Key          string
+	StatusCode   int
+	ContentType  string
+	ResponseBody []byte
+	ExpiresAt    time.Time
+}
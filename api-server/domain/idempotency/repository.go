@@ -0,0 +1,20 @@
+//go:generate mockgen -source=repository.go -destination=../mock/mock_idempotency_repository.go -package=mock
+package idempotency
+
+import (
+	"context"
+	"time"
+)
+
+type IdempotencyRepository interface {
+	// CreateIdempotencyKey 이미 같은 key 가 있으면 Conflict 를 반환합니다.
+	CreateIdempotencyKey(ctx context.Context, model *IdempotencyKey) error
+	FindIdempotencyKey(ctx context.Context, principalID, key string) (*IdempotencyKey, error)
+	// CompleteIdempotencyKey 처리 중인 key 에 응답을 저장합니다.
+	CompleteIdempotencyKey(ctx context.Context, param CompleteIdempotencyKeyParam) error
+	DeleteIdempotencyKey(ctx context.Context, principalID, key string) error
+	// DeleteExpiredIdempotencyKey key 가 before 이전에 만료된 경우에만 삭제합니다. (삭제 여부 반환)
+	DeleteExpiredIdempotencyKey(ctx context.Context, principalID, key string, before time.Time) (bool, error)
+	// DeleteExpiredIdempotencyKeys before 이전에 만료된 key 를 삭제합니다.
+	DeleteExpiredIdempotencyKeys(ctx context.Context, before time.Time) (int64, error)
+}
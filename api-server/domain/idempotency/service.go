@@ -0,0 +1,17 @@
+//go:generate mockgen -source=service.go -destination=../mock/mock_idempotency_service.go -package=mock
+package idempotency
+
+import (
+	"context"
+	"time"
+)
+
+type IdempotencyService interface {
+	// Begin key 를 처리 중으로 등록합니다. 이미 응답이 저장된 key 이면 재사용할 응답을 반환합니다.
+	// 처리 중인 key 는 Conflict, 다른 요청에 사용된 key 는 IdempotencyKeyReused 를 반환합니다.
+	Begin(ctx context.Context, request BeginRequest) (*IdempotencyKey, error)
+	Complete(ctx context.Context, request CompleteRequest) error
+	// Release 처리에 실패한 요청의 key 를 삭제해 같은 key 로 다시 시도할 수 있게 합니다.
+	Release(ctx context.Context, principalID, key string) error
+	PurgeExpiredIdempotencyKeys(ctx context.Context, before time.Time) (int64, error)
+}
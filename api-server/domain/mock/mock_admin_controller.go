@@ -0,0 +1,53 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: controller.go
+//
+// Generated by this command:
+//
+//	mockgen -source=controller.go -destination=../mock/mock_admin_controller.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	reflect "reflect"
+
+	gin "github.com/gin-gonic/gin"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockAdminController is a mock of AdminController interface.
+type MockAdminController struct {
+	ctrl     *gomock.Controller
+	recorder *MockAdminControllerMockRecorder
+	isgomock struct{}
+}
+
+// MockAdminControllerMockRecorder is the mock recorder for MockAdminController.
+type MockAdminControllerMockRecorder struct {
+	mock *MockAdminController
+}
+
+// NewMockAdminController creates a new mock instance.
+func NewMockAdminController(ctrl *gomock.Controller) *MockAdminController {
+	mock := &MockAdminController{ctrl: ctrl}
+	mock.recorder = &MockAdminControllerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAdminController) EXPECT() *MockAdminControllerMockRecorder {
+	return m.recorder
+}
+
+// GetConfig mocks base method.
+func (m *MockAdminController) GetConfig(ctx *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "GetConfig", ctx)
+}
+
+// GetConfig indicates an expected call of GetConfig.
+func (mr *MockAdminControllerMockRecorder) GetConfig(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConfig", reflect.TypeOf((*MockAdminController)(nil).GetConfig), ctx)
+}
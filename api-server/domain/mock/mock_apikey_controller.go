@@ -0,0 +1,77 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: controller.go
+//
+// Generated by this command:
+//
+//	mockgen -source=controller.go -destination=../mock/mock_apikey_controller.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	reflect "reflect"
+
+	gin "github.com/gin-gonic/gin"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockAPIKeyController is a mock of APIKeyController interface.
+type MockAPIKeyController struct {
+	ctrl     *gomock.Controller
+	recorder *MockAPIKeyControllerMockRecorder
+	isgomock struct{}
+}
+
+// MockAPIKeyControllerMockRecorder is the mock recorder for MockAPIKeyController.
+type MockAPIKeyControllerMockRecorder struct {
+	mock *MockAPIKeyController
+}
+
+// NewMockAPIKeyController creates a new mock instance.
+func NewMockAPIKeyController(ctrl *gomock.Controller) *MockAPIKeyController {
+	mock := &MockAPIKeyController{ctrl: ctrl}
+	mock.recorder = &MockAPIKeyControllerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAPIKeyController) EXPECT() *MockAPIKeyControllerMockRecorder {
+	return m.recorder
+}
+
+// CreateAPIKey mocks base method.
+func (m *MockAPIKeyController) CreateAPIKey(ctx *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "CreateAPIKey", ctx)
+}
+
+// CreateAPIKey indicates an expected call of CreateAPIKey.
+func (mr *MockAPIKeyControllerMockRecorder) CreateAPIKey(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAPIKey", reflect.TypeOf((*MockAPIKeyController)(nil).CreateAPIKey), ctx)
+}
+
+// ListAPIKeys mocks base method.
+func (m *MockAPIKeyController) ListAPIKeys(ctx *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ListAPIKeys", ctx)
+}
+
+// ListAPIKeys indicates an expected call of ListAPIKeys.
+func (mr *MockAPIKeyControllerMockRecorder) ListAPIKeys(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAPIKeys", reflect.TypeOf((*MockAPIKeyController)(nil).ListAPIKeys), ctx)
+}
+
+// RevokeAPIKey mocks base method.
+func (m *MockAPIKeyController) RevokeAPIKey(ctx *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RevokeAPIKey", ctx)
+}
+
+// RevokeAPIKey indicates an expected call of RevokeAPIKey.
+func (mr *MockAPIKeyControllerMockRecorder) RevokeAPIKey(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeAPIKey", reflect.TypeOf((*MockAPIKeyController)(nil).RevokeAPIKey), ctx)
+}
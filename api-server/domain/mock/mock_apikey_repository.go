@@ -0,0 +1,116 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=repository.go -destination=../mock/mock_apikey_repository.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	apikey "aitrics-vital-signs/api-server/domain/apikey"
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockAPIKeyRepository is a mock of APIKeyRepository interface.
+type MockAPIKeyRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockAPIKeyRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockAPIKeyRepositoryMockRecorder is the mock recorder for MockAPIKeyRepository.
+type MockAPIKeyRepositoryMockRecorder struct {
+	mock *MockAPIKeyRepository
+}
+
+// NewMockAPIKeyRepository creates a new mock instance.
+func NewMockAPIKeyRepository(ctrl *gomock.Controller) *MockAPIKeyRepository {
+	mock := &MockAPIKeyRepository{ctrl: ctrl}
+	mock.recorder = &MockAPIKeyRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAPIKeyRepository) EXPECT() *MockAPIKeyRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateAPIKey mocks base method.
+func (m *MockAPIKeyRepository) CreateAPIKey(ctx context.Context, model *apikey.APIKey) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAPIKey", ctx, model)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateAPIKey indicates an expected call of CreateAPIKey.
+func (mr *MockAPIKeyRepositoryMockRecorder) CreateAPIKey(ctx, model any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAPIKey", reflect.TypeOf((*MockAPIKeyRepository)(nil).CreateAPIKey), ctx, model)
+}
+
+// FindAPIKeyByHash mocks base method.
+func (m *MockAPIKeyRepository) FindAPIKeyByHash(ctx context.Context, keyHash string) (*apikey.APIKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAPIKeyByHash", ctx, keyHash)
+	ret0, _ := ret[0].(*apikey.APIKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAPIKeyByHash indicates an expected call of FindAPIKeyByHash.
+func (mr *MockAPIKeyRepositoryMockRecorder) FindAPIKeyByHash(ctx, keyHash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAPIKeyByHash", reflect.TypeOf((*MockAPIKeyRepository)(nil).FindAPIKeyByHash), ctx, keyHash)
+}
+
+// FindAPIKeyByID mocks base method.
+func (m *MockAPIKeyRepository) FindAPIKeyByID(ctx context.Context, id string) (*apikey.APIKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAPIKeyByID", ctx, id)
+	ret0, _ := ret[0].(*apikey.APIKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAPIKeyByID indicates an expected call of FindAPIKeyByID.
+func (mr *MockAPIKeyRepositoryMockRecorder) FindAPIKeyByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAPIKeyByID", reflect.TypeOf((*MockAPIKeyRepository)(nil).FindAPIKeyByID), ctx, id)
+}
+
+// FindAPIKeys mocks base method.
+func (m *MockAPIKeyRepository) FindAPIKeys(ctx context.Context) ([]apikey.APIKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAPIKeys", ctx)
+	ret0, _ := ret[0].([]apikey.APIKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAPIKeys indicates an expected call of FindAPIKeys.
+func (mr *MockAPIKeyRepositoryMockRecorder) FindAPIKeys(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAPIKeys", reflect.TypeOf((*MockAPIKeyRepository)(nil).FindAPIKeys), ctx)
+}
+
+// RevokeAPIKey mocks base method.
+func (m *MockAPIKeyRepository) RevokeAPIKey(ctx context.Context, id string, revokedAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeAPIKey", ctx, id, revokedAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeAPIKey indicates an expected call of RevokeAPIKey.
+func (mr *MockAPIKeyRepositoryMockRecorder) RevokeAPIKey(ctx, id, revokedAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeAPIKey", reflect.TypeOf((*MockAPIKeyRepository)(nil).RevokeAPIKey), ctx, id, revokedAt)
+}
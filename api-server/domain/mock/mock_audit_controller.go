@@ -0,0 +1,65 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: controller.go
+//
+// Generated by this command:
+//
+//	mockgen -source=controller.go -destination=../mock/mock_audit_controller.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	reflect "reflect"
+
+	gin "github.com/gin-gonic/gin"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockAuditController is a mock of AuditController interface.
+type MockAuditController struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuditControllerMockRecorder
+	isgomock struct{}
+}
+
+// MockAuditControllerMockRecorder is the mock recorder for MockAuditController.
+type MockAuditControllerMockRecorder struct {
+	mock *MockAuditController
+}
+
+// NewMockAuditController creates a new mock instance.
+func NewMockAuditController(ctrl *gomock.Controller) *MockAuditController {
+	mock := &MockAuditController{ctrl: ctrl}
+	mock.recorder = &MockAuditControllerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuditController) EXPECT() *MockAuditControllerMockRecorder {
+	return m.recorder
+}
+
+// ExportAuditEvents mocks base method.
+func (m *MockAuditController) ExportAuditEvents(ctx *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ExportAuditEvents", ctx)
+}
+
+// ExportAuditEvents indicates an expected call of ExportAuditEvents.
+func (mr *MockAuditControllerMockRecorder) ExportAuditEvents(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportAuditEvents", reflect.TypeOf((*MockAuditController)(nil).ExportAuditEvents), ctx)
+}
+
+// GetAuditEvents mocks base method.
+func (m *MockAuditController) GetAuditEvents(ctx *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "GetAuditEvents", ctx)
+}
+
+// GetAuditEvents indicates an expected call of GetAuditEvents.
+func (mr *MockAuditControllerMockRecorder) GetAuditEvents(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAuditEvents", reflect.TypeOf((*MockAuditController)(nil).GetAuditEvents), ctx)
+}
@@ -0,0 +1,85 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=repository.go -destination=../mock/mock_audit_repository.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	audit "aitrics-vital-signs/api-server/domain/audit"
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockAuditRepository is a mock of AuditRepository interface.
+type MockAuditRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuditRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockAuditRepositoryMockRecorder is the mock recorder for MockAuditRepository.
+type MockAuditRepositoryMockRecorder struct {
+	mock *MockAuditRepository
+}
+
+// NewMockAuditRepository creates a new mock instance.
+func NewMockAuditRepository(ctrl *gomock.Controller) *MockAuditRepository {
+	mock := &MockAuditRepository{ctrl: ctrl}
+	mock.recorder = &MockAuditRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuditRepository) EXPECT() *MockAuditRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateAuditEvents mocks base method.
+func (m *MockAuditRepository) CreateAuditEvents(ctx context.Context, models []audit.AuditEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAuditEvents", ctx, models)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateAuditEvents indicates an expected call of CreateAuditEvents.
+func (mr *MockAuditRepositoryMockRecorder) CreateAuditEvents(ctx, models any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAuditEvents", reflect.TypeOf((*MockAuditRepository)(nil).CreateAuditEvents), ctx, models)
+}
+
+// FindAuditEvents mocks base method.
+func (m *MockAuditRepository) FindAuditEvents(ctx context.Context, param audit.FindAuditEventsParam) ([]audit.AuditEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAuditEvents", ctx, param)
+	ret0, _ := ret[0].([]audit.AuditEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAuditEvents indicates an expected call of FindAuditEvents.
+func (mr *MockAuditRepositoryMockRecorder) FindAuditEvents(ctx, param any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAuditEvents", reflect.TypeOf((*MockAuditRepository)(nil).FindAuditEvents), ctx, param)
+}
+
+// StreamAuditEvents mocks base method.
+func (m *MockAuditRepository) StreamAuditEvents(ctx context.Context, param audit.StreamAuditEventsParam, fn func(*audit.AuditEvent) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StreamAuditEvents", ctx, param, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StreamAuditEvents indicates an expected call of StreamAuditEvents.
+func (mr *MockAuditRepositoryMockRecorder) StreamAuditEvents(ctx, param, fn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamAuditEvents", reflect.TypeOf((*MockAuditRepository)(nil).StreamAuditEvents), ctx, param, fn)
+}
@@ -0,0 +1,71 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service.go
+//
+// Generated by this command:
+//
+//	mockgen -source=service.go -destination=../mock/mock_audit_service.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	audit "aitrics-vital-signs/api-server/domain/audit"
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockAuditService is a mock of AuditService interface.
+type MockAuditService struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuditServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockAuditServiceMockRecorder is the mock recorder for MockAuditService.
+type MockAuditServiceMockRecorder struct {
+	mock *MockAuditService
+}
+
+// NewMockAuditService creates a new mock instance.
+func NewMockAuditService(ctrl *gomock.Controller) *MockAuditService {
+	mock := &MockAuditService{ctrl: ctrl}
+	mock.recorder = &MockAuditServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuditService) EXPECT() *MockAuditServiceMockRecorder {
+	return m.recorder
+}
+
+// ExportAuditEvents mocks base method.
+func (m *MockAuditService) ExportAuditEvents(ctx context.Context, request audit.ExportAuditEventsRequest, fn func(*audit.AuditEvent) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportAuditEvents", ctx, request, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExportAuditEvents indicates an expected call of ExportAuditEvents.
+func (mr *MockAuditServiceMockRecorder) ExportAuditEvents(ctx, request, fn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportAuditEvents", reflect.TypeOf((*MockAuditService)(nil).ExportAuditEvents), ctx, request, fn)
+}
+
+// GetAuditEvents mocks base method.
+func (m *MockAuditService) GetAuditEvents(ctx context.Context, request audit.GetAuditEventsRequest) (*audit.GetAuditEventsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAuditEvents", ctx, request)
+	ret0, _ := ret[0].(*audit.GetAuditEventsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAuditEvents indicates an expected call of GetAuditEvents.
+func (mr *MockAuditServiceMockRecorder) GetAuditEvents(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAuditEvents", reflect.TypeOf((*MockAuditService)(nil).GetAuditEvents), ctx, request)
+}
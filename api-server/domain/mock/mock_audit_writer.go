@@ -0,0 +1,82 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: writer.go
+//
+// Generated by this command:
+//
+//	mockgen -source=writer.go -destination=../mock/mock_audit_writer.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	audit "aitrics-vital-signs/api-server/domain/audit"
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockAuditWriter is a mock of AuditWriter interface.
+type MockAuditWriter struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuditWriterMockRecorder
+	isgomock struct{}
+}
+
+// MockAuditWriterMockRecorder is the mock recorder for MockAuditWriter.
+type MockAuditWriterMockRecorder struct {
+	mock *MockAuditWriter
+}
+
+// NewMockAuditWriter creates a new mock instance.
+func NewMockAuditWriter(ctrl *gomock.Controller) *MockAuditWriter {
+	mock := &MockAuditWriter{ctrl: ctrl}
+	mock.recorder = &MockAuditWriterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuditWriter) EXPECT() *MockAuditWriterMockRecorder {
+	return m.recorder
+}
+
+// Check mocks base method.
+func (m *MockAuditWriter) Check(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Check", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Check indicates an expected call of Check.
+func (mr *MockAuditWriterMockRecorder) Check(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Check", reflect.TypeOf((*MockAuditWriter)(nil).Check), ctx)
+}
+
+// Close mocks base method.
+func (m *MockAuditWriter) Close(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Close", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockAuditWriterMockRecorder) Close(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockAuditWriter)(nil).Close), ctx)
+}
+
+// Write mocks base method.
+func (m *MockAuditWriter) Write(event audit.AuditEvent) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Write", event)
+}
+
+// Write indicates an expected call of Write.
+func (mr *MockAuditWriterMockRecorder) Write(event any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Write", reflect.TypeOf((*MockAuditWriter)(nil).Write), event)
+}
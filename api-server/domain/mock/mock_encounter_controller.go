@@ -0,0 +1,53 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: controller.go
+//
+// Generated by this command:
+//
+//	mockgen -source=controller.go -destination=../mock/mock_encounter_controller.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	reflect "reflect"
+
+	gin "github.com/gin-gonic/gin"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockEncounterController is a mock of EncounterController interface.
+type MockEncounterController struct {
+	ctrl     *gomock.Controller
+	recorder *MockEncounterControllerMockRecorder
+	isgomock struct{}
+}
+
+// MockEncounterControllerMockRecorder is the mock recorder for MockEncounterController.
+type MockEncounterControllerMockRecorder struct {
+	mock *MockEncounterController
+}
+
+// NewMockEncounterController creates a new mock instance.
+func NewMockEncounterController(ctrl *gomock.Controller) *MockEncounterController {
+	mock := &MockEncounterController{ctrl: ctrl}
+	mock.recorder = &MockEncounterControllerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEncounterController) EXPECT() *MockEncounterControllerMockRecorder {
+	return m.recorder
+}
+
+// GetPatientEncounters mocks base method.
+func (m *MockEncounterController) GetPatientEncounters(ctx *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "GetPatientEncounters", ctx)
+}
+
+// GetPatientEncounters indicates an expected call of GetPatientEncounters.
+func (mr *MockEncounterControllerMockRecorder) GetPatientEncounters(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPatientEncounters", reflect.TypeOf((*MockEncounterController)(nil).GetPatientEncounters), ctx)
+}
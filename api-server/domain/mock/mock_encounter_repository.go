@@ -0,0 +1,102 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=repository.go -destination=../mock/mock_encounter_repository.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	encounter "aitrics-vital-signs/api-server/domain/encounter"
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockEncounterRepository is a mock of EncounterRepository interface.
+type MockEncounterRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockEncounterRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockEncounterRepositoryMockRecorder is the mock recorder for MockEncounterRepository.
+type MockEncounterRepositoryMockRecorder struct {
+	mock *MockEncounterRepository
+}
+
+// NewMockEncounterRepository creates a new mock instance.
+func NewMockEncounterRepository(ctrl *gomock.Controller) *MockEncounterRepository {
+	mock := &MockEncounterRepository{ctrl: ctrl}
+	mock.recorder = &MockEncounterRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEncounterRepository) EXPECT() *MockEncounterRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindActiveEncounterByPatientID mocks base method.
+func (m *MockEncounterRepository) FindActiveEncounterByPatientID(ctx context.Context, patientID string) (*encounter.Encounter, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindActiveEncounterByPatientID", ctx, patientID)
+	ret0, _ := ret[0].(*encounter.Encounter)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindActiveEncounterByPatientID indicates an expected call of FindActiveEncounterByPatientID.
+func (mr *MockEncounterRepositoryMockRecorder) FindActiveEncounterByPatientID(ctx, patientID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindActiveEncounterByPatientID", reflect.TypeOf((*MockEncounterRepository)(nil).FindActiveEncounterByPatientID), ctx, patientID)
+}
+
+// FindEncounterByID mocks base method.
+func (m *MockEncounterRepository) FindEncounterByID(ctx context.Context, encounterID string) (*encounter.Encounter, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindEncounterByID", ctx, encounterID)
+	ret0, _ := ret[0].(*encounter.Encounter)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindEncounterByID indicates an expected call of FindEncounterByID.
+func (mr *MockEncounterRepositoryMockRecorder) FindEncounterByID(ctx, encounterID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindEncounterByID", reflect.TypeOf((*MockEncounterRepository)(nil).FindEncounterByID), ctx, encounterID)
+}
+
+// FindEncounterByPatientIDAndTime mocks base method.
+func (m *MockEncounterRepository) FindEncounterByPatientIDAndTime(ctx context.Context, param encounter.FindEncounterByPatientIDAndTimeParam) (*encounter.Encounter, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindEncounterByPatientIDAndTime", ctx, param)
+	ret0, _ := ret[0].(*encounter.Encounter)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindEncounterByPatientIDAndTime indicates an expected call of FindEncounterByPatientIDAndTime.
+func (mr *MockEncounterRepositoryMockRecorder) FindEncounterByPatientIDAndTime(ctx, param any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindEncounterByPatientIDAndTime", reflect.TypeOf((*MockEncounterRepository)(nil).FindEncounterByPatientIDAndTime), ctx, param)
+}
+
+// FindEncountersByPatientID mocks base method.
+func (m *MockEncounterRepository) FindEncountersByPatientID(ctx context.Context, patientID string) ([]encounter.Encounter, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindEncountersByPatientID", ctx, patientID)
+	ret0, _ := ret[0].([]encounter.Encounter)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindEncountersByPatientID indicates an expected call of FindEncountersByPatientID.
+func (mr *MockEncounterRepositoryMockRecorder) FindEncountersByPatientID(ctx, patientID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindEncountersByPatientID", reflect.TypeOf((*MockEncounterRepository)(nil).FindEncountersByPatientID), ctx, patientID)
+}
@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service.go
+//
+// Generated by this command:
+//
+//	mockgen -source=service.go -destination=../mock/mock_encounter_service.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	encounter "aitrics-vital-signs/api-server/domain/encounter"
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockEncounterService is a mock of EncounterService interface.
+type MockEncounterService struct {
+	ctrl     *gomock.Controller
+	recorder *MockEncounterServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockEncounterServiceMockRecorder is the mock recorder for MockEncounterService.
+type MockEncounterServiceMockRecorder struct {
+	mock *MockEncounterService
+}
+
+// NewMockEncounterService creates a new mock instance.
+func NewMockEncounterService(ctrl *gomock.Controller) *MockEncounterService {
+	mock := &MockEncounterService{ctrl: ctrl}
+	mock.recorder = &MockEncounterServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEncounterService) EXPECT() *MockEncounterServiceMockRecorder {
+	return m.recorder
+}
+
+// GetPatientEncounters mocks base method.
+func (m *MockEncounterService) GetPatientEncounters(ctx context.Context, patientID string) ([]encounter.EncounterResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPatientEncounters", ctx, patientID)
+	ret0, _ := ret[0].([]encounter.EncounterResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPatientEncounters indicates an expected call of GetPatientEncounters.
+func (mr *MockEncounterServiceMockRecorder) GetPatientEncounters(ctx, patientID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPatientEncounters", reflect.TypeOf((*MockEncounterService)(nil).GetPatientEncounters), ctx, patientID)
+}
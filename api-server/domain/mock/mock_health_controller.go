@@ -0,0 +1,65 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: controller.go
+//
+// Generated by this command:
+//
+//	mockgen -source=controller.go -destination=../mock/mock_health_controller.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	reflect "reflect"
+
+	gin "github.com/gin-gonic/gin"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockHealthController is a mock of HealthController interface.
+type MockHealthController struct {
+	ctrl     *gomock.Controller
+	recorder *MockHealthControllerMockRecorder
+	isgomock struct{}
+}
+
+// MockHealthControllerMockRecorder is the mock recorder for MockHealthController.
+type MockHealthControllerMockRecorder struct {
+	mock *MockHealthController
+}
+
+// NewMockHealthController creates a new mock instance.
+func NewMockHealthController(ctrl *gomock.Controller) *MockHealthController {
+	mock := &MockHealthController{ctrl: ctrl}
+	mock.recorder = &MockHealthControllerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockHealthController) EXPECT() *MockHealthControllerMockRecorder {
+	return m.recorder
+}
+
+// Healthz mocks base method.
+func (m *MockHealthController) Healthz(ctx *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Healthz", ctx)
+}
+
+// Healthz indicates an expected call of Healthz.
+func (mr *MockHealthControllerMockRecorder) Healthz(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Healthz", reflect.TypeOf((*MockHealthController)(nil).Healthz), ctx)
+}
+
+// Readyz mocks base method.
+func (m *MockHealthController) Readyz(ctx *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Readyz", ctx)
+}
+
+// Readyz indicates an expected call of Readyz.
+func (mr *MockHealthControllerMockRecorder) Readyz(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Readyz", reflect.TypeOf((*MockHealthController)(nil).Readyz), ctx)
+}
@@ -0,0 +1,82 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service.go
+//
+// Generated by this command:
+//
+//	mockgen -source=service.go -destination=../mock/mock_health_service.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	health "aitrics-vital-signs/api-server/domain/health"
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockHealthService is a mock of HealthService interface.
+type MockHealthService struct {
+	ctrl     *gomock.Controller
+	recorder *MockHealthServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockHealthServiceMockRecorder is the mock recorder for MockHealthService.
+type MockHealthServiceMockRecorder struct {
+	mock *MockHealthService
+}
+
+// NewMockHealthService creates a new mock instance.
+func NewMockHealthService(ctrl *gomock.Controller) *MockHealthService {
+	mock := &MockHealthService{ctrl: ctrl}
+	mock.recorder = &MockHealthServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockHealthService) EXPECT() *MockHealthServiceMockRecorder {
+	return m.recorder
+}
+
+// Liveness mocks base method.
+func (m *MockHealthService) Liveness(ctx context.Context) health.LivenessResponse {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Liveness", ctx)
+	ret0, _ := ret[0].(health.LivenessResponse)
+	return ret0
+}
+
+// Liveness indicates an expected call of Liveness.
+func (mr *MockHealthServiceMockRecorder) Liveness(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Liveness", reflect.TypeOf((*MockHealthService)(nil).Liveness), ctx)
+}
+
+// Readiness mocks base method.
+func (m *MockHealthService) Readiness(ctx context.Context) health.ReadinessResponse {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Readiness", ctx)
+	ret0, _ := ret[0].(health.ReadinessResponse)
+	return ret0
+}
+
+// Readiness indicates an expected call of Readiness.
+func (mr *MockHealthServiceMockRecorder) Readiness(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Readiness", reflect.TypeOf((*MockHealthService)(nil).Readiness), ctx)
+}
+
+// StartShutdown mocks base method.
+func (m *MockHealthService) StartShutdown() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "StartShutdown")
+}
+
+// StartShutdown indicates an expected call of StartShutdown.
+func (mr *MockHealthServiceMockRecorder) StartShutdown() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartShutdown", reflect.TypeOf((*MockHealthService)(nil).StartShutdown))
+}
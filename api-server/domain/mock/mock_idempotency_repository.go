@@ -0,0 +1,130 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=repository.go -destination=../mock/mock_idempotency_repository.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	idempotency "aitrics-vital-signs/api-server/domain/idempotency"
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIdempotencyRepository is a mock of IdempotencyRepository interface.
+type MockIdempotencyRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIdempotencyRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockIdempotencyRepositoryMockRecorder is the mock recorder for MockIdempotencyRepository.
+type MockIdempotencyRepositoryMockRecorder struct {
+	mock *MockIdempotencyRepository
+}
+
+// NewMockIdempotencyRepository creates a new mock instance.
+func NewMockIdempotencyRepository(ctrl *gomock.Controller) *MockIdempotencyRepository {
+	mock := &MockIdempotencyRepository{ctrl: ctrl}
+	mock.recorder = &MockIdempotencyRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIdempotencyRepository) EXPECT() *MockIdempotencyRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CompleteIdempotencyKey mocks base method.
+func (m *MockIdempotencyRepository) CompleteIdempotencyKey(ctx context.Context, param idempotency.CompleteIdempotencyKeyParam) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompleteIdempotencyKey", ctx, param)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CompleteIdempotencyKey indicates an expected call of CompleteIdempotencyKey.
+func (mr *MockIdempotencyRepositoryMockRecorder) CompleteIdempotencyKey(ctx, param any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteIdempotencyKey", reflect.TypeOf((*MockIdempotencyRepository)(nil).CompleteIdempotencyKey), ctx, param)
+}
+
+// CreateIdempotencyKey mocks base method.
+func (m *MockIdempotencyRepository) CreateIdempotencyKey(ctx context.Context, model *idempotency.IdempotencyKey) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateIdempotencyKey", ctx, model)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateIdempotencyKey indicates an expected call of CreateIdempotencyKey.
+func (mr *MockIdempotencyRepositoryMockRecorder) CreateIdempotencyKey(ctx, model any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateIdempotencyKey", reflect.TypeOf((*MockIdempotencyRepository)(nil).CreateIdempotencyKey), ctx, model)
+}
+
+// DeleteExpiredIdempotencyKey mocks base method.
+func (m *MockIdempotencyRepository) DeleteExpiredIdempotencyKey(ctx context.Context, principalID, key string, before time.Time) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteExpiredIdempotencyKey", ctx, principalID, key, before)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteExpiredIdempotencyKey indicates an expected call of DeleteExpiredIdempotencyKey.
+func (mr *MockIdempotencyRepositoryMockRecorder) DeleteExpiredIdempotencyKey(ctx, principalID, key, before any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteExpiredIdempotencyKey", reflect.TypeOf((*MockIdempotencyRepository)(nil).DeleteExpiredIdempotencyKey), ctx, principalID, key, before)
+}
+
+// DeleteExpiredIdempotencyKeys mocks base method.
+func (m *MockIdempotencyRepository) DeleteExpiredIdempotencyKeys(ctx context.Context, before time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteExpiredIdempotencyKeys", ctx, before)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteExpiredIdempotencyKeys indicates an expected call of DeleteExpiredIdempotencyKeys.
+func (mr *MockIdempotencyRepositoryMockRecorder) DeleteExpiredIdempotencyKeys(ctx, before any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteExpiredIdempotencyKeys", reflect.TypeOf((*MockIdempotencyRepository)(nil).DeleteExpiredIdempotencyKeys), ctx, before)
+}
+
+// DeleteIdempotencyKey mocks base method.
+func (m *MockIdempotencyRepository) DeleteIdempotencyKey(ctx context.Context, principalID, key string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteIdempotencyKey", ctx, principalID, key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteIdempotencyKey indicates an expected call of DeleteIdempotencyKey.
+func (mr *MockIdempotencyRepositoryMockRecorder) DeleteIdempotencyKey(ctx, principalID, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteIdempotencyKey", reflect.TypeOf((*MockIdempotencyRepository)(nil).DeleteIdempotencyKey), ctx, principalID, key)
+}
+
+// FindIdempotencyKey mocks base method.
+func (m *MockIdempotencyRepository) FindIdempotencyKey(ctx context.Context, principalID, key string) (*idempotency.IdempotencyKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindIdempotencyKey", ctx, principalID, key)
+	ret0, _ := ret[0].(*idempotency.IdempotencyKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindIdempotencyKey indicates an expected call of FindIdempotencyKey.
+func (mr *MockIdempotencyRepositoryMockRecorder) FindIdempotencyKey(ctx, principalID, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindIdempotencyKey", reflect.TypeOf((*MockIdempotencyRepository)(nil).FindIdempotencyKey), ctx, principalID, key)
+}
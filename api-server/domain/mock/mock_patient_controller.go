@@ -40,6 +40,18 @@ func (m *MockPatientController) EXPECT() *MockPatientControllerMockRecorder {
 	return m.recorder
 }
 
+// ChangePatientID mocks base method.
+func (m *MockPatientController) ChangePatientID(ctx *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ChangePatientID", ctx)
+}
+
+// ChangePatientID indicates an expected call of ChangePatientID.
+func (mr *MockPatientControllerMockRecorder) ChangePatientID(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangePatientID", reflect.TypeOf((*MockPatientController)(nil).ChangePatientID), ctx)
+}
+
 // CreatePatient mocks base method.
 func (m *MockPatientController) CreatePatient(ctx *gin.Context) {
 	m.ctrl.T.Helper()
@@ -52,6 +64,30 @@ func (mr *MockPatientControllerMockRecorder) CreatePatient(ctx any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePatient", reflect.TypeOf((*MockPatientController)(nil).CreatePatient), ctx)
 }
 
+// GetPatient mocks base method.
+func (m *MockPatientController) GetPatient(ctx *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "GetPatient", ctx)
+}
+
+// GetPatient indicates an expected call of GetPatient.
+func (mr *MockPatientControllerMockRecorder) GetPatient(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPatient", reflect.TypeOf((*MockPatientController)(nil).GetPatient), ctx)
+}
+
+// GetPatientMerges mocks base method.
+func (m *MockPatientController) GetPatientMerges(ctx *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "GetPatientMerges", ctx)
+}
+
+// GetPatientMerges indicates an expected call of GetPatientMerges.
+func (mr *MockPatientControllerMockRecorder) GetPatientMerges(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPatientMerges", reflect.TypeOf((*MockPatientController)(nil).GetPatientMerges), ctx)
+}
+
 // GetPatientVitals mocks base method.
 func (m *MockPatientController) GetPatientVitals(ctx *gin.Context) {
 	m.ctrl.T.Helper()
@@ -64,6 +100,30 @@ func (mr *MockPatientControllerMockRecorder) GetPatientVitals(ctx any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPatientVitals", reflect.TypeOf((*MockPatientController)(nil).GetPatientVitals), ctx)
 }
 
+// MergePatients mocks base method.
+func (m *MockPatientController) MergePatients(ctx *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "MergePatients", ctx)
+}
+
+// MergePatients indicates an expected call of MergePatients.
+func (mr *MockPatientControllerMockRecorder) MergePatients(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergePatients", reflect.TypeOf((*MockPatientController)(nil).MergePatients), ctx)
+}
+
+// RevertPatientMerge mocks base method.
+func (m *MockPatientController) RevertPatientMerge(ctx *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RevertPatientMerge", ctx)
+}
+
+// RevertPatientMerge indicates an expected call of RevertPatientMerge.
+func (mr *MockPatientControllerMockRecorder) RevertPatientMerge(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevertPatientMerge", reflect.TypeOf((*MockPatientController)(nil).RevertPatientMerge), ctx)
+}
+
 // UpdatePatient mocks base method.
 func (m *MockPatientController) UpdatePatient(ctx *gin.Context) {
 	m.ctrl.T.Helper()
@@ -13,6 +13,7 @@ import (
 	patient "aitrics-vital-signs/api-server/domain/patient"
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	gomock "go.uber.org/mock/gomock"
 )
@@ -41,6 +42,20 @@ func (m *MockPatientRepository) EXPECT() *MockPatientRepositoryMockRecorder {
 	return m.recorder
 }
 
+// ChangePatientID mocks base method.
+func (m *MockPatientRepository) ChangePatientID(ctx context.Context, param patient.ChangePatientIDParam) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangePatientID", ctx, param)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ChangePatientID indicates an expected call of ChangePatientID.
+func (mr *MockPatientRepositoryMockRecorder) ChangePatientID(ctx, param any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangePatientID", reflect.TypeOf((*MockPatientRepository)(nil).ChangePatientID), ctx, param)
+}
+
 // CreatePatient mocks base method.
 func (m *MockPatientRepository) CreatePatient(ctx context.Context, model *patient.Patient) error {
 	m.ctrl.T.Helper()
@@ -70,6 +85,96 @@ func (mr *MockPatientRepositoryMockRecorder) FindPatientByID(ctx, patientID any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPatientByID", reflect.TypeOf((*MockPatientRepository)(nil).FindPatientByID), ctx, patientID)
 }
 
+// FindPatientMergesByPatientID mocks base method.
+func (m *MockPatientRepository) FindPatientMergesByPatientID(ctx context.Context, patientID string) ([]patient.PatientMerge, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPatientMergesByPatientID", ctx, patientID)
+	ret0, _ := ret[0].([]patient.PatientMerge)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindPatientMergesByPatientID indicates an expected call of FindPatientMergesByPatientID.
+func (mr *MockPatientRepositoryMockRecorder) FindPatientMergesByPatientID(ctx, patientID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPatientMergesByPatientID", reflect.TypeOf((*MockPatientRepository)(nil).FindPatientMergesByPatientID), ctx, patientID)
+}
+
+// FindPatientsByPatientIDs mocks base method.
+func (m *MockPatientRepository) FindPatientsByPatientIDs(ctx context.Context, patientIDs []string) ([]patient.Patient, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPatientsByPatientIDs", ctx, patientIDs)
+	ret0, _ := ret[0].([]patient.Patient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindPatientsByPatientIDs indicates an expected call of FindPatientsByPatientIDs.
+func (mr *MockPatientRepositoryMockRecorder) FindPatientsByPatientIDs(ctx, patientIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPatientsByPatientIDs", reflect.TypeOf((*MockPatientRepository)(nil).FindPatientsByPatientIDs), ctx, patientIDs)
+}
+
+// MergePatients mocks base method.
+func (m *MockPatientRepository) MergePatients(ctx context.Context, param patient.MergePatientsParam) (*patient.PatientMerge, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MergePatients", ctx, param)
+	ret0, _ := ret[0].(*patient.PatientMerge)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MergePatients indicates an expected call of MergePatients.
+func (mr *MockPatientRepositoryMockRecorder) MergePatients(ctx, param any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergePatients", reflect.TypeOf((*MockPatientRepository)(nil).MergePatients), ctx, param)
+}
+
+// PurgeDeletedPatients mocks base method.
+func (m *MockPatientRepository) PurgeDeletedPatients(ctx context.Context, before time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeDeletedPatients", ctx, before)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeDeletedPatients indicates an expected call of PurgeDeletedPatients.
+func (mr *MockPatientRepositoryMockRecorder) PurgeDeletedPatients(ctx, before any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeDeletedPatients", reflect.TypeOf((*MockPatientRepository)(nil).PurgeDeletedPatients), ctx, before)
+}
+
+// ReplacePatient mocks base method.
+func (m *MockPatientRepository) ReplacePatient(ctx context.Context, model *patient.Patient) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplacePatient", ctx, model)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReplacePatient indicates an expected call of ReplacePatient.
+func (mr *MockPatientRepositoryMockRecorder) ReplacePatient(ctx, model any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplacePatient", reflect.TypeOf((*MockPatientRepository)(nil).ReplacePatient), ctx, model)
+}
+
+// RevertPatientMerge mocks base method.
+func (m *MockPatientRepository) RevertPatientMerge(ctx context.Context, param patient.RevertPatientMergeParam) (*patient.PatientMerge, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevertPatientMerge", ctx, param)
+	ret0, _ := ret[0].(*patient.PatientMerge)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevertPatientMerge indicates an expected call of RevertPatientMerge.
+func (mr *MockPatientRepositoryMockRecorder) RevertPatientMerge(ctx, param any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevertPatientMerge", reflect.TypeOf((*MockPatientRepository)(nil).RevertPatientMerge), ctx, param)
+}
+
 // UpdatePatient mocks base method.
 func (m *MockPatientRepository) UpdatePatient(ctx context.Context, model *patient.Patient) error {
 	m.ctrl.T.Helper()
@@ -11,8 +11,10 @@ package mock
 
 import (
 	patient "aitrics-vital-signs/api-server/domain/patient"
+	vital "aitrics-vital-signs/api-server/domain/vital"
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	gomock "go.uber.org/mock/gomock"
 )
@@ -41,6 +43,21 @@ func (m *MockPatientService) EXPECT() *MockPatientServiceMockRecorder {
 	return m.recorder
 }
 
+// ChangePatientID mocks base method.
+func (m *MockPatientService) ChangePatientID(ctx context.Context, patientID string, request patient.ChangePatientIDRequest) (*patient.PatientResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangePatientID", ctx, patientID, request)
+	ret0, _ := ret[0].(*patient.PatientResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChangePatientID indicates an expected call of ChangePatientID.
+func (mr *MockPatientServiceMockRecorder) ChangePatientID(ctx, patientID, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangePatientID", reflect.TypeOf((*MockPatientService)(nil).ChangePatientID), ctx, patientID, request)
+}
+
 // CreatePatient mocks base method.
 func (m *MockPatientService) CreatePatient(ctx context.Context, request patient.CreatePatientRequest) error {
 	m.ctrl.T.Helper()
@@ -55,6 +72,36 @@ func (mr *MockPatientServiceMockRecorder) CreatePatient(ctx, request any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePatient", reflect.TypeOf((*MockPatientService)(nil).CreatePatient), ctx, request)
 }
 
+// GetPatient mocks base method.
+func (m *MockPatientService) GetPatient(ctx context.Context, patientID string) (*patient.PatientResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPatient", ctx, patientID)
+	ret0, _ := ret[0].(*patient.PatientResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPatient indicates an expected call of GetPatient.
+func (mr *MockPatientServiceMockRecorder) GetPatient(ctx, patientID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPatient", reflect.TypeOf((*MockPatientService)(nil).GetPatient), ctx, patientID)
+}
+
+// GetPatientMerges mocks base method.
+func (m *MockPatientService) GetPatientMerges(ctx context.Context, patientID string) (*patient.GetPatientMergesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPatientMerges", ctx, patientID)
+	ret0, _ := ret[0].(*patient.GetPatientMergesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPatientMerges indicates an expected call of GetPatientMerges.
+func (mr *MockPatientServiceMockRecorder) GetPatientMerges(ctx, patientID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPatientMerges", reflect.TypeOf((*MockPatientService)(nil).GetPatientMerges), ctx, patientID)
+}
+
 // GetPatientVitals mocks base method.
 func (m *MockPatientService) GetPatientVitals(ctx context.Context, patientID string, request patient.GetPatientVitalsRequest) (*patient.GetPatientVitalsResponse, error) {
 	m.ctrl.T.Helper()
@@ -70,6 +117,80 @@ func (mr *MockPatientServiceMockRecorder) GetPatientVitals(ctx, patientID, reque
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPatientVitals", reflect.TypeOf((*MockPatientService)(nil).GetPatientVitals), ctx, patientID, request)
 }
 
+// MergePatients mocks base method.
+func (m *MockPatientService) MergePatients(ctx context.Context, targetPatientID string, request patient.MergePatientsRequest) (*patient.PatientMergeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MergePatients", ctx, targetPatientID, request)
+	ret0, _ := ret[0].(*patient.PatientMergeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MergePatients indicates an expected call of MergePatients.
+func (mr *MockPatientServiceMockRecorder) MergePatients(ctx, targetPatientID, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergePatients", reflect.TypeOf((*MockPatientService)(nil).MergePatients), ctx, targetPatientID, request)
+}
+
+// PurgeDeletedPatients mocks base method.
+func (m *MockPatientService) PurgeDeletedPatients(ctx context.Context, before time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeDeletedPatients", ctx, before)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeDeletedPatients indicates an expected call of PurgeDeletedPatients.
+func (mr *MockPatientServiceMockRecorder) PurgeDeletedPatients(ctx, before any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeDeletedPatients", reflect.TypeOf((*MockPatientService)(nil).PurgeDeletedPatients), ctx, before)
+}
+
+// ReplacePatient mocks base method.
+func (m *MockPatientService) ReplacePatient(ctx context.Context, patientID string, request patient.UpdatePatientRequest) (*patient.ReplacePatientResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplacePatient", ctx, patientID, request)
+	ret0, _ := ret[0].(*patient.ReplacePatientResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReplacePatient indicates an expected call of ReplacePatient.
+func (mr *MockPatientServiceMockRecorder) ReplacePatient(ctx, patientID, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplacePatient", reflect.TypeOf((*MockPatientService)(nil).ReplacePatient), ctx, patientID, request)
+}
+
+// RevertPatientMerge mocks base method.
+func (m *MockPatientService) RevertPatientMerge(ctx context.Context, targetPatientID, mergeID string) (*patient.PatientMergeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevertPatientMerge", ctx, targetPatientID, mergeID)
+	ret0, _ := ret[0].(*patient.PatientMergeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevertPatientMerge indicates an expected call of RevertPatientMerge.
+func (mr *MockPatientServiceMockRecorder) RevertPatientMerge(ctx, targetPatientID, mergeID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevertPatientMerge", reflect.TypeOf((*MockPatientService)(nil).RevertPatientMerge), ctx, targetPatientID, mergeID)
+}
+
+// StreamPatientVitals mocks base method.
+func (m *MockPatientService) StreamPatientVitals(ctx context.Context, patientID string, request patient.GetPatientVitalsRequest, fn func(*vital.Vital) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StreamPatientVitals", ctx, patientID, request, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StreamPatientVitals indicates an expected call of StreamPatientVitals.
+func (mr *MockPatientServiceMockRecorder) StreamPatientVitals(ctx, patientID, request, fn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamPatientVitals", reflect.TypeOf((*MockPatientService)(nil).StreamPatientVitals), ctx, patientID, request, fn)
+}
+
 // UpdatePatient mocks base method.
 func (m *MockPatientService) UpdatePatient(ctx context.Context, patientID string, request patient.UpdatePatientRequest) error {
 	m.ctrl.T.Helper()
@@ -40,6 +40,30 @@ func (m *MockVitalController) EXPECT() *MockVitalControllerMockRecorder {
 	return m.recorder
 }
 
+// ExportVitals mocks base method.
+func (m *MockVitalController) ExportVitals(ctx *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ExportVitals", ctx)
+}
+
+// ExportVitals indicates an expected call of ExportVitals.
+func (mr *MockVitalControllerMockRecorder) ExportVitals(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportVitals", reflect.TypeOf((*MockVitalController)(nil).ExportVitals), ctx)
+}
+
+// GetVital mocks base method.
+func (m *MockVitalController) GetVital(ctx *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "GetVital", ctx)
+}
+
+// GetVital indicates an expected call of GetVital.
+func (mr *MockVitalControllerMockRecorder) GetVital(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVital", reflect.TypeOf((*MockVitalController)(nil).GetVital), ctx)
+}
+
 // UpsertVital mocks base method.
 func (m *MockVitalController) UpsertVital(ctx *gin.Context) {
 	m.ctrl.T.Helper()
@@ -13,6 +13,7 @@ import (
 	vital "aitrics-vital-signs/api-server/domain/vital"
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	gomock "go.uber.org/mock/gomock"
 )
@@ -41,18 +42,33 @@ func (m *MockVitalRepository) EXPECT() *MockVitalRepositoryMockRecorder {
 	return m.recorder
 }
 
-// CreateVital mocks base method.
-func (m *MockVitalRepository) CreateVital(ctx context.Context, model *vital.Vital) error {
+// CreateVitals mocks base method.
+func (m *MockVitalRepository) CreateVitals(ctx context.Context, models []*vital.Vital) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateVital", ctx, model)
+	ret := m.ctrl.Call(m, "CreateVitals", ctx, models)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// CreateVital indicates an expected call of CreateVital.
-func (mr *MockVitalRepositoryMockRecorder) CreateVital(ctx, model any) *gomock.Call {
+// CreateVitals indicates an expected call of CreateVitals.
+func (mr *MockVitalRepositoryMockRecorder) CreateVitals(ctx, models any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVital", reflect.TypeOf((*MockVitalRepository)(nil).CreateVital), ctx, model)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVitals", reflect.TypeOf((*MockVitalRepository)(nil).CreateVitals), ctx, models)
+}
+
+// FindLatestVitalsByPatientIDs mocks base method.
+func (m *MockVitalRepository) FindLatestVitalsByPatientIDs(ctx context.Context, param vital.FindLatestVitalsByPatientIDsParam) ([]vital.Vital, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindLatestVitalsByPatientIDs", ctx, param)
+	ret0, _ := ret[0].([]vital.Vital)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindLatestVitalsByPatientIDs indicates an expected call of FindLatestVitalsByPatientIDs.
+func (mr *MockVitalRepositoryMockRecorder) FindLatestVitalsByPatientIDs(ctx, param any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindLatestVitalsByPatientIDs", reflect.TypeOf((*MockVitalRepository)(nil).FindLatestVitalsByPatientIDs), ctx, param)
 }
 
 // FindVitalByPatientIDAndRecordedAtAndVitalType mocks base method.
@@ -85,16 +101,46 @@ func (mr *MockVitalRepositoryMockRecorder) FindVitalsByPatientIDAndDateRange(ctx
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindVitalsByPatientIDAndDateRange", reflect.TypeOf((*MockVitalRepository)(nil).FindVitalsByPatientIDAndDateRange), ctx, param)
 }
 
-// UpdateVital mocks base method.
-func (m *MockVitalRepository) UpdateVital(ctx context.Context, model *vital.Vital) error {
+// PurgeDeletedVitals mocks base method.
+func (m *MockVitalRepository) PurgeDeletedVitals(ctx context.Context, before time.Time) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateVital", ctx, model)
+	ret := m.ctrl.Call(m, "PurgeDeletedVitals", ctx, before)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeDeletedVitals indicates an expected call of PurgeDeletedVitals.
+func (mr *MockVitalRepositoryMockRecorder) PurgeDeletedVitals(ctx, before any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeDeletedVitals", reflect.TypeOf((*MockVitalRepository)(nil).PurgeDeletedVitals), ctx, before)
+}
+
+// StreamVitalsByPatientIDsAndDateRange mocks base method.
+func (m *MockVitalRepository) StreamVitalsByPatientIDsAndDateRange(ctx context.Context, param vital.StreamVitalsByPatientIDsAndDateRangeParam, fn func(*vital.Vital) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StreamVitalsByPatientIDsAndDateRange", ctx, param, fn)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// UpdateVital indicates an expected call of UpdateVital.
-func (mr *MockVitalRepositoryMockRecorder) UpdateVital(ctx, model any) *gomock.Call {
+// StreamVitalsByPatientIDsAndDateRange indicates an expected call of StreamVitalsByPatientIDsAndDateRange.
+func (mr *MockVitalRepositoryMockRecorder) StreamVitalsByPatientIDsAndDateRange(ctx, param, fn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamVitalsByPatientIDsAndDateRange", reflect.TypeOf((*MockVitalRepository)(nil).StreamVitalsByPatientIDsAndDateRange), ctx, param, fn)
+}
+
+// UpsertVital mocks base method.
+func (m *MockVitalRepository) UpsertVital(ctx context.Context, param vital.UpsertVitalParam) (vital.UpsertResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertVital", ctx, param)
+	ret0, _ := ret[0].(vital.UpsertResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertVital indicates an expected call of UpsertVital.
+func (mr *MockVitalRepositoryMockRecorder) UpsertVital(ctx, param any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVital", reflect.TypeOf((*MockVitalRepository)(nil).UpdateVital), ctx, model)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertVital", reflect.TypeOf((*MockVitalRepository)(nil).UpsertVital), ctx, param)
 }
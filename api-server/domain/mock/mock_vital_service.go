@@ -13,6 +13,7 @@ import (
 	vital "aitrics-vital-signs/api-server/domain/vital"
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	gomock "go.uber.org/mock/gomock"
 )
@@ -41,14 +42,59 @@ func (m *MockVitalService) EXPECT() *MockVitalServiceMockRecorder {
 	return m.recorder
 }
 
-// UpsertVital mocks base method.
-func (m *MockVitalService) UpsertVital(ctx context.Context, request vital.UpsertVitalRequest) error {
+// ExportVitals mocks base method.
+func (m *MockVitalService) ExportVitals(ctx context.Context, request vital.ExportVitalsRequest, fn func(*vital.Vital) error) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpsertVital", ctx, request)
+	ret := m.ctrl.Call(m, "ExportVitals", ctx, request, fn)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
+// ExportVitals indicates an expected call of ExportVitals.
+func (mr *MockVitalServiceMockRecorder) ExportVitals(ctx, request, fn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportVitals", reflect.TypeOf((*MockVitalService)(nil).ExportVitals), ctx, request, fn)
+}
+
+// GetVital mocks base method.
+func (m *MockVitalService) GetVital(ctx context.Context, request vital.GetVitalRequest) (*vital.VitalResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVital", ctx, request)
+	ret0, _ := ret[0].(*vital.VitalResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVital indicates an expected call of GetVital.
+func (mr *MockVitalServiceMockRecorder) GetVital(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVital", reflect.TypeOf((*MockVitalService)(nil).GetVital), ctx, request)
+}
+
+// PurgeDeletedVitals mocks base method.
+func (m *MockVitalService) PurgeDeletedVitals(ctx context.Context, before time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeDeletedVitals", ctx, before)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeDeletedVitals indicates an expected call of PurgeDeletedVitals.
+func (mr *MockVitalServiceMockRecorder) PurgeDeletedVitals(ctx, before any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeDeletedVitals", reflect.TypeOf((*MockVitalService)(nil).PurgeDeletedVitals), ctx, before)
+}
+
+// UpsertVital mocks base method.
+func (m *MockVitalService) UpsertVital(ctx context.Context, request vital.UpsertVitalRequest) (*vital.UpsertVitalResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertVital", ctx, request)
+	ret0, _ := ret[0].(*vital.UpsertVitalResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
 // UpsertVital indicates an expected call of UpsertVital.
 func (mr *MockVitalServiceMockRecorder) UpsertVital(ctx, request any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
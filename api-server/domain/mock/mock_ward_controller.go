@@ -0,0 +1,137 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: controller.go
+//
+// Generated by this command:
+//
+//	mockgen -source=controller.go -destination=../mock/mock_ward_controller.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	reflect "reflect"
+
+	gin "github.com/gin-gonic/gin"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockWardController is a mock of WardController interface.
+type MockWardController struct {
+	ctrl     *gomock.Controller
+	recorder *MockWardControllerMockRecorder
+	isgomock struct{}
+}
+
+// MockWardControllerMockRecorder is the mock recorder for MockWardController.
+type MockWardControllerMockRecorder struct {
+	mock *MockWardController
+}
+
+// NewMockWardController creates a new mock instance.
+func NewMockWardController(ctrl *gomock.Controller) *MockWardController {
+	mock := &MockWardController{ctrl: ctrl}
+	mock.recorder = &MockWardControllerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWardController) EXPECT() *MockWardControllerMockRecorder {
+	return m.recorder
+}
+
+// AdmitPatient mocks base method.
+func (m *MockWardController) AdmitPatient(ctx *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "AdmitPatient", ctx)
+}
+
+// AdmitPatient indicates an expected call of AdmitPatient.
+func (mr *MockWardControllerMockRecorder) AdmitPatient(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AdmitPatient", reflect.TypeOf((*MockWardController)(nil).AdmitPatient), ctx)
+}
+
+// CreateBed mocks base method.
+func (m *MockWardController) CreateBed(ctx *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "CreateBed", ctx)
+}
+
+// CreateBed indicates an expected call of CreateBed.
+func (mr *MockWardControllerMockRecorder) CreateBed(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBed", reflect.TypeOf((*MockWardController)(nil).CreateBed), ctx)
+}
+
+// CreateWard mocks base method.
+func (m *MockWardController) CreateWard(ctx *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "CreateWard", ctx)
+}
+
+// CreateWard indicates an expected call of CreateWard.
+func (mr *MockWardControllerMockRecorder) CreateWard(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWard", reflect.TypeOf((*MockWardController)(nil).CreateWard), ctx)
+}
+
+// DischargePatient mocks base method.
+func (m *MockWardController) DischargePatient(ctx *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "DischargePatient", ctx)
+}
+
+// DischargePatient indicates an expected call of DischargePatient.
+func (mr *MockWardControllerMockRecorder) DischargePatient(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DischargePatient", reflect.TypeOf((*MockWardController)(nil).DischargePatient), ctx)
+}
+
+// GetPatientBedAssignments mocks base method.
+func (m *MockWardController) GetPatientBedAssignments(ctx *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "GetPatientBedAssignments", ctx)
+}
+
+// GetPatientBedAssignments indicates an expected call of GetPatientBedAssignments.
+func (mr *MockWardControllerMockRecorder) GetPatientBedAssignments(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPatientBedAssignments", reflect.TypeOf((*MockWardController)(nil).GetPatientBedAssignments), ctx)
+}
+
+// GetWardPatients mocks base method.
+func (m *MockWardController) GetWardPatients(ctx *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "GetWardPatients", ctx)
+}
+
+// GetWardPatients indicates an expected call of GetWardPatients.
+func (mr *MockWardControllerMockRecorder) GetWardPatients(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWardPatients", reflect.TypeOf((*MockWardController)(nil).GetWardPatients), ctx)
+}
+
+// ListWards mocks base method.
+func (m *MockWardController) ListWards(ctx *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ListWards", ctx)
+}
+
+// ListWards indicates an expected call of ListWards.
+func (mr *MockWardControllerMockRecorder) ListWards(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWards", reflect.TypeOf((*MockWardController)(nil).ListWards), ctx)
+}
+
+// TransferPatient mocks base method.
+func (m *MockWardController) TransferPatient(ctx *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "TransferPatient", ctx)
+}
+
+// TransferPatient indicates an expected call of TransferPatient.
+func (mr *MockWardControllerMockRecorder) TransferPatient(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransferPatient", reflect.TypeOf((*MockWardController)(nil).TransferPatient), ctx)
+}
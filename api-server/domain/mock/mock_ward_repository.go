@@ -0,0 +1,233 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=repository.go -destination=../mock/mock_ward_repository.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	encounter "aitrics-vital-signs/api-server/domain/encounter"
+	ward "aitrics-vital-signs/api-server/domain/ward"
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockWardRepository is a mock of WardRepository interface.
+type MockWardRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockWardRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockWardRepositoryMockRecorder is the mock recorder for MockWardRepository.
+type MockWardRepositoryMockRecorder struct {
+	mock *MockWardRepository
+}
+
+// NewMockWardRepository creates a new mock instance.
+func NewMockWardRepository(ctrl *gomock.Controller) *MockWardRepository {
+	mock := &MockWardRepository{ctrl: ctrl}
+	mock.recorder = &MockWardRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWardRepository) EXPECT() *MockWardRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateBed mocks base method.
+func (m *MockWardRepository) CreateBed(ctx context.Context, model *ward.Bed) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateBed", ctx, model)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateBed indicates an expected call of CreateBed.
+func (mr *MockWardRepositoryMockRecorder) CreateBed(ctx, model any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBed", reflect.TypeOf((*MockWardRepository)(nil).CreateBed), ctx, model)
+}
+
+// CreateBedAssignment mocks base method.
+func (m *MockWardRepository) CreateBedAssignment(ctx context.Context, model *ward.BedAssignment, newEncounter *encounter.Encounter) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateBedAssignment", ctx, model, newEncounter)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateBedAssignment indicates an expected call of CreateBedAssignment.
+func (mr *MockWardRepositoryMockRecorder) CreateBedAssignment(ctx, model, newEncounter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBedAssignment", reflect.TypeOf((*MockWardRepository)(nil).CreateBedAssignment), ctx, model, newEncounter)
+}
+
+// CreateWard mocks base method.
+func (m *MockWardRepository) CreateWard(ctx context.Context, model *ward.Ward) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWard", ctx, model)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateWard indicates an expected call of CreateWard.
+func (mr *MockWardRepositoryMockRecorder) CreateWard(ctx, model any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWard", reflect.TypeOf((*MockWardRepository)(nil).CreateWard), ctx, model)
+}
+
+// FindActiveBedAssignmentByBedID mocks base method.
+func (m *MockWardRepository) FindActiveBedAssignmentByBedID(ctx context.Context, bedID string) (*ward.BedAssignment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindActiveBedAssignmentByBedID", ctx, bedID)
+	ret0, _ := ret[0].(*ward.BedAssignment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindActiveBedAssignmentByBedID indicates an expected call of FindActiveBedAssignmentByBedID.
+func (mr *MockWardRepositoryMockRecorder) FindActiveBedAssignmentByBedID(ctx, bedID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindActiveBedAssignmentByBedID", reflect.TypeOf((*MockWardRepository)(nil).FindActiveBedAssignmentByBedID), ctx, bedID)
+}
+
+// FindActiveBedAssignmentByPatientID mocks base method.
+func (m *MockWardRepository) FindActiveBedAssignmentByPatientID(ctx context.Context, patientID string) (*ward.BedAssignment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindActiveBedAssignmentByPatientID", ctx, patientID)
+	ret0, _ := ret[0].(*ward.BedAssignment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindActiveBedAssignmentByPatientID indicates an expected call of FindActiveBedAssignmentByPatientID.
+func (mr *MockWardRepositoryMockRecorder) FindActiveBedAssignmentByPatientID(ctx, patientID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindActiveBedAssignmentByPatientID", reflect.TypeOf((*MockWardRepository)(nil).FindActiveBedAssignmentByPatientID), ctx, patientID)
+}
+
+// FindActiveBedAssignmentsByWardID mocks base method.
+func (m *MockWardRepository) FindActiveBedAssignmentsByWardID(ctx context.Context, wardID string) ([]ward.BedAssignment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindActiveBedAssignmentsByWardID", ctx, wardID)
+	ret0, _ := ret[0].([]ward.BedAssignment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindActiveBedAssignmentsByWardID indicates an expected call of FindActiveBedAssignmentsByWardID.
+func (mr *MockWardRepositoryMockRecorder) FindActiveBedAssignmentsByWardID(ctx, wardID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindActiveBedAssignmentsByWardID", reflect.TypeOf((*MockWardRepository)(nil).FindActiveBedAssignmentsByWardID), ctx, wardID)
+}
+
+// FindBedAssignmentsByPatientID mocks base method.
+func (m *MockWardRepository) FindBedAssignmentsByPatientID(ctx context.Context, patientID string) ([]ward.BedAssignment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindBedAssignmentsByPatientID", ctx, patientID)
+	ret0, _ := ret[0].([]ward.BedAssignment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindBedAssignmentsByPatientID indicates an expected call of FindBedAssignmentsByPatientID.
+func (mr *MockWardRepositoryMockRecorder) FindBedAssignmentsByPatientID(ctx, patientID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindBedAssignmentsByPatientID", reflect.TypeOf((*MockWardRepository)(nil).FindBedAssignmentsByPatientID), ctx, patientID)
+}
+
+// FindBedByID mocks base method.
+func (m *MockWardRepository) FindBedByID(ctx context.Context, bedID string) (*ward.Bed, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindBedByID", ctx, bedID)
+	ret0, _ := ret[0].(*ward.Bed)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindBedByID indicates an expected call of FindBedByID.
+func (mr *MockWardRepositoryMockRecorder) FindBedByID(ctx, bedID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindBedByID", reflect.TypeOf((*MockWardRepository)(nil).FindBedByID), ctx, bedID)
+}
+
+// FindBedsByWardID mocks base method.
+func (m *MockWardRepository) FindBedsByWardID(ctx context.Context, wardID string) ([]ward.Bed, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindBedsByWardID", ctx, wardID)
+	ret0, _ := ret[0].([]ward.Bed)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindBedsByWardID indicates an expected call of FindBedsByWardID.
+func (mr *MockWardRepositoryMockRecorder) FindBedsByWardID(ctx, wardID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindBedsByWardID", reflect.TypeOf((*MockWardRepository)(nil).FindBedsByWardID), ctx, wardID)
+}
+
+// FindWardByID mocks base method.
+func (m *MockWardRepository) FindWardByID(ctx context.Context, wardID string) (*ward.Ward, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindWardByID", ctx, wardID)
+	ret0, _ := ret[0].(*ward.Ward)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindWardByID indicates an expected call of FindWardByID.
+func (mr *MockWardRepositoryMockRecorder) FindWardByID(ctx, wardID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindWardByID", reflect.TypeOf((*MockWardRepository)(nil).FindWardByID), ctx, wardID)
+}
+
+// FindWards mocks base method.
+func (m *MockWardRepository) FindWards(ctx context.Context) ([]ward.Ward, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindWards", ctx)
+	ret0, _ := ret[0].([]ward.Ward)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindWards indicates an expected call of FindWards.
+func (mr *MockWardRepositoryMockRecorder) FindWards(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindWards", reflect.TypeOf((*MockWardRepository)(nil).FindWards), ctx)
+}
+
+// ReleaseBedAssignment mocks base method.
+func (m *MockWardRepository) ReleaseBedAssignment(ctx context.Context, model *ward.BedAssignment, closedEncounter *encounter.Encounter) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseBedAssignment", ctx, model, closedEncounter)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReleaseBedAssignment indicates an expected call of ReleaseBedAssignment.
+func (mr *MockWardRepositoryMockRecorder) ReleaseBedAssignment(ctx, model, closedEncounter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseBedAssignment", reflect.TypeOf((*MockWardRepository)(nil).ReleaseBedAssignment), ctx, model, closedEncounter)
+}
+
+// TransferBedAssignment mocks base method.
+func (m *MockWardRepository) TransferBedAssignment(ctx context.Context, current, next *ward.BedAssignment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransferBedAssignment", ctx, current, next)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TransferBedAssignment indicates an expected call of TransferBedAssignment.
+func (mr *MockWardRepositoryMockRecorder) TransferBedAssignment(ctx, current, next any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransferBedAssignment", reflect.TypeOf((*MockWardRepository)(nil).TransferBedAssignment), ctx, current, next)
+}
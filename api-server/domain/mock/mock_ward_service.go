@@ -0,0 +1,162 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service.go
+//
+// Generated by this command:
+//
+//	mockgen -source=service.go -destination=../mock/mock_ward_service.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	ward "aitrics-vital-signs/api-server/domain/ward"
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockWardService is a mock of WardService interface.
+type MockWardService struct {
+	ctrl     *gomock.Controller
+	recorder *MockWardServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockWardServiceMockRecorder is the mock recorder for MockWardService.
+type MockWardServiceMockRecorder struct {
+	mock *MockWardService
+}
+
+// NewMockWardService creates a new mock instance.
+func NewMockWardService(ctrl *gomock.Controller) *MockWardService {
+	mock := &MockWardService{ctrl: ctrl}
+	mock.recorder = &MockWardServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWardService) EXPECT() *MockWardServiceMockRecorder {
+	return m.recorder
+}
+
+// AdmitPatient mocks base method.
+func (m *MockWardService) AdmitPatient(ctx context.Context, patientID string, request ward.AssignBedRequest) (*ward.BedAssignmentResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AdmitPatient", ctx, patientID, request)
+	ret0, _ := ret[0].(*ward.BedAssignmentResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AdmitPatient indicates an expected call of AdmitPatient.
+func (mr *MockWardServiceMockRecorder) AdmitPatient(ctx, patientID, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AdmitPatient", reflect.TypeOf((*MockWardService)(nil).AdmitPatient), ctx, patientID, request)
+}
+
+// CreateBed mocks base method.
+func (m *MockWardService) CreateBed(ctx context.Context, wardID string, request ward.CreateBedRequest) (*ward.BedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateBed", ctx, wardID, request)
+	ret0, _ := ret[0].(*ward.BedResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateBed indicates an expected call of CreateBed.
+func (mr *MockWardServiceMockRecorder) CreateBed(ctx, wardID, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBed", reflect.TypeOf((*MockWardService)(nil).CreateBed), ctx, wardID, request)
+}
+
+// CreateWard mocks base method.
+func (m *MockWardService) CreateWard(ctx context.Context, request ward.CreateWardRequest) (*ward.WardResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWard", ctx, request)
+	ret0, _ := ret[0].(*ward.WardResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateWard indicates an expected call of CreateWard.
+func (mr *MockWardServiceMockRecorder) CreateWard(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWard", reflect.TypeOf((*MockWardService)(nil).CreateWard), ctx, request)
+}
+
+// DischargePatient mocks base method.
+func (m *MockWardService) DischargePatient(ctx context.Context, patientID string) (*ward.BedAssignmentResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DischargePatient", ctx, patientID)
+	ret0, _ := ret[0].(*ward.BedAssignmentResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DischargePatient indicates an expected call of DischargePatient.
+func (mr *MockWardServiceMockRecorder) DischargePatient(ctx, patientID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DischargePatient", reflect.TypeOf((*MockWardService)(nil).DischargePatient), ctx, patientID)
+}
+
+// GetPatientBedAssignments mocks base method.
+func (m *MockWardService) GetPatientBedAssignments(ctx context.Context, patientID string) ([]ward.BedAssignmentResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPatientBedAssignments", ctx, patientID)
+	ret0, _ := ret[0].([]ward.BedAssignmentResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPatientBedAssignments indicates an expected call of GetPatientBedAssignments.
+func (mr *MockWardServiceMockRecorder) GetPatientBedAssignments(ctx, patientID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPatientBedAssignments", reflect.TypeOf((*MockWardService)(nil).GetPatientBedAssignments), ctx, patientID)
+}
+
+// GetWardPatients mocks base method.
+func (m *MockWardService) GetWardPatients(ctx context.Context, wardID string) (*ward.GetWardPatientsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWardPatients", ctx, wardID)
+	ret0, _ := ret[0].(*ward.GetWardPatientsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWardPatients indicates an expected call of GetWardPatients.
+func (mr *MockWardServiceMockRecorder) GetWardPatients(ctx, wardID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWardPatients", reflect.TypeOf((*MockWardService)(nil).GetWardPatients), ctx, wardID)
+}
+
+// ListWards mocks base method.
+func (m *MockWardService) ListWards(ctx context.Context) ([]ward.WardResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWards", ctx)
+	ret0, _ := ret[0].([]ward.WardResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWards indicates an expected call of ListWards.
+func (mr *MockWardServiceMockRecorder) ListWards(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWards", reflect.TypeOf((*MockWardService)(nil).ListWards), ctx)
+}
+
+// TransferPatient mocks base method.
+func (m *MockWardService) TransferPatient(ctx context.Context, patientID string, request ward.AssignBedRequest) (*ward.BedAssignmentResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransferPatient", ctx, patientID, request)
+	ret0, _ := ret[0].(*ward.BedAssignmentResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TransferPatient indicates an expected call of TransferPatient.
+func (mr *MockWardServiceMockRecorder) TransferPatient(ctx, patientID, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransferPatient", reflect.TypeOf((*MockWardService)(nil).TransferPatient), ctx, patientID, request)
+}
@@ -5,6 +5,11 @@ import "github.com/gin-gonic/gin"
 
 type PatientController interface {
 	CreatePatient(ctx *gin.Context)
+	GetPatient(ctx *gin.Context)
 	UpdatePatient(ctx *gin.Context)
 	GetPatientVitals(ctx *gin.Context)
+	MergePatients(ctx *gin.Context)
+	RevertPatientMerge(ctx *gin.Context)
+	GetPatientMerges(ctx *gin.Context)
+	ChangePatientID(ctx *gin.Context)
 }
@@ -8,11 +8,11 @@ import (
 
 type Patient struct {
 	ID        string         `gorm:"column:id;type:char(36);primaryKey;comment:PK"`
-	PatientID string         `gorm:"column:patient_id;type:varchar(20);not null;uniqueIndex;comment:외부 환자 ID"`
-	Name      string         `gorm:"column:name;type:varchar(50);not null;comment:환자 이름"`
+	PatientID string         `gorm:"column:patient_id;type:varchar(20);not null;uniqueIndex;comment:외부 환자 ID" phi:"hash"`
+	Name      string         `gorm:"column:name;type:varchar(50);not null;comment:환자 이름" phi:"mask"`
 	Gender    string         `gorm:"column:gender;type:enum('M','F');not null;comment:성별"`
-	BirthDate time.Time      `gorm:"column:birth_date;type:date;not null;comment:생년월일"`
-	Version   int            `gorm:"column:version;not null;default:1;comment:버전"`
+	BirthDate time.Time      `gorm:"column:birth_date;type:date;not null;comment:생년월일" phi:"mask"`
+	Version   int            `gorm:"column:version;type:bigint;not null;default:1;comment:버전"`
 	CreatedAt time.Time      `gorm:"column:created_at;type:datetime(3);not null;comment:데이터 생성일"`
 	UpdatedAt *time.Time     `gorm:"column:updated_at;type:datetime(3);comment:데이터 수정일"`
 	DeletedAt gorm.DeletedAt `gorm:"column:deleted_at;type:datetime(3);comment:데이터 삭제일"`
@@ -21,3 +21,38 @@ type Patient struct {
 func (p *Patient) TableName() string {
 	return "patients"
 }
+
+// PatientMerge
+// source 환자의 vital 을 target 환자로 병합한 기록입니다.
+// 환자는 PK(ref) 로 연결하므로 병합 이후 patient_id 가 변경되어도 되돌릴 수 있습니다.
+type PatientMerge struct {
+	ID               string     `gorm:"column:id;type:char(36);primaryKey;comment:PK"`
+	SourcePatientRef string     `gorm:"column:source_patient_ref;type:char(36);not null;index;comment:source 환자 PK"`
+	TargetPatientRef string     `gorm:"column:target_patient_ref;type:char(36);not null;index;comment:target 환자 PK"`
+	SourcePatientID  string     `gorm:"column:source_patient_id;type:varchar(20);not null;comment:병합 시점의 source 외부 환자 ID" phi:"hash"`
+	TargetPatientID  string     `gorm:"column:target_patient_id;type:varchar(20);not null;comment:병합 시점의 target 외부 환자 ID" phi:"hash"`
+	CollisionPolicy  string     `gorm:"column:collision_policy;type:varchar(20);not null;comment:충돌 처리 정책"`
+	MovedCount       int        `gorm:"column:moved_count;type:int;not null;comment:이동한 vital 수"`
+	CollisionCount   int        `gorm:"column:collision_count;type:int;not null;comment:충돌한 vital 수"`
+	MergedAt         time.Time  `gorm:"column:merged_at;type:datetime(3);not null;comment:병합일"`
+	RevertedAt       *time.Time `gorm:"column:reverted_at;type:datetime(3);comment:되돌린 일시"`
+}
+
+func (p *PatientMerge) TableName() string {
+	return "patient_merges"
+}
+
+// PatientMergeVital 병합 시 처리한 source vital 과 교체 전 target 값 (되돌리기용)
+type PatientMergeVital struct {
+	MergeID             string     `gorm:"column:merge_id;type:char(36);not null;primaryKey;comment:병합 ID"`
+	RecordedAt          time.Time  `gorm:"column:recorded_at;type:datetime(3);not null;primaryKey;comment:레코드 기록일"`
+	VitalType           string     `gorm:"column:vital_type;type:enum('HR','RR','SBP','DBP','SpO2','BT');not null;primaryKey;comment:바이탈 유형"`
+	Action              string     `gorm:"column:action;type:varchar(20);not null;comment:처리 결과 (moved / kept_target / replaced)"`
+	PreviousValue       *float64   `gorm:"column:previous_value;type:double;comment:교체 전 target 값"`
+	PreviousEncounterID *string    `gorm:"column:previous_encounter_id;type:char(36);comment:교체 전 target encounter ID"`
+	PreviousDeletedAt   *time.Time `gorm:"column:previous_deleted_at;type:datetime(3);comment:교체 전 target 삭제일"`
+}
+
+func (p *PatientMergeVital) TableName() string {
+	return "patient_merge_vitals"
+}
@@ -3,27 +3,66 @@ package patient
 import "time"
 
 type CreatePatientRequest struct {
-	PatientID string `json:"patientId" binding:"required"`
-	Name      string `json:"name" binding:"required"`
+	PatientID string `json:"patientId" binding:"required" phi:"hash"`
+	Name      string `json:"name" binding:"required" phi:"mask"`
 	Gender    string `json:"gender" binding:"required,oneof=M F"`
-	BirthDate string `json:"birthDate" binding:"required,datetime=2006-01-02"`
+	BirthDate string `json:"birthDate" binding:"required,datetime=2006-01-02" phi:"mask"`
 }
 
 type UpdatePatientRequest struct {
-	Name      string `json:"name" binding:"required"`
+	Name      string `json:"name" binding:"required" phi:"mask"`
 	Gender    string `json:"gender" binding:"required,oneof=M F"`
-	BirthDate string `json:"birthDate" binding:"required,datetime=2006-01-02"`
-	Version   int    `json:"version" binding:"required,min=1"`
+	BirthDate string `json:"birthDate" binding:"required,datetime=2006-01-02" phi:"mask"`
+	Version   int    `json:"version" binding:"omitempty,min=1"` // If-Match 헤더 사용 시 생략 가능
+}
+
+type PatientResponse struct {
+	PatientID string `json:"patientId" phi:"hash"`
+	Name      string `json:"name" phi:"mask"`
+	Gender    string `json:"gender"`
+	BirthDate string `json:"birthDate" phi:"mask"`
+	Version   int    `json:"version"`
+}
+
+type ReplacePatientResponse struct {
+	PatientResponse
+	Created bool `json:"created"` // 신규 등록 여부 (false 이면 기존 환자를 교체)
+}
+
+type MergePatientsRequest struct {
+	SourcePatientID string `json:"sourcePatientId" binding:"required" phi:"hash"`
+	CollisionPolicy string `json:"collisionPolicy" binding:"omitempty,oneof=reject keep_target keep_source"` // 생략 시 reject
+}
+
+type PatientMergeResponse struct {
+	MergeID         string     `json:"mergeId"`
+	SourcePatientID string     `json:"sourcePatientId" phi:"hash"` // 병합 시점의 외부 환자 ID
+	TargetPatientID string     `json:"targetPatientId" phi:"hash"`
+	CollisionPolicy string     `json:"collisionPolicy"`
+	MovedCount      int        `json:"movedCount"`
+	CollisionCount  int        `json:"collisionCount"`
+	MergedAt        time.Time  `json:"mergedAt"`
+	RevertedAt      *time.Time `json:"revertedAt,omitempty"`
+}
+
+type GetPatientMergesResponse struct {
+	Items []PatientMergeResponse `json:"items"`
+}
+
+type ChangePatientIDRequest struct {
+	NewPatientID string `json:"newPatientId" binding:"required,max=20" phi:"hash"`
+	Version      int    `json:"version" binding:"omitempty,min=1"` // If-Match 헤더 사용 시 생략 가능
 }
 
 type GetPatientVitalsRequest struct {
-	From       string   `form:"from" binding:"required"` // RFC3339 format
-	To         string   `form:"to" binding:"required"`   // RFC3339 format
-	VitalTypes []string `form:"vital_types" binding:"omitempty,dive,oneof=HR RR SBP DBP SpO2 BT"`
+	From        string   `form:"from" binding:"required_without=EncounterID"` // RFC3339 format
+	To          string   `form:"to" binding:"required_without=EncounterID"`   // RFC3339 format
+	VitalTypes  []string `form:"vital_types" binding:"omitempty,dive,oneof=HR RR SBP DBP SpO2 BT"`
+	EncounterID string   `form:"encounter_id"` // from / to 생략 시 encounter 기간 전체
 }
 
 type GetPatientVitalsResponse struct {
-	PatientID string                         `json:"patient_id"`
+	PatientID string                         `json:"patient_id" phi:"hash"`
 	Items     map[string][]VitalItemResponse `json:"items"`
 }
 
@@ -31,4 +70,5 @@ type VitalItemResponse struct {
 	VitalType  string    `json:"vital_type"`
 	RecordedAt time.Time `json:"recorded_at"`
 	Value      float64   `json:"value"`
+	Version    int       `json:"version"`
 }
@@ -1 +1,26 @@
 package patient
+
+import "time"
+
+type MergePatientsParam struct {
+	MergeID         string
+	SourcePatientID string
+	TargetPatientID string
+	CollisionPolicy string
+	Now             time.Time
+}
+
+type RevertPatientMergeParam struct {
+	MergeID         string
+	TargetPatientID string // 요청 경로의 환자 (병합 기록의 target 과 같아야 함)
+	Now             time.Time
+}
+
+// ChangePatientIDParam
+// Version 은 클라이언트가 알고 있는 환자 version 이며, 일치하는 경우에만 변경됩니다.
+type ChangePatientIDParam struct {
+	PatientID    string
+	NewPatientID string
+	Version      int
+	Now          time.Time
+}
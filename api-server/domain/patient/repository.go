@@ -1,10 +1,26 @@
 //go:generate mockgen -source=repository.go -destination=../mock/mock_patient_repository.go -package=mock
 package patient
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
 type PatientRepository interface {
 	CreatePatient(ctx context.Context, model *Patient) error
 	FindPatientByID(ctx context.Context, patientID string) (*Patient, error)
+	FindPatientsByPatientIDs(ctx context.Context, patientIDs []string) ([]Patient, error)
 	UpdatePatient(ctx context.Context, model *Patient) error
+	// ReplacePatient patient_id 기준으로 등록하거나 교체하며, 신규 등록 여부를 반환합니다.
+	ReplacePatient(ctx context.Context, model *Patient) (bool, error)
+	// MergePatients source 환자의 vital 을 target 으로 옮기고 source 를 soft delete 합니다. (Transactor 트랜잭션 안에서 호출)
+	MergePatients(ctx context.Context, param MergePatientsParam) (*PatientMerge, error)
+	// RevertPatientMerge 병합을 되돌려 source 환자와 vital 을 복원합니다. (Transactor 트랜잭션 안에서 호출)
+	RevertPatientMerge(ctx context.Context, param RevertPatientMergeParam) (*PatientMerge, error)
+	// FindPatientMergesByPatientID 환자가 source 또는 target 인 병합 기록 (최근 순)
+	FindPatientMergesByPatientID(ctx context.Context, patientID string) ([]PatientMerge, error)
+	// ChangePatientID 환자와 관련 vital / encounter / 병상 배정의 patient_id 를 변경합니다. (Transactor 트랜잭션 안에서 호출)
+	ChangePatientID(ctx context.Context, param ChangePatientIDParam) error
+	// PurgeDeletedPatients before 이전에 soft delete 된 환자를 영구 삭제합니다.
+	PurgeDeletedPatients(ctx context.Context, before time.Time) (int64, error)
 }
@@ -2,11 +2,22 @@
 package patient
 
 import (
+	"aitrics-vital-signs/api-server/domain/vital"
 	"context"
+	"time"
 )
 
 type PatientService interface {
 	CreatePatient(ctx context.Context, request CreatePatientRequest) error
+	GetPatient(ctx context.Context, patientID string) (*PatientResponse, error)
 	UpdatePatient(ctx context.Context, patientID string, request UpdatePatientRequest) error
+	// ReplacePatient version 없이 환자를 등록하거나 요청 값으로 교체합니다. (EHR 동기화용 PUT)
+	ReplacePatient(ctx context.Context, patientID string, request UpdatePatientRequest) (*ReplacePatientResponse, error)
+	MergePatients(ctx context.Context, targetPatientID string, request MergePatientsRequest) (*PatientMergeResponse, error)
+	RevertPatientMerge(ctx context.Context, targetPatientID, mergeID string) (*PatientMergeResponse, error)
+	GetPatientMerges(ctx context.Context, patientID string) (*GetPatientMergesResponse, error)
+	ChangePatientID(ctx context.Context, patientID string, request ChangePatientIDRequest) (*PatientResponse, error)
 	GetPatientVitals(ctx context.Context, patientID string, request GetPatientVitalsRequest) (*GetPatientVitalsResponse, error)
+	StreamPatientVitals(ctx context.Context, patientID string, request GetPatientVitalsRequest, fn func(*vital.Vital) error) error
+	PurgeDeletedPatients(ctx context.Context, before time.Time) (int64, error)
 }
@@ -5,4 +5,6 @@ import "github.com/gin-gonic/gin"
 
 type VitalController interface {
 	UpsertVital(ctx *gin.Context)
-}
\ No newline at end of file
+	GetVital(ctx *gin.Context)
+	ExportVitals(ctx *gin.Context)
+}
@@ -3,9 +3,41 @@ package vital
 import "time"
 
 type UpsertVitalRequest struct {
-	PatientID  string    `json:"patient_id" binding:"required"`
-	RecordedAt time.Time `json:"recorded_at" binding:"required"`
-	VitalType  string    `json:"vital_type" binding:"required,oneof=HR RR SBP DBP SpO2 BT"`
-	Value      float64   `json:"value" binding:"required"`
-	Version    int       `json:"version" binding:"required,min=1"`
+	PatientID   string    `json:"patient_id" binding:"required" phi:"hash"`
+	RecordedAt  time.Time `json:"recorded_at" binding:"required"`
+	VitalType   string    `json:"vital_type" binding:"required,oneof=HR RR SBP DBP SpO2 BT"`
+	Value       float64   `json:"value" binding:"required"`
+	Version     int       `json:"version" binding:"omitempty,min=1"` // If-Match 헤더 사용 시 생략 가능
+	EncounterID string    `json:"encounter_id"`                      // 생략 시 recorded_at 을 포함하는 encounter 에 자동 연결
+	// 생략 시 API key 의 기본 정책, 없으면 strict (strict 외의 정책은 version 을 사용하지 않음)
+	ConflictPolicy string `json:"conflict_policy" binding:"omitempty,oneof=strict last_write_wins keep_first max_of min_of"`
+}
+
+type UpsertVitalResponse struct {
+	ConflictPolicy string  `json:"conflict_policy"` // 적용된 정책
+	Result         string  `json:"result"`          // inserted / updated / unchanged
+	Value          float64 `json:"value"`           // 저장되어 있는 값 (unchanged 인 경우 기존 값)
+	Attempts       int     `json:"attempts"`        // 충돌 재시도를 포함한 저장 시도 횟수
+}
+
+type GetVitalRequest struct {
+	PatientID  string    `uri:"patient_id" binding:"required" phi:"hash"`
+	VitalType  string    `uri:"vital_type" binding:"required,oneof=HR RR SBP DBP SpO2 BT"`
+	RecordedAt time.Time `uri:"recorded_at" binding:"required"` // RFC3339 format
+}
+
+type VitalResponse struct {
+	PatientID   string    `json:"patient_id" phi:"hash"`
+	VitalType   string    `json:"vital_type"`
+	RecordedAt  time.Time `json:"recorded_at"`
+	Value       float64   `json:"value"`
+	EncounterID *string   `json:"encounter_id"`
+	Version     int       `json:"version"`
+}
+
+type ExportVitalsRequest struct {
+	PatientIDs []string `form:"patient_ids" binding:"required,min=1,max=100,dive,required"`
+	From       string   `form:"from" binding:"required"` // RFC3339 format
+	To         string   `form:"to" binding:"required"`   // RFC3339 format
+	VitalTypes []string `form:"vital_types" binding:"omitempty,dive,oneof=HR RR SBP DBP SpO2 BT"`
 }
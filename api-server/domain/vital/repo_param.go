@@ -9,8 +9,62 @@ type FindVitalByPatientIDAndRecordedAtAndVitalTypeParam struct {
 }
 
 type FindVitalsByPatientIDAndDateRangeParam struct {
-	PatientID  string
-	From       time.Time
-	To         time.Time
-	VitalTypes []string
+	PatientID   string
+	From        time.Time
+	To          time.Time
+	VitalTypes  []string
+	EncounterID string
+}
+
+// FindLatestVitalsByPatientIDsParam
+// 환자별 진행 중인 encounter 에서 From 이후 기록된 vital 만 대상으로 합니다.
+type FindLatestVitalsByPatientIDsParam struct {
+	PatientIDs   []string
+	EncounterIDs []string
+	From         time.Time
+}
+
+type StreamVitalsByPatientIDsAndDateRangeParam struct {
+	PatientIDs  []string
+	From        time.Time
+	To          time.Time
+	VitalTypes  []string
+	EncounterID string
+}
+
+// UpsertVitalParam
+// Version 은 클라이언트가 알고 있는 version 이며, 1 인 경우에만 신규 저장됩니다.
+type UpsertVitalParam struct {
+	PatientID   string
+	RecordedAt  time.Time
+	VitalType   string
+	Value       float64
+	Version     int
+	EncounterID *string
+	// RelinkEncounter false 이면 기존 데이터에 이미 연결된 encounter 는 유지합니다.
+	RelinkEncounter bool
+	// InsertOnly true 이면 신규 저장만 수행하고, 이미 존재하는 데이터는 수정하지 않습니다. (Conflict)
+	InsertOnly bool
+	Now        time.Time
+}
+
+type UpsertResult int
+
+const (
+	UpsertInserted UpsertResult = iota + 1
+	UpsertUpdated
+	UpsertUnchanged // conflict_policy 에 의해 기존 값을 유지
+)
+
+func (u UpsertResult) String() string {
+	switch u {
+	case UpsertInserted:
+		return "inserted"
+	case UpsertUpdated:
+		return "updated"
+	case UpsertUnchanged:
+		return "unchanged"
+	default:
+		return ""
+	}
 }
@@ -3,11 +3,19 @@ package vital
 
 import (
 	"context"
+	"time"
 )
 
 type VitalRepository interface {
 	FindVitalByPatientIDAndRecordedAtAndVitalType(ctx context.Context, param FindVitalByPatientIDAndRecordedAtAndVitalTypeParam) (*Vital, error)
 	FindVitalsByPatientIDAndDateRange(ctx context.Context, param FindVitalsByPatientIDAndDateRangeParam) ([]Vital, error)
-	CreateVital(ctx context.Context, model *Vital) error
-	UpdateVital(ctx context.Context, model *Vital) error
+	StreamVitalsByPatientIDsAndDateRange(ctx context.Context, param StreamVitalsByPatientIDsAndDateRangeParam, fn func(*Vital) error) error
+	FindLatestVitalsByPatientIDs(ctx context.Context, param FindLatestVitalsByPatientIDsParam) ([]Vital, error)
+	// UpsertVital 신규 저장 또는 version 이 일치하는 경우의 수정을 조회 없이 원자적으로 수행합니다.
+	// version 불일치는 Conflict, version 이 1 이 아닌데 데이터가 없으면 NotFound 를 반환합니다.
+	// InsertOnly 인 경우 이미 데이터가 있으면 Conflict 를 반환합니다.
+	UpsertVital(ctx context.Context, param UpsertVitalParam) (UpsertResult, error)
+	CreateVitals(ctx context.Context, models []*Vital) error
+	// PurgeDeletedVitals before 이전에 soft delete 된 vital 을 영구 삭제합니다.
+	PurgeDeletedVitals(ctx context.Context, before time.Time) (int64, error)
 }
@@ -1,8 +1,14 @@
 //go:generate mockgen -source=service.go -destination=../mock/mock_vital_service.go -package=mock
 package vital
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
 type VitalService interface {
-	UpsertVital(ctx context.Context, request UpsertVitalRequest) error
+	UpsertVital(ctx context.Context, request UpsertVitalRequest) (*UpsertVitalResponse, error)
+	GetVital(ctx context.Context, request GetVitalRequest) (*VitalResponse, error)
+	ExportVitals(ctx context.Context, request ExportVitalsRequest, fn func(*Vital) error) error
+	PurgeDeletedVitals(ctx context.Context, before time.Time) (int64, error)
 }
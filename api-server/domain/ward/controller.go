@@ -0,0 +1,15 @@
+//go:generate mockgen -source=controller.go -destination=../mock/mock_ward_controller.go -package=mock
+package ward
+
+import "github.com/gin-gonic/gin"
+
+type WardController interface {
+	CreateWard(ctx *gin.Context)
+	ListWards(ctx *gin.Context)
+	CreateBed(ctx *gin.Context)
+	GetWardPatients(ctx *gin.Context)
+	AdmitPatient(ctx *gin.Context)
+	TransferPatient(ctx *gin.Context)
+	DischargePatient(ctx *gin.Context)
+	GetPatientBedAssignments(ctx *gin.Context)
+}
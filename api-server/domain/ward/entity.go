@@ -0,0 +1,52 @@
+package ward
+
+import (
+	"time"
+)
+
+type Ward struct {
+	ID        string     `gorm:"column:id;type:char(36);primaryKey;comment:PK"`
+	Code      string     `gorm:"column:code;type:varchar(20);not null;uniqueIndex;comment:병동 코드"`
+	Name      string     `gorm:"column:name;type:varchar(100);not null;comment:병동 이름"`
+	CreatedAt time.Time  `gorm:"column:created_at;type:datetime(3);not null;comment:데이터 생성일"`
+	UpdatedAt *time.Time `gorm:"column:updated_at;type:datetime(3);comment:데이터 수정일"`
+}
+
+func (w *Ward) TableName() string {
+	return "wards"
+}
+
+type Bed struct {
+	ID        string     `gorm:"column:id;type:char(36);primaryKey;comment:PK"`
+	WardID    string     `gorm:"column:ward_id;type:char(36);not null;uniqueIndex:idx_beds_ward_id_label,priority:1;comment:병동 ID"`
+	Label     string     `gorm:"column:label;type:varchar(20);not null;uniqueIndex:idx_beds_ward_id_label,priority:2;comment:병상 표시명"`
+	CreatedAt time.Time  `gorm:"column:created_at;type:datetime(3);not null;comment:데이터 생성일"`
+	UpdatedAt *time.Time `gorm:"column:updated_at;type:datetime(3);comment:데이터 수정일"`
+}
+
+func (b *Bed) TableName() string {
+	return "beds"
+}
+
+// BedAssignment
+// 환자의 병상 배정 이력. released_at 이 NULL 인 row 가 현재 위치입니다.
+// active_bed_id / active_patient_id 는 배정 중에만 값을 가지며, unique index 로 병상·환자당 하나의 활성 배정만 허용합니다.
+type BedAssignment struct {
+	ID              string     `gorm:"column:id;type:char(36);primaryKey;comment:PK"`
+	PatientID       string     `gorm:"column:patient_id;type:varchar(20);not null;index;comment:외부 환자 ID" phi:"hash"`
+	WardID          string     `gorm:"column:ward_id;type:char(36);not null;index;comment:병동 ID"`
+	BedID           string     `gorm:"column:bed_id;type:char(36);not null;comment:병상 ID"`
+	EncounterID     string     `gorm:"column:encounter_id;type:char(36);not null;index;comment:encounter ID"`
+	ActiveBedID     *string    `gorm:"column:active_bed_id;type:char(36);uniqueIndex;comment:배정 중인 병상 ID"`
+	ActivePatientID *string    `gorm:"column:active_patient_id;type:varchar(20);uniqueIndex;comment:배정 중인 환자 ID" phi:"hash"`
+	AssignReason    string     `gorm:"column:assign_reason;type:enum('ADMIT','TRANSFER');not null;comment:배정 사유"`
+	ReleaseReason   *string    `gorm:"column:release_reason;type:enum('TRANSFER','DISCHARGE');comment:해제 사유"`
+	AssignedAt      time.Time  `gorm:"column:assigned_at;type:datetime(3);not null;comment:배정일"`
+	ReleasedAt      *time.Time `gorm:"column:released_at;type:datetime(3);comment:해제일"`
+	CreatedAt       time.Time  `gorm:"column:created_at;type:datetime(3);not null;comment:데이터 생성일"`
+	UpdatedAt       *time.Time `gorm:"column:updated_at;type:datetime(3);comment:데이터 수정일"`
+}
+
+func (b *BedAssignment) TableName() string {
+	return "bed_assignments"
+}
@@ -0,0 +1,62 @@
+package ward
+
+import "time"
+
+type CreateWardRequest struct {
+	Code string `json:"code" binding:"required,max=20"`
+	Name string `json:"name" binding:"required,max=100"`
+}
+
+type CreateBedRequest struct {
+	Label string `json:"label" binding:"required,max=20"`
+}
+
+type AssignBedRequest struct {
+	BedID         string `json:"bed_id" binding:"required"`
+	EncounterType string `json:"encounter_type" binding:"omitempty,oneof=INPATIENT EMERGENCY OBSERVATION"` // 입원 시에만 사용 (기본값 INPATIENT)
+}
+
+type WardResponse struct {
+	ID   string `json:"id"`
+	Code string `json:"code"`
+	Name string `json:"name"`
+}
+
+type BedResponse struct {
+	ID     string `json:"id"`
+	WardID string `json:"ward_id"`
+	Label  string `json:"label"`
+}
+
+type BedAssignmentResponse struct {
+	ID            string     `json:"id"`
+	PatientID     string     `json:"patient_id" phi:"hash"`
+	WardID        string     `json:"ward_id"`
+	BedID         string     `json:"bed_id"`
+	EncounterID   string     `json:"encounter_id"`
+	AssignReason  string     `json:"assign_reason"`
+	ReleaseReason *string    `json:"release_reason"`
+	AssignedAt    time.Time  `json:"assigned_at"`
+	ReleasedAt    *time.Time `json:"released_at"`
+}
+
+type GetWardPatientsResponse struct {
+	WardID string                `json:"ward_id"`
+	Items  []WardPatientResponse `json:"items"`
+}
+
+type WardPatientResponse struct {
+	BedID          string                         `json:"bed_id"`
+	BedLabel       string                         `json:"bed_label"`
+	PatientID      string                         `json:"patient_id" phi:"hash"`
+	Name           string                         `json:"name" phi:"mask"`
+	AssignedAt     time.Time                      `json:"assigned_at"`
+	RiskLevel      string                         `json:"risk_level"`
+	TriggeredRules []string                       `json:"triggered_rules"`
+	LatestVitals   map[string]LatestVitalResponse `json:"latest_vitals"`
+}
+
+type LatestVitalResponse struct {
+	Value      float64   `json:"value"`
+	RecordedAt time.Time `json:"recorded_at"`
+}
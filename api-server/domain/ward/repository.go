@@ -0,0 +1,24 @@
+//go:generate mockgen -source=repository.go -destination=../mock/mock_ward_repository.go -package=mock
+package ward
+
+import (
+	"aitrics-vital-signs/api-server/domain/encounter"
+	"context"
+)
+
+type WardRepository interface {
+	CreateWard(ctx context.Context, model *Ward) error
+	FindWardByID(ctx context.Context, wardID string) (*Ward, error)
+	FindWards(ctx context.Context) ([]Ward, error)
+	CreateBed(ctx context.Context, model *Bed) error
+	FindBedByID(ctx context.Context, bedID string) (*Bed, error)
+	FindBedsByWardID(ctx context.Context, wardID string) ([]Bed, error)
+	FindActiveBedAssignmentByPatientID(ctx context.Context, patientID string) (*BedAssignment, error)
+	FindActiveBedAssignmentByBedID(ctx context.Context, bedID string) (*BedAssignment, error)
+	FindActiveBedAssignmentsByWardID(ctx context.Context, wardID string) ([]BedAssignment, error)
+	FindBedAssignmentsByPatientID(ctx context.Context, patientID string) ([]BedAssignment, error)
+	// CreateBedAssignment / ReleaseBedAssignment / TransferBedAssignment 는 encounter 와 함께 변경하므로 Transactor 트랜잭션 안에서 호출합니다.
+	CreateBedAssignment(ctx context.Context, model *BedAssignment, newEncounter *encounter.Encounter) error
+	ReleaseBedAssignment(ctx context.Context, model *BedAssignment, closedEncounter *encounter.Encounter) error
+	TransferBedAssignment(ctx context.Context, current *BedAssignment, next *BedAssignment) error
+}
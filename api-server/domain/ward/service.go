@@ -0,0 +1,15 @@
+//go:generate mockgen -source=service.go -destination=../mock/mock_ward_service.go -package=mock
+package ward
+
+import "context"
+
+type WardService interface {
+	CreateWard(ctx context.Context, request CreateWardRequest) (*WardResponse, error)
+	ListWards(ctx context.Context) ([]WardResponse, error)
+	CreateBed(ctx context.Context, wardID string, request CreateBedRequest) (*BedResponse, error)
+	GetWardPatients(ctx context.Context, wardID string) (*GetWardPatientsResponse, error)
+	AdmitPatient(ctx context.Context, patientID string, request AssignBedRequest) (*BedAssignmentResponse, error)
+	TransferPatient(ctx context.Context, patientID string, request AssignBedRequest) (*BedAssignmentResponse, error)
+	DischargePatient(ctx context.Context, patientID string) (*BedAssignmentResponse, error)
+	GetPatientBedAssignments(ctx context.Context, patientID string) ([]BedAssignmentResponse, error)
+}
@@ -0,0 +1,156 @@
+package audit
+
+import (
+	"aitrics-vital-signs/api-server/domain/audit"
+	pkgLogger "aitrics-vital-signs/library/logger"
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// 한 batch 저장에 허용하는 최대 시간
+const flushTimeout = 5 * time.Second
+
+type BufferedWriterConfig struct {
+	BufferSize    int
+	BatchSize     int
+	FlushInterval time.Duration
+}
+
+// bufferedWriter
+// 감사 기록을 channel 에 적재하고, BatchSize 가 차거나 FlushInterval 이 지나면 한 번에 저장합니다.
+type bufferedWriter struct {
+	repo   audit.AuditRepository
+	config BufferedWriterConfig
+	events chan audit.AuditEvent
+	done   chan struct{}
+
+	mu     sync.RWMutex
+	closed bool
+
+	flushErr atomic.Pointer[error] // 마지막 저장 실패 원인 (성공 시 nil)
+}
+
+func (b *bufferedWriter) Write(event audit.AuditEvent) {
+	// lock 은 channel 적재 여부 판단에만 사용하고, 동기 저장은 lock 밖에서 수행해 Close 를 막지 않습니다.
+	b.mu.RLock()
+	closed := b.closed
+	queued := false
+	if !closed {
+		select {
+		case b.events <- event:
+			queued = true
+		default:
+		}
+	}
+	b.mu.RUnlock()
+
+	if queued {
+		return
+	}
+
+	// 종료 이후 / 버퍼 초과 시에도 기록을 잃지 않도록 동기로 저장
+	if !closed {
+		pkgLogger.ZapLogger.Logger.Warn("audit buffer is full, writing synchronously")
+	}
+	b.flush([]audit.AuditEvent{event})
+}
+
+func (b *bufferedWriter) Close(ctx context.Context) error {
+	b.mu.Lock()
+	if !b.closed {
+		b.closed = true
+		close(b.events)
+	}
+	b.mu.Unlock()
+
+	select {
+	case <-b.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Check
+// 종료되었거나, 버퍼가 가득 찼거나(요청 경로에서 동기 저장 중), 마지막 저장이 실패한 경우 unhealthy 로 판단합니다.
+func (b *bufferedWriter) Check(ctx context.Context) error {
+	b.mu.RLock()
+	closed := b.closed
+	b.mu.RUnlock()
+
+	if closed {
+		return errors.New("audit writer is closed")
+	}
+	if len(b.events) >= cap(b.events) {
+		return fmt.Errorf("audit buffer is full (%d)", cap(b.events))
+	}
+	if err := b.flushErr.Load(); err != nil {
+		return fmt.Errorf("last flush failed: %w", *err)
+	}
+	return nil
+}
+
+func (b *bufferedWriter) run() {
+	defer close(b.done)
+
+	ticker := time.NewTicker(b.config.FlushInterval)
+	defer ticker.Stop()
+
+	batch := make([]audit.AuditEvent, 0, b.config.BatchSize)
+	for {
+		select {
+		case event, ok := <-b.events:
+			if !ok {
+				b.flush(batch)
+				return
+			}
+
+			batch = append(batch, event)
+			if len(batch) >= b.config.BatchSize {
+				b.flush(batch)
+				batch = make([]audit.AuditEvent, 0, b.config.BatchSize)
+			}
+		case <-ticker.C:
+			if len(batch) > 0 {
+				b.flush(batch)
+				batch = make([]audit.AuditEvent, 0, b.config.BatchSize)
+			}
+		}
+	}
+}
+
+// flush 저장 실패 시 유실되지 않도록 기록 전체를 에러 로그로 남깁니다.
+func (b *bufferedWriter) flush(batch []audit.AuditEvent) {
+	if len(batch) == 0 {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), flushTimeout)
+	defer cancel()
+
+	if err := b.repo.CreateAuditEvents(ctx, batch); err != nil {
+		b.flushErr.Store(&err)
+		pkgLogger.ZapLogger.Logger.Error("fail to write audit events: "+err.Error(), zap.Any("audit_events", batch))
+		return
+	}
+	b.flushErr.Store(nil)
+}
+
+func NewBufferedWriter(repo audit.AuditRepository, config BufferedWriterConfig) audit.AuditWriter {
+	b := &bufferedWriter{
+		repo:   repo,
+		config: config,
+		events: make(chan audit.AuditEvent, config.BufferSize),
+		done:   make(chan struct{}),
+	}
+
+	go b.run()
+
+	return b
+}
@@ -0,0 +1,173 @@
+package audit
+
+import (
+	"aitrics-vital-signs/api-server/domain/audit"
+	"aitrics-vital-signs/api-server/domain/mock"
+	pkgLogger "aitrics-vital-signs/library/logger"
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_BufferedWriter(t *testing.T) {
+	tests := []struct {
+		name        string
+		config      BufferedWriterConfig
+		events      int
+		wantBatches []int
+	}{
+		{
+			name:        "성공 - batch 크기 단위 저장 후 Close 시 잔여분 저장",
+			config:      BufferedWriterConfig{BufferSize: 10, BatchSize: 2, FlushInterval: time.Hour},
+			events:      5,
+			wantBatches: []int{2, 2, 1},
+		},
+		{
+			name:        "성공 - 기록이 없으면 저장하지 않음",
+			config:      BufferedWriterConfig{BufferSize: 10, BatchSize: 2, FlushInterval: time.Hour},
+			events:      0,
+			wantBatches: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockAuditRepository := mock.NewMockAuditRepository(ctrl)
+
+			var (
+				mu      sync.Mutex
+				batches []int
+			)
+			mockAuditRepository.EXPECT().CreateAuditEvents(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, models []audit.AuditEvent) error {
+					mu.Lock()
+					defer mu.Unlock()
+					batches = append(batches, len(models))
+					return nil
+				}).AnyTimes()
+
+			writer := NewBufferedWriter(mockAuditRepository, tt.config)
+			for i := 0; i < tt.events; i++ {
+				writer.Write(audit.AuditEvent{RequestID: "req-1"})
+			}
+
+			require.NoError(t, writer.Close(context.Background()))
+
+			mu.Lock()
+			defer mu.Unlock()
+			require.Equal(t, tt.wantBatches, batches)
+		})
+	}
+}
+
+func Test_BufferedWriter_FlushInterval(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockAuditRepository := mock.NewMockAuditRepository(ctrl)
+
+	flushed := make(chan int, 1)
+	mockAuditRepository.EXPECT().CreateAuditEvents(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, models []audit.AuditEvent) error {
+			flushed <- len(models)
+			return nil
+		})
+
+	writer := NewBufferedWriter(mockAuditRepository, BufferedWriterConfig{BufferSize: 10, BatchSize: 100, FlushInterval: 10 * time.Millisecond})
+	writer.Write(audit.AuditEvent{RequestID: "req-1"})
+
+	select {
+	case n := <-flushed:
+		require.Equal(t, 1, n)
+	case <-time.After(time.Second):
+		t.Fatal("audit events were not flushed")
+	}
+
+	require.NoError(t, writer.Close(context.Background()))
+}
+
+func Test_BufferedWriter_Check(t *testing.T) {
+	pkgLogger.MustInitStderrZapLogger()
+
+	ctrl := gomock.NewController(t)
+	mockAuditRepository := mock.NewMockAuditRepository(ctrl)
+
+	var fail atomic.Bool
+	fail.Store(true)
+	mockAuditRepository.EXPECT().CreateAuditEvents(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ []audit.AuditEvent) error {
+			if fail.Load() {
+				return errors.New("db error")
+			}
+			return nil
+		}).AnyTimes()
+
+	writer := NewBufferedWriter(mockAuditRepository, BufferedWriterConfig{BufferSize: 10, BatchSize: 1, FlushInterval: time.Hour})
+	require.NoError(t, writer.Check(context.Background()))
+
+	// 저장 실패 시 unhealthy, 이후 저장에 성공하면 복구
+	writer.Write(audit.AuditEvent{RequestID: "req-1"})
+	require.Eventually(t, func() bool {
+		err := writer.Check(context.Background())
+		return err != nil && strings.Contains(err.Error(), "last flush failed")
+	}, time.Second, 10*time.Millisecond)
+
+	fail.Store(false)
+	writer.Write(audit.AuditEvent{RequestID: "req-2"})
+	require.Eventually(t, func() bool {
+		return writer.Check(context.Background()) == nil
+	}, time.Second, 10*time.Millisecond)
+
+	require.NoError(t, writer.Close(context.Background()))
+	require.EqualError(t, writer.Check(context.Background()), "audit writer is closed")
+}
+
+func Test_BufferedWriter_SyncFlushDoesNotBlockClose(t *testing.T) {
+	pkgLogger.MustInitStderrZapLogger()
+
+	ctrl := gomock.NewController(t)
+	mockAuditRepository := mock.NewMockAuditRepository(ctrl)
+
+	release := make(chan struct{})
+	var saved atomic.Int32
+	mockAuditRepository.EXPECT().CreateAuditEvents(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, models []audit.AuditEvent) error {
+			<-release
+			saved.Add(int32(len(models)))
+			return nil
+		}).AnyTimes()
+
+	writer := NewBufferedWriter(mockAuditRepository, BufferedWriterConfig{BufferSize: 1, BatchSize: 1, FlushInterval: time.Hour})
+
+	// 첫 기록은 background 저장에서 대기, 두 번째는 버퍼에 적재
+	writer.Write(audit.AuditEvent{RequestID: "req-1"})
+	require.Eventually(t, func() bool { return len(writer.(*bufferedWriter).events) == 0 }, time.Second, 10*time.Millisecond)
+	writer.Write(audit.AuditEvent{RequestID: "req-2"})
+
+	// 버퍼가 가득 차 동기 저장 중인 요청이 있어도 Close 는 바로 종료 상태로 전환
+	written := make(chan struct{})
+	go func() {
+		writer.Write(audit.AuditEvent{RequestID: "req-3"})
+		close(written)
+	}()
+
+	closed := make(chan error, 1)
+	go func() {
+		closed <- writer.Close(context.Background())
+	}()
+	require.Eventually(t, func() bool {
+		err := writer.Check(context.Background())
+		return err != nil && err.Error() == "audit writer is closed"
+	}, time.Second, 10*time.Millisecond)
+
+	close(release)
+	<-written
+	require.NoError(t, <-closed)
+	require.Equal(t, int32(3), saved.Load())
+}
@@ -0,0 +1,186 @@
+package generator
+
+import (
+	"aitrics-vital-signs/api-server/domain/patient"
+	"aitrics-vital-signs/api-server/domain/vital"
+	pkgError "aitrics-vital-signs/library/error"
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Scenario 악화 시나리오
+type Scenario string
+
+const (
+	ScenarioNone               Scenario = "none"
+	ScenarioSepsis             Scenario = "sepsis"
+	ScenarioHemorrhage         Scenario = "hemorrhage"
+	ScenarioRespiratoryFailure Scenario = "respiratory_failure"
+)
+
+func (s Scenario) String() string {
+	return string(s)
+}
+
+func ParseScenario(value string) (Scenario, error) {
+	switch s := Scenario(value); s {
+	case ScenarioNone, ScenarioSepsis, ScenarioHemorrhage, ScenarioRespiratoryFailure:
+		return s, nil
+	case "":
+		return ScenarioNone, nil
+	default:
+		return "", pkgError.WrapWithCode(pkgError.EmptyBusinessError(), pkgError.WrongParam, "unknown scenario: "+value)
+	}
+}
+
+type Config struct {
+	Seed            int64
+	Patients        int
+	PatientIDPrefix string
+	PatientIDStart  int
+	Start           time.Time     // 첫 측정 시간
+	Duration        time.Duration // 0 이면 환자만 생성
+	Interval        time.Duration // 측정 간격
+	Scenario        Scenario
+	Onset           time.Duration // Start 기준 악화 시작 시점
+	Ramp            time.Duration // 악화 시작부터 최대 악화까지 걸리는 시간
+	Ratio           float64       // 시나리오를 적용할 환자 비율 (0 ~ 1)
+}
+
+// Series 환자 한 명의 생성 결과
+type Series struct {
+	Patient  patient.Patient
+	Scenario Scenario
+	OnsetAt  *time.Time // 시나리오 미적용 환자는 nil
+	Vitals   []vital.Vital
+}
+
+type Generator struct {
+	config Config
+}
+
+// Generate
+// 환자별로 Series 를 만들어 fn 에 전달합니다. 환자마다 (Seed, 순번) 으로 난수를 분리하므로
+// 같은 설정이면 항상 같은 결과가 나오고, 환자 수를 늘려도 기존 환자의 데이터는 바뀌지 않습니다.
+func (g *Generator) Generate(fn func(*Series) error) error {
+	for i := 0; i < g.config.Patients; i++ {
+		random := rand.New(rand.NewSource(g.config.Seed*1_000_003 + int64(i)))
+
+		series := &Series{
+			Patient:  g.newPatient(random, fmt.Sprintf("%s%05d", g.config.PatientIDPrefix, g.config.PatientIDStart+i)),
+			Scenario: ScenarioNone,
+		}
+
+		// 시나리오 적용 여부는 항상 첫 번째 난수로 결정 (Ratio 를 바꿔도 생리 기준값은 유지)
+		if random.Float64() < g.config.Ratio && g.config.Scenario != ScenarioNone {
+			onsetAt := g.config.Start.Add(g.config.Onset)
+			series.Scenario = g.config.Scenario
+			series.OnsetAt = &onsetAt
+		}
+
+		series.Vitals = g.newVitals(random, series)
+
+		if err := fn(series); err != nil {
+			return pkgError.Wrap(err)
+		}
+	}
+
+	return nil
+}
+
+func (g *Generator) newPatient(random *rand.Rand, patientID string) patient.Patient {
+	id, _ := uuid.NewRandomFromReader(random)
+	createdAt := g.config.Start.UTC()
+
+	return patient.Patient{
+		ID:        id.String(),
+		PatientID: patientID,
+		Name:      names[random.Intn(len(names))],
+		Gender:    []string{"M", "F"}[random.Intn(2)],
+		BirthDate: time.Date(1940+random.Intn(60), time.Month(1+random.Intn(12)), 1+random.Intn(28), 0, 0, 0, 0, time.UTC),
+		Version:   1,
+		CreatedAt: createdAt,
+		UpdatedAt: &createdAt,
+	}
+}
+
+func (g *Generator) newVitals(random *rand.Rand, series *Series) []vital.Vital {
+	baselines := make([]float64, len(profiles))
+	for i, profile := range profiles {
+		baselines[i] = profile.mean + random.NormFloat64()*profile.sd
+	}
+
+	if g.config.Duration <= 0 {
+		return nil
+	}
+
+	noises := make([]float64, len(profiles))
+	count := int(g.config.Duration / g.config.Interval)
+	vitals := make([]vital.Vital, 0, count*len(profiles))
+
+	for k := 0; k < count; k++ {
+		recordedAt := g.config.Start.Add(time.Duration(k) * g.config.Interval).UTC()
+		severity := g.severity(series, recordedAt)
+		rhythm := circadian(recordedAt)
+
+		values := make([]float64, len(profiles))
+		for i, profile := range profiles {
+			noises[i] = noiseCorrelation*noises[i] + profile.noise*noiseInnovation*random.NormFloat64()
+			values[i] = baselines[i] + profile.circadian*rhythm + noises[i] + severity*effects[series.Scenario][profile.vitalType]
+		}
+		constrain(values)
+
+		for i, profile := range profiles {
+			updatedAt := recordedAt
+			vitals = append(vitals, vital.Vital{
+				PatientID:  series.Patient.PatientID,
+				RecordedAt: recordedAt,
+				VitalType:  profile.vitalType.String(),
+				Value:      values[i],
+				Version:    1,
+				CreatedAt:  recordedAt,
+				UpdatedAt:  &updatedAt,
+			})
+		}
+	}
+
+	return vitals
+}
+
+// severity 악화 시작 전 0, 이후 Ramp 동안 선형 증가하여 1 에서 유지
+func (g *Generator) severity(series *Series, at time.Time) float64 {
+	if series.OnsetAt == nil || at.Before(*series.OnsetAt) {
+		return 0
+	}
+	if g.config.Ramp <= 0 {
+		return 1
+	}
+	return min(float64(at.Sub(*series.OnsetAt))/float64(g.config.Ramp), 1)
+}
+
+func NewGenerator(config Config) (*Generator, error) {
+	switch {
+	case config.Patients < 1:
+		return nil, pkgError.WrapWithCode(pkgError.EmptyBusinessError(), pkgError.WrongParam, "patients must be greater than 0")
+	case config.Duration < 0:
+		return nil, pkgError.WrapWithCode(pkgError.EmptyBusinessError(), pkgError.WrongParam, "duration must not be negative")
+	case config.Duration > 0 && config.Interval <= 0:
+		return nil, pkgError.WrapWithCode(pkgError.EmptyBusinessError(), pkgError.WrongParam, "interval must be greater than 0")
+	case config.Ratio < 0 || config.Ratio > 1:
+		return nil, pkgError.WrapWithCode(pkgError.EmptyBusinessError(), pkgError.WrongParam, "ratio must be between 0 and 1")
+	case config.Onset < 0 || config.Ramp < 0:
+		return nil, pkgError.WrapWithCode(pkgError.EmptyBusinessError(), pkgError.WrongParam, "onset and ramp must not be negative")
+	}
+
+	if _, err := ParseScenario(config.Scenario.String()); err != nil {
+		return nil, pkgError.Wrap(err)
+	}
+	if config.Scenario == "" {
+		config.Scenario = ScenarioNone
+	}
+
+	return &Generator{config: config}, nil
+}
@@ -0,0 +1,229 @@
+package generator
+
+import (
+	"aitrics-vital-signs/api-server/pkg/constant"
+	pkgError "aitrics-vital-signs/library/error"
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+var testStart = time.Date(2025, 12, 1, 0, 0, 0, 0, time.UTC)
+
+func generate(t *testing.T, config Config) []*Series {
+	generator, err := NewGenerator(config)
+	require.NoError(t, err)
+
+	var results []*Series
+	require.NoError(t, generator.Generate(func(series *Series) error {
+		results = append(results, series)
+		return nil
+	}))
+	return results
+}
+
+// meanOf from <= recorded_at < to 구간의 평균
+func meanOf(series *Series, vitalType constant.VitalType, from, to time.Time) float64 {
+	sum, count := 0.0, 0
+	for _, v := range series.Vitals {
+		if v.VitalType == vitalType.String() && !v.RecordedAt.Before(from) && v.RecordedAt.Before(to) {
+			sum += v.Value
+			count++
+		}
+	}
+	return sum / float64(count)
+}
+
+func Test_Generate(t *testing.T) {
+	config := Config{
+		Seed:            42,
+		Patients:        3,
+		PatientIDPrefix: "G",
+		PatientIDStart:  1,
+		Start:           testStart,
+		Duration:        24 * time.Hour,
+		Interval:        15 * time.Minute,
+	}
+
+	results := generate(t, config)
+	require.Len(t, results, 3)
+	require.Equal(t, "G00001", results[0].Patient.PatientID)
+	require.Equal(t, "G00003", results[2].Patient.PatientID)
+
+	for _, series := range results {
+		require.Equal(t, ScenarioNone, series.Scenario)
+		require.Nil(t, series.OnsetAt)
+		require.Len(t, series.Vitals, 96*len(profiles))
+
+		for _, v := range series.Vitals {
+			p := profiles[indexOf(constant.VitalType(v.VitalType))]
+			require.GreaterOrEqual(t, v.Value, p.min)
+			require.LessOrEqual(t, v.Value, p.max)
+			require.Equal(t, 1, v.Version)
+		}
+	}
+
+	t.Run("성공 - 같은 seed 는 같은 결과", func(t *testing.T) {
+		require.Equal(t, results, generate(t, config))
+	})
+
+	t.Run("성공 - 환자 수를 늘려도 기존 환자 데이터 유지", func(t *testing.T) {
+		more := config
+		more.Patients = 5
+		require.Equal(t, results, generate(t, more)[:3])
+	})
+
+	t.Run("성공 - 다른 seed 는 다른 결과", func(t *testing.T) {
+		other := config
+		other.Seed = 43
+		require.NotEqual(t, results[0].Vitals, generate(t, other)[0].Vitals)
+	})
+
+	t.Run("성공 - duration 0 이면 환자만 생성", func(t *testing.T) {
+		patientsOnly := config
+		patientsOnly.Duration = 0
+		for i, series := range generate(t, patientsOnly) {
+			require.Empty(t, series.Vitals)
+			require.Equal(t, results[i].Patient, series.Patient)
+		}
+	})
+}
+
+func Test_Generate_Scenario(t *testing.T) {
+	onset := 12 * time.Hour
+	tests := []struct {
+		name     string
+		scenario Scenario
+		rising   []constant.VitalType
+		falling  []constant.VitalType
+	}{
+		{
+			name:     "성공 - sepsis",
+			scenario: ScenarioSepsis,
+			rising:   []constant.VitalType{constant.VitalTypeHR, constant.VitalTypeRR, constant.VitalTypeBT},
+			falling:  []constant.VitalType{constant.VitalTypeSBP, constant.VitalTypeDBP},
+		},
+		{
+			name:     "성공 - hemorrhage",
+			scenario: ScenarioHemorrhage,
+			rising:   []constant.VitalType{constant.VitalTypeHR},
+			falling:  []constant.VitalType{constant.VitalTypeSBP, constant.VitalTypeBT},
+		},
+		{
+			name:     "성공 - respiratory failure",
+			scenario: ScenarioRespiratoryFailure,
+			rising:   []constant.VitalType{constant.VitalTypeRR, constant.VitalTypeHR},
+			falling:  []constant.VitalType{constant.VitalTypeSpO2},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			series := generate(t, Config{
+				Seed:            7,
+				Patients:        1,
+				PatientIDPrefix: "G",
+				Start:           testStart,
+				Duration:        24 * time.Hour,
+				Interval:        5 * time.Minute,
+				Scenario:        tt.scenario,
+				Onset:           onset,
+				Ramp:            2 * time.Hour,
+				Ratio:           1,
+			})[0]
+
+			require.Equal(t, tt.scenario, series.Scenario)
+			require.Equal(t, testStart.Add(onset), *series.OnsetAt)
+
+			// 악화 전 구간과 최대 악화 이후 구간의 평균 비교
+			before := func(vitalType constant.VitalType) float64 {
+				return meanOf(series, vitalType, testStart, testStart.Add(onset))
+			}
+			after := func(vitalType constant.VitalType) float64 {
+				return meanOf(series, vitalType, testStart.Add(onset+2*time.Hour), testStart.Add(24*time.Hour))
+			}
+
+			for _, vitalType := range tt.rising {
+				require.Greater(t, after(vitalType), before(vitalType), vitalType.String())
+			}
+			for _, vitalType := range tt.falling {
+				require.Less(t, after(vitalType), before(vitalType), vitalType.String())
+			}
+		})
+	}
+
+	t.Run("성공 - ratio 0 이면 시나리오 미적용", func(t *testing.T) {
+		for _, series := range generate(t, Config{Seed: 1, Patients: 5, Start: testStart, Scenario: ScenarioSepsis}) {
+			require.Equal(t, ScenarioNone, series.Scenario)
+		}
+	})
+}
+
+func Test_NewGenerator(t *testing.T) {
+	tests := []struct {
+		name   string
+		config Config
+	}{
+		{name: "실패 - 환자 수 0", config: Config{Patients: 0}},
+		{name: "실패 - interval 누락", config: Config{Patients: 1, Duration: time.Hour}},
+		{name: "실패 - ratio 범위 초과", config: Config{Patients: 1, Ratio: 1.5}},
+		{name: "실패 - 알 수 없는 시나리오", config: Config{Patients: 1, Scenario: "stroke"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewGenerator(tt.config)
+			require.Error(t, err)
+			require.True(t, pkgError.CompareBusinessError(err, pkgError.WrongParam))
+		})
+	}
+}
+
+func Test_HL7Writer(t *testing.T) {
+	series := generate(t, Config{
+		Seed:            1,
+		Patients:        1,
+		PatientIDPrefix: "G",
+		Start:           testStart,
+		Duration:        time.Hour,
+		Interval:        30 * time.Minute,
+	})[0]
+
+	var buf bytes.Buffer
+	writer := NewHL7Writer(&buf)
+	require.NoError(t, writer.Write(series))
+	require.NoError(t, writer.Flush())
+
+	messages := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+	require.Len(t, messages, 2)
+
+	segments := strings.Split(strings.TrimSuffix(messages[0], "\r"), "\r")
+	require.Len(t, segments, 3+len(profiles))
+	require.Equal(t, `MSH|^~\&|VITAL_GENERATOR|AITRICS|||20251201000000||ORU^R01^ORU_R01|G00000-1|P|2.5.1`, segments[0])
+	require.True(t, strings.HasPrefix(segments[1], "PID|1||G00000^^^AITRICS^MR||"))
+	require.True(t, strings.HasPrefix(segments[3], "OBX|1|NM|8867-4^Heart rate^LN||"))
+	require.True(t, strings.HasSuffix(segments[3], "|F|||20251201000000"))
+}
+
+func Test_NDJSONWriter(t *testing.T) {
+	series := generate(t, Config{
+		Seed:            1,
+		Patients:        1,
+		PatientIDPrefix: "G",
+		Start:           testStart,
+		Duration:        time.Hour,
+		Interval:        time.Hour,
+	})[0]
+
+	var buf bytes.Buffer
+	writer := NewNDJSONWriter(&buf)
+	require.NoError(t, writer.Write(series))
+	require.NoError(t, writer.Flush())
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, len(profiles))
+	require.Contains(t, lines[0], `"patient_id":"G00000","recorded_at":"2025-12-01T00:00:00Z","vital_type":"HR"`)
+}
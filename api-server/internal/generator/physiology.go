@@ -0,0 +1,99 @@
+package generator
+
+import (
+	"aitrics-vital-signs/api-server/pkg/constant"
+	"math"
+	"time"
+)
+
+var names = []string{"김민준", "이서연", "박지훈", "최수아", "정도윤", "강하은", "조시우", "윤지민", "장예준", "임서윤"}
+
+// 측정 잡음은 AR(1) 로 생성해 연속 측정값이 급격히 튀지 않도록 합니다.
+var (
+	noiseCorrelation = 0.6
+	noiseInnovation  = math.Sqrt(1 - noiseCorrelation*noiseCorrelation)
+)
+
+type profile struct {
+	vitalType constant.VitalType
+	mean      float64 // 성인 환자 기준값 평균
+	sd        float64 // 환자 간 기준값 표준편차
+	circadian float64 // 일중 변동 진폭 (16시 최고, 4시 최저)
+	noise     float64 // 측정 잡음 표준편차
+	min       float64
+	max       float64
+	precision float64 // 반올림 단위
+}
+
+// profiles 순서가 난수 소비 순서이므로 항목을 추가할 때는 끝에 추가합니다.
+var profiles = []profile{
+	{vitalType: constant.VitalTypeHR, mean: 76, sd: 8, circadian: 6, noise: 3, min: 30, max: 220, precision: 1},
+	{vitalType: constant.VitalTypeSBP, mean: 122, sd: 10, circadian: 7, noise: 5, min: 50, max: 250, precision: 1},
+	{vitalType: constant.VitalTypeDBP, mean: 76, sd: 7, circadian: 4, noise: 3, min: 25, max: 150, precision: 1},
+	{vitalType: constant.VitalTypeSpO2, mean: 97.5, sd: 0.8, circadian: 0, noise: 0.6, min: 50, max: 100, precision: 1},
+	{vitalType: constant.VitalTypeRR, mean: 15, sd: 1.5, circadian: 1, noise: 1, min: 4, max: 60, precision: 1},
+	{vitalType: constant.VitalTypeBT, mean: 36.7, sd: 0.2, circadian: 0.3, noise: 0.08, min: 33, max: 42, precision: 0.1},
+}
+
+// effects 최대 악화 시 기준값 대비 변화량
+var effects = map[Scenario]map[constant.VitalType]float64{
+	ScenarioNone: {},
+	// 빈맥, 빈호흡, 발열, 혈관 확장에 의한 저혈압
+	ScenarioSepsis: {
+		constant.VitalTypeHR:   45,
+		constant.VitalTypeSBP:  -40,
+		constant.VitalTypeDBP:  -28,
+		constant.VitalTypeSpO2: -5,
+		constant.VitalTypeRR:   12,
+		constant.VitalTypeBT:   2.2,
+	},
+	// 순환 혈액량 감소: 빈맥과 맥압 감소를 동반한 저혈압, 체온 저하
+	ScenarioHemorrhage: {
+		constant.VitalTypeHR:   55,
+		constant.VitalTypeSBP:  -55,
+		constant.VitalTypeDBP:  -30,
+		constant.VitalTypeSpO2: -2,
+		constant.VitalTypeRR:   8,
+		constant.VitalTypeBT:   -0.8,
+	},
+	// 저산소증과 호흡수 증가, 보상성 빈맥 / 고혈압
+	ScenarioRespiratoryFailure: {
+		constant.VitalTypeHR:   30,
+		constant.VitalTypeSBP:  12,
+		constant.VitalTypeDBP:  6,
+		constant.VitalTypeSpO2: -18,
+		constant.VitalTypeRR:   18,
+		constant.VitalTypeBT:   0.3,
+	},
+}
+
+// circadian 하루 주기 변동 (-1 ~ 1)
+func circadian(at time.Time) float64 {
+	hour := float64(at.Hour()) + float64(at.Minute())/60
+	return math.Cos(2 * math.Pi * (hour - 16) / 24)
+}
+
+// constrain 생리적 범위로 제한하고 측정 장비 해상도로 반올림합니다.
+func constrain(values []float64) {
+	for i, profile := range profiles {
+		values[i] = math.Max(profile.min, math.Min(profile.max, values[i]))
+		values[i] = math.Round(values[i]/profile.precision) * profile.precision
+	}
+
+	// 이완기 혈압은 수축기 혈압보다 최소 15 mmHg 낮게 유지
+	sbp, dbp := indexOf(constant.VitalTypeSBP), indexOf(constant.VitalTypeDBP)
+	values[dbp] = math.Max(profiles[dbp].min, math.Min(values[dbp], values[sbp]-15))
+
+	// 0.1 단위 반올림 후 남는 부동소수 오차 제거
+	bt := indexOf(constant.VitalTypeBT)
+	values[bt] = math.Round(values[bt]*10) / 10
+}
+
+func indexOf(vitalType constant.VitalType) int {
+	for i, profile := range profiles {
+		if profile.vitalType == vitalType {
+			return i
+		}
+	}
+	return -1
+}
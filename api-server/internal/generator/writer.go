@@ -0,0 +1,138 @@
+package generator
+
+import (
+	"aitrics-vital-signs/api-server/domain/vital"
+	"aitrics-vital-signs/api-server/pkg/constant"
+	pkgError "aitrics-vital-signs/library/error"
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type SeriesWriter interface {
+	Write(series *Series) error
+	Flush() error
+}
+
+// ndjsonWriter import 명령 / POST /api/v1/vitals 요청과 같은 형식으로 vital 을 한 줄씩 기록합니다.
+type ndjsonWriter struct {
+	w       *bufio.Writer
+	encoder *json.Encoder
+}
+
+func (n *ndjsonWriter) Write(series *Series) error {
+	for _, v := range series.Vitals {
+		if err := n.encoder.Encode(vital.UpsertVitalRequest{
+			PatientID:  v.PatientID,
+			RecordedAt: v.RecordedAt,
+			VitalType:  v.VitalType,
+			Value:      v.Value,
+			Version:    v.Version,
+		}); err != nil {
+			return pkgError.Wrap(err)
+		}
+	}
+	return nil
+}
+
+func (n *ndjsonWriter) Flush() error {
+	return pkgError.Wrap(n.w.Flush())
+}
+
+func NewNDJSONWriter(w io.Writer) SeriesWriter {
+	buffered := bufio.NewWriter(w)
+	return &ndjsonWriter{w: buffered, encoder: json.NewEncoder(buffered)}
+}
+
+// hl7Observation vital 유형별 LOINC 코드 / UCUM 단위
+type hl7Observation struct {
+	code string
+	name string
+	unit string
+}
+
+var hl7Observations = map[string]hl7Observation{
+	constant.VitalTypeHR.String():   {code: "8867-4", name: "Heart rate", unit: "/min"},
+	constant.VitalTypeSBP.String():  {code: "8480-6", name: "Systolic blood pressure", unit: "mm[Hg]"},
+	constant.VitalTypeDBP.String():  {code: "8462-4", name: "Diastolic blood pressure", unit: "mm[Hg]"},
+	constant.VitalTypeSpO2.String(): {code: "59408-5", name: "Oxygen saturation by Pulse oximetry", unit: "%"},
+	constant.VitalTypeRR.String():   {code: "9279-1", name: "Respiratory rate", unit: "/min"},
+	constant.VitalTypeBT.String():   {code: "8310-5", name: "Body temperature", unit: "Cel"},
+}
+
+const (
+	hl7TimeLayout       = "20060102150405"
+	hl7SendingFacility  = "AITRICS"
+	hl7SendingApp       = "VITAL_GENERATOR"
+	hl7SegmentSeparator = "\r"
+)
+
+// hl7Writer
+// 측정 시점마다 HL7 v2.5.1 ORU^R01 메시지 한 건(OBX = vital 유형별 측정값)을 기록합니다.
+// segment 는 CR 로 구분하고, 메시지 사이에는 LF 를 추가해 파일에서도 메시지 단위로 구분되도록 합니다.
+type hl7Writer struct {
+	w *bufio.Writer
+}
+
+func (h *hl7Writer) Write(series *Series) error {
+	sequence := 0
+	for start := 0; start < len(series.Vitals); {
+		end := start
+		for end < len(series.Vitals) && series.Vitals[end].RecordedAt.Equal(series.Vitals[start].RecordedAt) {
+			end++
+		}
+
+		sequence++
+		if _, err := h.w.WriteString(h.message(series, sequence, series.Vitals[start:end])); err != nil {
+			return pkgError.Wrap(err)
+		}
+		start = end
+	}
+	return nil
+}
+
+func (h *hl7Writer) message(series *Series, sequence int, vitals []vital.Vital) string {
+	observedAt := formatHL7Time(vitals[0].RecordedAt)
+	p := series.Patient
+
+	segments := []string{
+		strings.Join([]string{"MSH", `^~\&`, hl7SendingApp, hl7SendingFacility, "", "", observedAt, "", "ORU^R01^ORU_R01", fmt.Sprintf("%s-%d", p.PatientID, sequence), "P", "2.5.1"}, "|"),
+		strings.Join([]string{"PID", "1", "", hl7Escape(p.PatientID) + "^^^" + hl7SendingFacility + "^MR", "", hl7Escape(p.Name), "", p.BirthDate.Format("20060102"), p.Gender}, "|"),
+		strings.Join([]string{"OBR", "1", "", "", "85353-1^Vital signs panel^LN", "", "", observedAt}, "|"),
+	}
+
+	for i, v := range vitals {
+		observation := hl7Observations[v.VitalType]
+		segments = append(segments, strings.Join([]string{
+			"OBX", strconv.Itoa(i + 1), "NM",
+			observation.code + "^" + observation.name + "^LN", "",
+			strconv.FormatFloat(v.Value, 'f', -1, 64),
+			observation.unit + "^" + observation.unit + "^UCUM",
+			"", "", "", "", "F", "", "", formatHL7Time(v.RecordedAt),
+		}, "|"))
+	}
+
+	return strings.Join(segments, hl7SegmentSeparator) + hl7SegmentSeparator + "\n"
+}
+
+func (h *hl7Writer) Flush() error {
+	return pkgError.Wrap(h.w.Flush())
+}
+
+func NewHL7Writer(w io.Writer) SeriesWriter {
+	return &hl7Writer{w: bufio.NewWriter(w)}
+}
+
+// hl7Escape HL7 구분 문자를 escape sequence 로 치환합니다.
+func hl7Escape(value string) string {
+	return strings.NewReplacer(`\`, `\E\`, "|", `\F\`, "^", `\S\`, "&", `\T\`, "~", `\R\`).Replace(value)
+}
+
+// formatHL7Time HL7 DTM 형식 (UTC, 초 단위)
+func formatHL7Time(t time.Time) string {
+	return t.UTC().Format(hl7TimeLayout)
+}
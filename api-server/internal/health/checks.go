@@ -0,0 +1,40 @@
+package health
+
+import (
+	"aitrics-vital-signs/api-server/domain"
+	"aitrics-vital-signs/api-server/domain/health"
+	"aitrics-vital-signs/api-server/internal/migration"
+	"context"
+	"fmt"
+)
+
+// NewDBChecker connection pool 에서 DB 연결 가능 여부를 확인합니다.
+func NewDBChecker(dbClient domain.ExternalDBClient) health.Checker {
+	return health.CheckerFunc(func(ctx context.Context) error {
+		sqlDB, err := dbClient.MySQL().DB()
+		if err != nil {
+			return err
+		}
+		return sqlDB.PingContext(ctx)
+	})
+}
+
+// NewMigrationChecker 바이너리에 포함된 migration 이 모두 적용되었고 dirty 상태가 아닌지 확인합니다.
+// 조회만 수행하므로 DDL 권한이 없는 계정으로도 확인할 수 있습니다.
+func NewMigrationChecker(dbClient domain.ExternalDBClient, migrations []migration.Migration) health.Checker {
+	return health.CheckerFunc(func(ctx context.Context) error {
+		sqlDB, err := dbClient.MySQL().DB()
+		if err != nil {
+			return err
+		}
+
+		pending, err := migration.NewMigrator(sqlDB, migrations, 0).Pending(ctx)
+		if err != nil {
+			return err
+		}
+		if len(pending) > 0 {
+			return fmt.Errorf("%d pending or dirty migration(s), first: %d_%s", len(pending), pending[0].Version, pending[0].Name)
+		}
+		return nil
+	})
+}
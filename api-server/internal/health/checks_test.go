@@ -0,0 +1,89 @@
+package health
+
+import (
+	"aitrics-vital-signs/api-server/domain/mock"
+	"aitrics-vital-signs/api-server/internal/migration"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+)
+
+func Test_DBChecker(t *testing.T) {
+	tests := []struct {
+		name    string
+		pingErr error
+	}{
+		{name: "성공 - ping 성공"},
+		{name: "실패 - ping 실패", pingErr: errors.New("connection refused")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sqlDB, sqlMock, err := sqlmock.New(sqlmock.MonitorPingsOption(true))
+			require.NoError(t, err)
+
+			db, err := gorm.Open(mysql.New(mysql.Config{Conn: sqlDB, SkipInitializeWithVersion: true}), &gorm.Config{DisableAutomaticPing: true})
+			require.NoError(t, err)
+
+			ctrl := gomock.NewController(t)
+			mockExternalDBClient := mock.NewMockExternalDBClient(ctrl)
+			mockExternalDBClient.EXPECT().MySQL().Return(db).AnyTimes()
+
+			sqlMock.ExpectPing().WillReturnError(tt.pingErr)
+
+			err = NewDBChecker(mockExternalDBClient).Check(context.Background())
+			if tt.pingErr != nil {
+				require.ErrorIs(t, err, tt.pingErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func Test_MigrationChecker(t *testing.T) {
+	migrations := []migration.Migration{
+		{Version: 1, Name: "init_schema", Up: "CREATE TABLE a (id int)", Down: "DROP TABLE a"},
+	}
+
+	tests := []struct {
+		name    string
+		rows    *sqlmock.Rows
+		wantErr bool
+	}{
+		{name: "성공 - 모든 migration 적용", rows: sqlmock.NewRows([]string{"version", "dirty", "applied_at"}).AddRow(1, false, time.Now())},
+		{name: "실패 - 미적용 migration 존재", rows: sqlmock.NewRows([]string{"version", "dirty", "applied_at"}), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sqlDB, sqlMock, err := sqlmock.New()
+			require.NoError(t, err)
+
+			db, err := gorm.Open(mysql.New(mysql.Config{Conn: sqlDB, SkipInitializeWithVersion: true}), &gorm.Config{DisableAutomaticPing: true})
+			require.NoError(t, err)
+
+			ctrl := gomock.NewController(t)
+			mockExternalDBClient := mock.NewMockExternalDBClient(ctrl)
+			mockExternalDBClient.EXPECT().MySQL().Return(db).AnyTimes()
+
+			// readiness 확인은 schema_migrations 조회만 수행 (CREATE TABLE 없음)
+			sqlMock.ExpectQuery("SELECT `version`, `dirty`, `applied_at` FROM `schema_migrations`").WillReturnRows(tt.rows)
+
+			err = NewMigrationChecker(mockExternalDBClient, migrations).Check(context.Background())
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			require.NoError(t, sqlMock.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,112 @@
+package i18n
+
+import (
+	pkgError "aitrics-vital-signs/library/error"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type Language string
+
+const (
+	Korean  Language = "ko"
+	English Language = "en"
+
+	// DefaultLanguage Accept-Language 가 없거나 지원하지 않는 언어인 경우 사용
+	DefaultLanguage = English
+)
+
+// Languages 지원 언어 목록
+var Languages = []Language{Korean, English}
+
+func (l Language) String() string {
+	return string(l)
+}
+
+// Negotiate Accept-Language 헤더에서 q 값이 가장 높은 지원 언어를 선택합니다. (ko-KR 은 ko 로 처리)
+func Negotiate(acceptLanguage string) Language {
+	type candidate struct {
+		lang Language
+		q    float64
+	}
+
+	candidates := make([]candidate, 0, 2)
+	for _, part := range strings.Split(acceptLanguage, ",") {
+		fields := strings.Split(strings.TrimSpace(part), ";")
+		tag := strings.ToLower(strings.TrimSpace(fields[0]))
+		if tag == "" {
+			continue
+		}
+
+		q := 1.0
+		for _, param := range fields[1:] {
+			param = strings.TrimSpace(param)
+			if value, ok := strings.CutPrefix(param, "q="); ok {
+				parsed, err := strconv.ParseFloat(value, 64)
+				if err != nil {
+					parsed = 0
+				}
+				q = parsed
+			}
+		}
+		if q <= 0 {
+			continue
+		}
+
+		if tag == "*" {
+			candidates = append(candidates, candidate{lang: DefaultLanguage, q: q})
+			continue
+		}
+
+		base, _, _ := strings.Cut(tag, "-")
+		for _, lang := range Languages {
+			if string(lang) == base {
+				candidates = append(candidates, candidate{lang: lang, q: q})
+				break
+			}
+		}
+	}
+
+	if len(candidates) == 0 {
+		return DefaultLanguage
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool { return candidates[i].q > candidates[j].q })
+	return candidates[0].lang
+}
+
+// Message business code 의 언어별 메시지를 반환합니다. 번역이 없으면 code 에 등록된 기본 메시지를 사용합니다.
+func Message(lang Language, code pkgError.Code) string {
+	if msg, ok := codeMessages[lang][code]; ok {
+		return msg
+	}
+	return pkgError.LookupStatus(code).Message
+}
+
+// ValidationReason validator tag 의 언어별 검증 실패 사유를 반환합니다.
+func ValidationReason(lang Language, tag string, param string) string {
+	messages, ok := validationMessages[lang]
+	if !ok {
+		messages = validationMessages[DefaultLanguage]
+	}
+
+	if tag == "oneof" {
+		param = strings.ReplaceAll(param, " ", ", ")
+	}
+
+	format, ok := messages[tag]
+	if !ok {
+		format = messages[unknownTag]
+		if param != "" {
+			return fmt.Sprintf(format, tag+"="+param)
+		}
+		return fmt.Sprintf(format, tag)
+	}
+
+	if strings.Contains(format, "%s") {
+		return fmt.Sprintf(format, param)
+	}
+	return format
+}
@@ -0,0 +1,74 @@
+package i18n
+
+import (
+	pkgError "aitrics-vital-signs/library/error"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Negotiate(t *testing.T) {
+	tests := []struct {
+		name           string
+		acceptLanguage string
+		want           Language
+	}{
+		{name: "성공 - 헤더가 없으면 기본 언어", acceptLanguage: "", want: DefaultLanguage},
+		{name: "성공 - 지역 코드는 기본 언어로 처리", acceptLanguage: "ko-KR", want: Korean},
+		{name: "성공 - q 값이 높은 언어 선택", acceptLanguage: "en;q=0.5, ko-KR;q=0.9", want: Korean},
+		{name: "성공 - 지원하지 않는 언어는 건너뜀", acceptLanguage: "ja-JP, en-US;q=0.8", want: English},
+		{name: "성공 - q=0 은 제외", acceptLanguage: "ko;q=0, en;q=0.1", want: English},
+		{name: "성공 - 지원하는 언어가 없으면 기본 언어", acceptLanguage: "ja, zh-CN", want: DefaultLanguage},
+		{name: "성공 - wildcard 는 기본 언어", acceptLanguage: "*", want: DefaultLanguage},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, Negotiate(tt.acceptLanguage))
+		})
+	}
+}
+
+// Test_CodeMessages business code 추가 시 모든 언어의 메시지가 함께 추가되어야 합니다.
+func Test_CodeMessages(t *testing.T) {
+	for _, lang := range Languages {
+		for _, status := range pkgError.Catalog() {
+			msg, ok := codeMessages[lang][pkgError.Code(status.Code)]
+			require.Truef(t, ok, "missing %s message for code %d", lang, status.Code)
+			require.NotEmpty(t, msg)
+		}
+	}
+}
+
+// Test_ValidationMessages 모든 언어가 같은 validator tag 를 번역해야 합니다.
+func Test_ValidationMessages(t *testing.T) {
+	for _, lang := range Languages {
+		for _, other := range Languages {
+			for tag := range validationMessages[other] {
+				_, ok := validationMessages[lang][tag]
+				require.Truef(t, ok, "missing %s message for validator tag %q", lang, tag)
+			}
+		}
+	}
+}
+
+func Test_ValidationReason(t *testing.T) {
+	tests := []struct {
+		name  string
+		lang  Language
+		tag   string
+		param string
+		want  string
+	}{
+		{name: "성공 - param 없는 tag", lang: Korean, tag: "required", want: "필수 값입니다"},
+		{name: "성공 - oneof 는 목록으로 표시", lang: English, tag: "oneof", param: "M F", want: "must be one of [M, F]"},
+		{name: "성공 - 번역이 없는 tag", lang: Korean, tag: "email", want: "email 검증에 실패했습니다"},
+		{name: "성공 - 번역이 없는 tag 와 param", lang: English, tag: "startswith", param: "P", want: "failed on startswith=P"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, ValidationReason(tt.lang, tt.tag, tt.param))
+		})
+	}
+}
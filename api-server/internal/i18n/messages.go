@@ -0,0 +1,87 @@
+package i18n
+
+import pkgError "aitrics-vital-signs/library/error"
+
+// codeMessages business code 별 메시지 (code 추가 시 모든 언어에 함께 추가)
+var codeMessages = map[Language]map[pkgError.Code]string{
+	Korean: {
+		pkgError.None:                  "알 수 없는 오류가 발생했습니다",
+		pkgError.Create:                "데이터 생성에 실패했습니다",
+		pkgError.Update:                "데이터 수정에 실패했습니다",
+		pkgError.Delete:                "데이터 삭제에 실패했습니다",
+		pkgError.Upsert:                "데이터 저장에 실패했습니다",
+		pkgError.Get:                   "데이터 조회에 실패했습니다",
+		pkgError.Transaction:           "트랜잭션 처리에 실패했습니다",
+		pkgError.AuthUnavailable:       "인증 키를 조회할 수 없습니다. 잠시 후 다시 시도해 주세요",
+		pkgError.WrongParam:            "요청 값이 올바르지 않습니다",
+		pkgError.Conflict:              "다른 요청에 의해 데이터가 변경되었습니다",
+		pkgError.NotFound:              "데이터를 찾을 수 없습니다",
+		pkgError.Unauthorized:          "인증이 필요합니다",
+		pkgError.Forbidden:             "접근 권한이 없습니다",
+		pkgError.TokenExpired:          "토큰이 만료되었습니다",
+		pkgError.InvalidTokenSignature: "토큰 서명이 올바르지 않습니다",
+		pkgError.MissingRole:           "필요한 역할이 없습니다",
+		pkgError.PreconditionFailed:    "If-Match 의 버전이 현재 데이터와 일치하지 않습니다",
+		pkgError.IdempotencyKeyReused:  "같은 Idempotency-Key 로 다른 요청을 보낼 수 없습니다",
+	},
+	English: {
+		pkgError.None:                  "not exists error",
+		pkgError.Create:                "fail to create data",
+		pkgError.Update:                "fail to update data",
+		pkgError.Delete:                "fail to delete data",
+		pkgError.Upsert:                "fail to upsert data",
+		pkgError.Get:                   "fail to get data",
+		pkgError.Transaction:           "fail to process transaction",
+		pkgError.AuthUnavailable:       "authentication key set is unavailable",
+		pkgError.WrongParam:            "wrong parameter",
+		pkgError.Conflict:              "conflict data",
+		pkgError.NotFound:              "not found data",
+		pkgError.Unauthorized:          "unauthorized",
+		pkgError.Forbidden:             "forbidden",
+		pkgError.TokenExpired:          "token is expired",
+		pkgError.InvalidTokenSignature: "invalid token signature",
+		pkgError.MissingRole:           "missing role",
+		pkgError.PreconditionFailed:    "precondition failed",
+		pkgError.IdempotencyKeyReused:  "idempotency key reused with different request",
+	},
+}
+
+const (
+	// TypeTag json decode 시 type 이 맞지 않는 경우 (validator tag 가 아닌 자체 정의)
+	TypeTag    = "type"
+	unknownTag = ""
+)
+
+// validationMessages validator tag 별 검증 실패 사유 (%s 는 tag param)
+var validationMessages = map[Language]map[string]string{
+	Korean: {
+		"required":         "필수 값입니다",
+		"required_without": "%s 가 없으면 필수 값입니다",
+		"oneof":            "[%s] 중 하나여야 합니다",
+		"max":              "최대 %s 이하여야 합니다",
+		"min":              "최소 %s 이상이어야 합니다",
+		"gt":               "%s 보다 커야 합니다",
+		"gte":              "%s 이상이어야 합니다",
+		"lt":               "%s 보다 작아야 합니다",
+		"lte":              "%s 이하여야 합니다",
+		"len":              "길이가 %s 여야 합니다",
+		"datetime":         "%s 형식이어야 합니다",
+		TypeTag:            "%s 타입이어야 합니다",
+		unknownTag:         "%s 검증에 실패했습니다",
+	},
+	English: {
+		"required":         "is required",
+		"required_without": "is required when %s is missing",
+		"oneof":            "must be one of [%s]",
+		"max":              "must be at most %s",
+		"min":              "must be at least %s",
+		"gt":               "must be greater than %s",
+		"gte":              "must be greater than or equal to %s",
+		"lt":               "must be less than %s",
+		"lte":              "must be less than or equal to %s",
+		"len":              "must have length %s",
+		"datetime":         "must be in %s format",
+		TypeTag:            "must be %s",
+		unknownTag:         "failed on %s",
+	},
+}
@@ -0,0 +1,73 @@
+package metrics
+
+import (
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const startTimeKey = "metrics:start_time"
+
+// GormPlugin 모든 GORM 호출의 실행 시간을 operation / table 별 histogram 으로 기록합니다.
+type GormPlugin struct{}
+
+func (GormPlugin) Name() string {
+	return "metrics"
+}
+
+func (p GormPlugin) Initialize(db *gorm.DB) error {
+	callback := db.Callback()
+	registrations := []struct {
+		operation string
+		before    func(string, func(*gorm.DB)) error
+		after     func(string, func(*gorm.DB)) error
+	}{
+		{"create", callback.Create().Before("gorm:create").Register, callback.Create().After("gorm:create").Register},
+		{"query", callback.Query().Before("gorm:query").Register, callback.Query().After("gorm:query").Register},
+		{"update", callback.Update().Before("gorm:update").Register, callback.Update().After("gorm:update").Register},
+		{"delete", callback.Delete().Before("gorm:delete").Register, callback.Delete().After("gorm:delete").Register},
+		{"row", callback.Row().Before("gorm:row").Register, callback.Row().After("gorm:row").Register},
+		{"raw", callback.Raw().Before("gorm:raw").Register, callback.Raw().After("gorm:raw").Register},
+	}
+
+	for _, r := range registrations {
+		if err := r.before("metrics:before_"+r.operation, before); err != nil {
+			return err
+		}
+		if err := r.after("metrics:after_"+r.operation, after(r.operation)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func before(db *gorm.DB) {
+	db.InstanceSet(startTimeKey, time.Now())
+}
+
+func after(operation string) func(*gorm.DB) {
+	return func(db *gorm.DB) {
+		value, ok := db.InstanceGet(startTimeKey)
+		if !ok {
+			return
+		}
+		start, ok := value.(time.Time)
+		if !ok {
+			return
+		}
+
+		// not found 는 정상 조회 결과이므로 error 로 집계하지 않음
+		status := "ok"
+		if db.Error != nil && !errors.Is(db.Error, gorm.ErrRecordNotFound) {
+			status = "error"
+		}
+
+		table := db.Statement.Table
+		if table == "" {
+			table = "unknown"
+		}
+
+		DBQueryDuration.WithLabelValues(operation, table, status).Observe(time.Since(start).Seconds())
+	}
+}
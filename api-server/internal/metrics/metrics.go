@@ -0,0 +1,125 @@
+package metrics
+
+import (
+	pkgError "aitrics-vital-signs/library/error"
+	"database/sql"
+	"errors"
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "aitrics"
+
+// label 값
+const (
+	ResultInserted  = "inserted"
+	ResultUpdated   = "updated"
+	ResultUnchanged = "unchanged"
+
+	EntityPatient = "patient"
+	EntityVital   = "vital"
+)
+
+// Registry 기본 registry 대신 사용해 테스트 / 다른 패키지의 전역 등록과 분리합니다.
+var Registry = prometheus.NewRegistry()
+
+var (
+	HTTPRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "http",
+		Name:      "request_duration_seconds",
+		Help:      "HTTP 요청 처리 시간 (route 는 path parameter 치환 전 경로)",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method", "route", "status"})
+
+	HTTPRequestsInFlight = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "http",
+		Name:      "requests_in_flight",
+		Help:      "처리 중인 HTTP 요청 수",
+	})
+
+	DBQueryDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "db",
+		Name:      "query_duration_seconds",
+		Help:      "GORM query 실행 시간",
+		Buckets:   []float64{.0005, .001, .0025, .005, .01, .025, .05, .1, .25, .5, 1, 2.5},
+	}, []string{"operation", "table", "status"})
+
+	DBReplicaUp = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "db",
+		Name:      "replica_up",
+		Help:      "read replica health check 결과 (0 이면 읽기 대상에서 제외)",
+	}, []string{"replica"})
+
+	VitalsUpserted = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "vitals_upserted_total",
+		Help:      "Vital UPSERT 결과 (inserted / updated / unchanged)",
+	}, []string{"result"})
+
+	VitalConflictRetries = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "vital_conflict_retries_total",
+		Help:      "conflict_policy 적용 중 충돌로 인한 재시도",
+	}, []string{"policy"})
+
+	OptimisticLockConflicts = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "optimistic_lock_conflicts_total",
+		Help:      "version 불일치로 거절된 수정 요청",
+	}, []string{"entity"})
+
+	InferenceRuns = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "inference_runs_total",
+		Help:      "위험 스코어 계산 API 결과 RiskLevel 별 횟수",
+	}, []string{"risk_level"})
+
+	InferenceTriggeredRules = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "inference_triggered_rules_total",
+		Help:      "위험 스코어 계산 API 에서 충족된 rule 별 횟수",
+	}, []string{"rule"})
+)
+
+func init() {
+	Registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		HTTPRequestDuration,
+		HTTPRequestsInFlight,
+		DBQueryDuration,
+		DBReplicaUp,
+		VitalsUpserted,
+		VitalConflictRetries,
+		OptimisticLockConflicts,
+		InferenceRuns,
+		InferenceTriggeredRules,
+	)
+}
+
+// RecordConflict 저장 단계에서 발생한 optimistic lock 충돌(Conflict)만 집계합니다.
+func RecordConflict(entity string, err error) {
+	if pkgError.CompareBusinessError(err, pkgError.Conflict) {
+		OptimisticLockConflicts.WithLabelValues(entity).Inc()
+	}
+}
+
+// RegisterDBStats connection pool 상태(sql.DBStats: open / in use / idle / wait 등)를 gauge 로 노출합니다.
+func RegisterDBStats(db *sql.DB, dbName string) error {
+	err := Registry.Register(collectors.NewDBStatsCollector(db, dbName))
+	if are := (prometheus.AlreadyRegisteredError{}); errors.As(err, &are) {
+		return nil
+	}
+	return err
+}
+
+func Handler() http.Handler {
+	return promhttp.HandlerFor(Registry, promhttp.HandlerOpts{Registry: Registry})
+}
@@ -0,0 +1,73 @@
+package metrics
+
+import (
+	pkgError "aitrics-vital-signs/library/error"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+)
+
+type testRow struct {
+	ID string
+}
+
+func (testRow) TableName() string {
+	return "test_rows"
+}
+
+func Test_GormPlugin(t *testing.T) {
+	sqlDB, sqlMock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer sqlDB.Close()
+
+	db, err := gorm.Open(mysql.New(mysql.Config{Conn: sqlDB, SkipInitializeWithVersion: true}), &gorm.Config{})
+	require.NoError(t, err)
+	require.NoError(t, db.Use(GormPlugin{}))
+
+	countOf := func(status string) uint64 {
+		return sampleCount(t, DBQueryDuration.WithLabelValues("query", "test_rows", status))
+	}
+
+	t.Run("성공 - 조회 / not found 는 ok 로 기록", func(t *testing.T) {
+		sqlMock.ExpectQuery("SELECT \\* FROM `test_rows`").WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+		var row testRow
+		require.ErrorIs(t, db.First(&row).Error, gorm.ErrRecordNotFound)
+		require.Equal(t, uint64(1), countOf("ok"))
+	})
+
+	t.Run("실패 - query 에러는 error 로 기록", func(t *testing.T) {
+		sqlMock.ExpectQuery("SELECT \\* FROM `test_rows`").WillReturnError(errors.New("connection refused"))
+
+		var rows []testRow
+		require.Error(t, db.Find(&rows).Error)
+		require.Equal(t, uint64(1), countOf("error"))
+	})
+
+	require.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
+func Test_RecordConflict(t *testing.T) {
+	counter := OptimisticLockConflicts.WithLabelValues(EntityPatient)
+	before := testutil.ToFloat64(counter)
+
+	RecordConflict(EntityPatient, pkgError.WrapWithCode(pkgError.EmptyBusinessError(), pkgError.Conflict, "version conflict in db update"))
+	require.Equal(t, before+1, testutil.ToFloat64(counter))
+
+	RecordConflict(EntityPatient, pkgError.WrapWithCode(pkgError.EmptyBusinessError(), pkgError.Update))
+	require.Equal(t, before+1, testutil.ToFloat64(counter))
+}
+
+// sampleCount histogram 의 관측 횟수
+func sampleCount(t *testing.T, observer prometheus.Observer) uint64 {
+	var out dto.Metric
+	require.NoError(t, observer.(prometheus.Metric).Write(&out))
+	return out.GetHistogram().GetSampleCount()
+}
@@ -0,0 +1,55 @@
+package middleware
+
+import (
+	pkgLogger "aitrics-vital-signs/library/logger"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// probePaths 주기적으로 호출되는 probe / scrape 요청은 실패한 경우에만 기록합니다.
+var probePaths = map[string]bool{
+	"/healthz": true,
+	"/readyz":  true,
+	"/metrics": true,
+}
+
+// AccessLogMiddleware 요청마다 처리 결과를 한 줄로 기록합니다. (5xx: error, 4xx: warn, 그 외: info)
+func AccessLogMiddleware() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		start := time.Now()
+		ctx.Next()
+
+		status := ctx.Writer.Status()
+		if probePaths[ctx.Request.URL.Path] && status < http.StatusBadRequest {
+			return
+		}
+
+		route := ctx.FullPath()
+		if route == "" {
+			route = unmatchedRoute
+		}
+
+		logger := pkgLogger.FromContext(ctx.Request.Context())
+		fields := []zap.Field{
+			zap.String("method", ctx.Request.Method),
+			zap.String("route", route),
+			zap.String("path", ctx.Request.URL.Path),
+			zap.Int("status", status),
+			zap.Float64("latency_ms", float64(time.Since(start).Microseconds())/1000),
+			zap.Int("response_bytes", ctx.Writer.Size()),
+			zap.String("client_ip", ctx.ClientIP()),
+		}
+
+		switch {
+		case status >= http.StatusInternalServerError:
+			logger.Error("access", fields...)
+		case status >= http.StatusBadRequest:
+			logger.Warn("access", fields...)
+		default:
+			logger.Info("access", fields...)
+		}
+	}
+}
@@ -0,0 +1,111 @@
+package middleware
+
+import (
+	"aitrics-vital-signs/api-server/domain/audit"
+	"aitrics-vital-signs/api-server/domain/auth"
+	"aitrics-vital-signs/api-server/pkg/constant"
+	pkgError "aitrics-vital-signs/library/error"
+	pkgLogger "aitrics-vital-signs/library/logger"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+const auditPathPrefix = "/api/v1"
+
+// AuditMiddleware
+// /api/v1 하위 모든 요청의 인증 주체, 대상 환자 / 리소스, 처리 결과를 감사 기록으로 남깁니다.
+// RequestIDMiddleware 뒤에 등록해야 요청 ID 가 기록됩니다.
+// 대상 환자는 path 의 patient_id 와 controller 가 audit.AddResources 로 추가한 리소스를 사용합니다.
+func AuditMiddleware(writer audit.AuditWriter) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		if !strings.HasPrefix(ctx.Request.URL.Path, auditPathPrefix) {
+			ctx.Next()
+			return
+		}
+
+		occurredAt := time.Now()
+		ctx.Next()
+
+		base := audit.AuditEvent{
+			RequestID:   pkgLogger.RequestIDFromContext(ctx.Request.Context()), // RequestIDMiddleware 가 설정한 ID (로그와 동일)
+			PrincipalID: constant.AnonymousPrincipalID,
+			Action:      auditAction(ctx.Request.Method).String(),
+			Method:      ctx.Request.Method,
+			Route:       ctx.FullPath(),
+			Path:        ctx.Request.URL.Path,
+			Outcome:     auditOutcome(ctx.Writer.Status()).String(),
+			StatusCode:  ctx.Writer.Status(),
+			ClientIP:    ctx.ClientIP(),
+			OccurredAt:  occurredAt,
+		}
+
+		if principal, ok := auth.PrincipalFromContext(ctx.Request.Context()); ok {
+			base.PrincipalID = principal.ID
+			base.PrincipalName = principal.Name
+			base.PrincipalType = principal.Type
+		}
+
+		if err := ctx.Errors.Last(); err != nil {
+			if castedErr, ok := pkgError.CastBusinessError(err); ok {
+				base.ErrorCode = castedErr.Status.Code
+			}
+		}
+
+		for _, resource := range auditResources(ctx) {
+			event := base
+			if resource.PatientID != "" {
+				patientID := resource.PatientID
+				event.PatientID = &patientID
+			}
+			event.ResourceKey = resource.Key
+			writer.Write(event)
+		}
+	}
+}
+
+// auditResources path 의 patient_id 가 명시적으로 추가된 리소스에 없으면 함께 기록하며, 대상이 없어도 한 건은 남깁니다.
+func auditResources(ctx *gin.Context) []audit.Resource {
+	resources := audit.ResourcesFromContext(ctx)
+
+	if patientID := ctx.Param("patient_id"); patientID != "" {
+		found := false
+		for _, resource := range resources {
+			if resource.PatientID == patientID {
+				found = true
+				break
+			}
+		}
+		if !found {
+			resources = append(resources, audit.Resource{PatientID: patientID})
+		}
+	}
+
+	if len(resources) == 0 {
+		resources = append(resources, audit.Resource{})
+	}
+
+	return resources
+}
+
+func auditAction(method string) constant.AuditAction {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return constant.AuditActionRead
+	default:
+		return constant.AuditActionWrite
+	}
+}
+
+func auditOutcome(status int) constant.AuditOutcome {
+	switch {
+	case status == http.StatusUnauthorized || status == http.StatusForbidden:
+		return constant.AuditOutcomeDenied
+	case status >= http.StatusBadRequest:
+		return constant.AuditOutcomeFailure
+	default:
+		return constant.AuditOutcomeSuccess
+	}
+}
@@ -0,0 +1,129 @@
+package middleware
+
+import (
+	"aitrics-vital-signs/api-server/domain/audit"
+	"aitrics-vital-signs/api-server/domain/auth"
+	"aitrics-vital-signs/api-server/pkg/constant"
+	pkgError "aitrics-vital-signs/library/error"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+)
+
+type recordingAuditWriter struct {
+	events []audit.AuditEvent
+}
+
+func (r *recordingAuditWriter) Write(event audit.AuditEvent) {
+	r.events = append(r.events, event)
+}
+
+func (r *recordingAuditWriter) Close(ctx context.Context) error {
+	return nil
+}
+
+func (r *recordingAuditWriter) Check(ctx context.Context) error {
+	return nil
+}
+
+func Test_AuditMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	principal := &auth.Principal{ID: "key-1", Name: "ward-a", Type: constant.PrincipalTypeAPIKey.String()}
+
+	tests := []struct {
+		name       string
+		method     string
+		path       string
+		handler    gin.HandlerFunc
+		wantEvents []audit.AuditEvent
+	}{
+		{
+			name:   "성공 - path 의 patient_id 조회 기록",
+			method: http.MethodGet,
+			path:   "/api/v1/patients/P00001/vitals",
+			handler: func(ctx *gin.Context) {
+				ctx.Request = ctx.Request.WithContext(auth.WithPrincipal(ctx.Request.Context(), principal))
+				ctx.Status(http.StatusOK)
+			},
+			wantEvents: []audit.AuditEvent{
+				{PrincipalID: "key-1", Action: "READ", PatientID: strPtr("P00001"), Outcome: "SUCCESS", StatusCode: http.StatusOK},
+			},
+		},
+		{
+			name:   "성공 - controller 가 추가한 vital 리소스 기록",
+			method: http.MethodPost,
+			path:   "/api/v1/vitals",
+			handler: func(ctx *gin.Context) {
+				ctx.Request = ctx.Request.WithContext(auth.WithPrincipal(ctx.Request.Context(), principal))
+				audit.AddResources(ctx, audit.Resource{PatientID: "P00001", Key: "HR@2025-12-01T10:00:00Z"}, audit.Resource{PatientID: "P00002"})
+				ctx.Status(http.StatusOK)
+			},
+			wantEvents: []audit.AuditEvent{
+				{PrincipalID: "key-1", Action: "WRITE", PatientID: strPtr("P00001"), ResourceKey: "HR@2025-12-01T10:00:00Z", Outcome: "SUCCESS", StatusCode: http.StatusOK},
+				{PrincipalID: "key-1", Action: "WRITE", PatientID: strPtr("P00002"), Outcome: "SUCCESS", StatusCode: http.StatusOK},
+			},
+		},
+		{
+			name:   "성공 - 인증 실패 요청도 기록",
+			method: http.MethodGet,
+			path:   "/api/v1/patients/P00001/vitals",
+			handler: func(ctx *gin.Context) {
+				_ = ctx.Error(pkgError.WrapWithCode(pkgError.EmptyBusinessError(), pkgError.Unauthorized))
+				ctx.Status(http.StatusUnauthorized)
+			},
+			wantEvents: []audit.AuditEvent{
+				{PrincipalID: constant.AnonymousPrincipalID, Action: "READ", PatientID: strPtr("P00001"), Outcome: "DENIED", StatusCode: http.StatusUnauthorized, ErrorCode: int(pkgError.Unauthorized)},
+			},
+		},
+		{
+			name:   "성공 - /api/v1 외 경로는 기록하지 않음",
+			method: http.MethodGet,
+			path:   "/swagger/index.html",
+			handler: func(ctx *gin.Context) {
+				ctx.Status(http.StatusOK)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			writer := &recordingAuditWriter{}
+			engine := gin.New()
+			engine.Use(RequestIDMiddleware(), AuditMiddleware(writer))
+			engine.GET("/api/v1/patients/:patient_id/vitals", tt.handler)
+			engine.POST("/api/v1/vitals", tt.handler)
+			engine.GET("/swagger/*any", tt.handler)
+
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(tt.method, tt.path, nil)
+			req.Header.Set("X-Request-Id", "req-1")
+			engine.ServeHTTP(w, req)
+
+			require.Len(t, writer.events, len(tt.wantEvents))
+			if len(tt.wantEvents) > 0 {
+				require.Equal(t, "req-1", w.Header().Get("X-Request-Id"))
+			}
+			for idx, want := range tt.wantEvents {
+				got := writer.events[idx]
+				require.Equal(t, "req-1", got.RequestID)
+				require.Equal(t, tt.path, got.Path)
+				require.Equal(t, want.PrincipalID, got.PrincipalID)
+				require.Equal(t, want.Action, got.Action)
+				require.Equal(t, want.PatientID, got.PatientID)
+				require.Equal(t, want.ResourceKey, got.ResourceKey)
+				require.Equal(t, want.Outcome, got.Outcome)
+				require.Equal(t, want.StatusCode, got.StatusCode)
+				require.Equal(t, want.ErrorCode, got.ErrorCode)
+			}
+		})
+	}
+}
+
+func strPtr(s string) *string {
+	return &s
+}
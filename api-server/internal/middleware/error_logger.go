@@ -12,7 +12,7 @@ func GinBusinessErrLogger() gin.HandlerFunc {
 			ctx.Next()
 			err := ctx.Errors.Last()
 			if err != nil {
-				pkgLogger.ZapLogger.Logger.Error(err.Error())
+				pkgLogger.FromContext(ctx.Request.Context()).Error(err.Error())
 			}
 		}
 	}
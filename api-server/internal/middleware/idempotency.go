@@ -0,0 +1,122 @@
+package middleware
+
+import (
+	"aitrics-vital-signs/api-server/domain/auth"
+	"aitrics-vital-signs/api-server/domain/idempotency"
+	"aitrics-vital-signs/api-server/internal/output"
+	"aitrics-vital-signs/api-server/pkg/constant"
+	pkgError "aitrics-vital-signs/library/error"
+	pkgLogger "aitrics-vital-signs/library/logger"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+const (
+	HeaderIdempotencyKey     = "Idempotency-Key"
+	HeaderIdempotentReplayed = "Idempotent-Replayed"
+	maxIdempotencyKeyLength  = 255
+	idempotencyHashSeparator = "\n"
+)
+
+// responseRecorder 저장을 위해 응답 body 를 함께 기록합니다.
+type responseRecorder struct {
+	gin.ResponseWriter
+	body bytes.Buffer
+}
+
+func (r *responseRecorder) Write(data []byte) (int, error) {
+	r.body.Write(data)
+	return r.ResponseWriter.Write(data)
+}
+
+func (r *responseRecorder) WriteString(s string) (int, error) {
+	r.body.WriteString(s)
+	return r.ResponseWriter.WriteString(s)
+}
+
+// IdempotencyMiddleware
+// Idempotency-Key 헤더가 있는 요청의 응답을 저장하고, 같은 key 로 다시 들어온 요청에는 저장된 응답을 그대로 반환합니다.
+// RequireScope 이후에 사용하며, key 는 인증 주체별로 구분됩니다. 5xx 응답은 저장하지 않아 같은 key 로 재시도할 수 있습니다.
+func IdempotencyMiddleware(service idempotency.IdempotencyService) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		key := ctx.GetHeader(HeaderIdempotencyKey)
+		if key == "" {
+			ctx.Next()
+			return
+		}
+		if len(key) > maxIdempotencyKeyLength {
+			output.AppendErrorContext(ctx, pkgError.WrapWithCode(pkgError.EmptyBusinessError(), pkgError.WrongParam, "idempotency key is too long"), nil)
+			return
+		}
+
+		principalID := constant.AnonymousPrincipalID
+		if principal, ok := auth.PrincipalFromContext(ctx.Request.Context()); ok {
+			principalID = principal.ID
+		}
+
+		body, err := io.ReadAll(ctx.Request.Body)
+		if err != nil {
+			output.AppendErrorContext(ctx, pkgError.WrapWithCode(err, pkgError.WrongParam, "fail to read request body"), nil)
+			return
+		}
+		ctx.Request.Body = io.NopCloser(bytes.NewReader(body))
+
+		path := ctx.Request.URL.RequestURI()
+		replay, err := service.Begin(ctx, idempotency.BeginRequest{
+			PrincipalID: principalID,
+			Key:         key,
+			Method:      ctx.Request.Method,
+			Path:        path,
+			RequestHash: requestHash(ctx.Request.Method, path, body),
+		})
+		if err != nil {
+			output.AppendErrorContext(ctx, pkgError.Wrap(err), nil)
+			return
+		}
+		if replay != nil {
+			ctx.Header(HeaderIdempotentReplayed, "true")
+			ctx.Data(*replay.StatusCode, replay.ContentType, replay.ResponseBody)
+			ctx.Abort()
+			return
+		}
+
+		recorder := &responseRecorder{ResponseWriter: ctx.Writer}
+		ctx.Writer = recorder
+		ctx.Next()
+
+		// 클라이언트 연결이 끊겨도 결과는 저장되어야 재시도에 응답할 수 있음
+		storeCtx := context.WithoutCancel(ctx.Request.Context())
+		status := recorder.Status()
+		if status >= http.StatusInternalServerError {
+			if err := service.Release(storeCtx, principalID, key); err != nil {
+				pkgLogger.FromContext(storeCtx).Warn("fail to release idempotency key", zap.Error(err))
+			}
+			return
+		}
+
+		if err := service.Complete(storeCtx, idempotency.CompleteRequest{
+			PrincipalID:  principalID,
+			Key:          key,
+			StatusCode:   status,
+			ContentType:  recorder.Header().Get("Content-Type"),
+			ResponseBody: recorder.body.Bytes(),
+		}); err != nil {
+			pkgLogger.FromContext(storeCtx).Warn("fail to store idempotent response", zap.Error(err))
+		}
+	}
+}
+
+// requestHash 같은 key 로 다른 요청을 보냈는지 확인하기 위한 method / 경로 / body 해시
+func requestHash(method, path string, body []byte) string {
+	hash := sha256.New()
+	hash.Write([]byte(method + idempotencyHashSeparator + path + idempotencyHashSeparator))
+	hash.Write(body)
+	return hex.EncodeToString(hash.Sum(nil))
+}
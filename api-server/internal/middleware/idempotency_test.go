@@ -0,0 +1,161 @@
+package middleware
+
+import (
+	"aitrics-vital-signs/api-server/domain/auth"
+	"aitrics-vital-signs/api-server/domain/idempotency"
+	"aitrics-vital-signs/api-server/domain/mock"
+	pkgError "aitrics-vital-signs/library/error"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_IdempotencyMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	const body = `{"patient_id":"P00001"}`
+	storedStatus := http.StatusCreated
+
+	tests := []struct {
+		name          string
+		key           string
+		handlerStatus int
+		setupMock     func(svc *mock.MockIdempotencyService)
+		wantStatus    int
+		wantBody      string
+		wantHandler   bool
+		wantReplayed  bool
+	}{
+		{
+			name:          "성공 - key 가 없으면 그대로 처리",
+			handlerStatus: http.StatusCreated,
+			setupMock:     func(svc *mock.MockIdempotencyService) {},
+			wantStatus:    http.StatusCreated,
+			wantBody:      `{"code":0}`,
+			wantHandler:   true,
+		},
+		{
+			name:          "성공 - 첫 요청의 응답 저장",
+			key:           "req-1",
+			handlerStatus: http.StatusCreated,
+			setupMock: func(svc *mock.MockIdempotencyService) {
+				svc.EXPECT().
+					Begin(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, request idempotency.BeginRequest) (*idempotency.IdempotencyKey, error) {
+						require.Equal(t, "key-1", request.PrincipalID)
+						require.Equal(t, "req-1", request.Key)
+						require.Equal(t, requestHash(http.MethodPost, "/api/v1/patients", []byte(body)), request.RequestHash)
+						return nil, nil
+					})
+				svc.EXPECT().
+					Complete(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, request idempotency.CompleteRequest) error {
+						require.Equal(t, http.StatusCreated, request.StatusCode)
+						require.Equal(t, `{"code":0}`, string(request.ResponseBody))
+						require.True(t, strings.HasPrefix(request.ContentType, gin.MIMEJSON))
+						return nil
+					})
+			},
+			wantStatus:  http.StatusCreated,
+			wantBody:    `{"code":0}`,
+			wantHandler: true,
+		},
+		{
+			name: "성공 - 재시도 요청은 저장된 응답 반환",
+			key:  "req-1",
+			setupMock: func(svc *mock.MockIdempotencyService) {
+				svc.EXPECT().
+					Begin(gomock.Any(), gomock.Any()).
+					Return(&idempotency.IdempotencyKey{StatusCode: &storedStatus, ContentType: gin.MIMEJSON, ResponseBody: []byte(`{"code":0,"stored":true}`)}, nil)
+			},
+			wantStatus:   http.StatusCreated,
+			wantBody:     `{"code":0,"stored":true}`,
+			wantReplayed: true,
+		},
+		{
+			name:          "성공 - 5xx 응답은 저장하지 않고 key 해제",
+			key:           "req-1",
+			handlerStatus: http.StatusInternalServerError,
+			setupMock: func(svc *mock.MockIdempotencyService) {
+				svc.EXPECT().Begin(gomock.Any(), gomock.Any()).Return(nil, nil)
+				svc.EXPECT().Release(gomock.Any(), "key-1", "req-1").Return(nil)
+			},
+			wantStatus:  http.StatusInternalServerError,
+			wantBody:    `{"code":0}`,
+			wantHandler: true,
+		},
+		{
+			name: "실패 - 다른 요청에 사용된 key",
+			key:  "req-1",
+			setupMock: func(svc *mock.MockIdempotencyService) {
+				svc.EXPECT().
+					Begin(gomock.Any(), gomock.Any()).
+					Return(nil, pkgError.WrapWithCode(pkgError.EmptyBusinessError(), pkgError.IdempotencyKeyReused))
+			},
+			wantStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name: "실패 - 처리 중인 key",
+			key:  "req-1",
+			setupMock: func(svc *mock.MockIdempotencyService) {
+				svc.EXPECT().
+					Begin(gomock.Any(), gomock.Any()).
+					Return(nil, pkgError.WrapWithCode(pkgError.EmptyBusinessError(), pkgError.Conflict))
+			},
+			wantStatus: http.StatusConflict,
+		},
+		{
+			name:       "실패 - 너무 긴 key",
+			key:        strings.Repeat("k", maxIdempotencyKeyLength+1),
+			setupMock:  func(svc *mock.MockIdempotencyService) {},
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			svc := mock.NewMockIdempotencyService(ctrl)
+			tt.setupMock(svc)
+
+			handled := false
+			engine := gin.New()
+			engine.POST("/api/v1/patients",
+				func(ctx *gin.Context) {
+					ctx.Request = ctx.Request.WithContext(auth.WithPrincipal(ctx.Request.Context(), &auth.Principal{ID: "key-1"}))
+					ctx.Next()
+				},
+				IdempotencyMiddleware(svc),
+				func(ctx *gin.Context) {
+					handled = true
+					// handler 도 body 를 그대로 읽을 수 있어야 함
+					read, err := io.ReadAll(ctx.Request.Body)
+					require.NoError(t, err)
+					require.Equal(t, body, string(read))
+					ctx.JSON(tt.handlerStatus, gin.H{"code": 0})
+				},
+			)
+
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/patients", strings.NewReader(body))
+			if tt.key != "" {
+				req.Header.Set(HeaderIdempotencyKey, tt.key)
+			}
+			w := httptest.NewRecorder()
+			engine.ServeHTTP(w, req)
+
+			require.Equal(t, tt.wantStatus, w.Code)
+			require.Equal(t, tt.wantHandler, handled)
+			if tt.wantBody != "" {
+				require.Equal(t, tt.wantBody, w.Body.String())
+			}
+			require.Equal(t, tt.wantReplayed, w.Header().Get(HeaderIdempotentReplayed) == "true")
+		})
+	}
+}
@@ -0,0 +1,229 @@
+package middleware
+
+import (
+	pkgError "aitrics-vital-signs/library/error"
+	pkgLogger "aitrics-vital-signs/library/logger"
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+)
+
+const (
+	jwksFetchTimeout = 5 * time.Second
+	// 알 수 없는 kid 로 인한 과도한 재조회를 막기 위한 최소 refresh 간격
+	jwksMinRefreshInterval = 30 * time.Second
+	jwksMaxBodyBytes       = 1 << 20
+)
+
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+type jsonWebKeySet struct {
+	Keys []jsonWebKey `json:"keys"`
+}
+
+// JWKSProvider
+// 로컬 파일 혹은 URL 의 JWKS 문서를 캐싱합니다.
+// 캐시에 없는 kid 가 들어오면 (key rotation) 최소 간격을 두고 다시 읽어오며, 재조회 실패 시 기존 키를 계속 사용합니다.
+// 조회는 lock 밖에서 singleflight 로 한 번만 수행하고 키 목록 교체만 lock 안에서 하므로, 갱신 중에도 캐시된 키로 검증하는 요청은 대기하지 않습니다.
+type JWKSProvider struct {
+	source string
+	ttl    time.Duration
+	client *http.Client
+	group  singleflight.Group
+
+	mu          sync.RWMutex
+	keys        map[string]crypto.PublicKey
+	fetchedAt   time.Time
+	lastAttempt time.Time
+	lastErr     error // 마지막 조회 실패 (최소 간격 이내 재요청에 그대로 반환)
+}
+
+func NewJWKSProvider(source string, ttl time.Duration) *JWKSProvider {
+	return &JWKSProvider{
+		source: source,
+		ttl:    ttl,
+		client: &http.Client{Timeout: jwksFetchTimeout},
+		keys:   map[string]crypto.PublicKey{},
+	}
+}
+
+// Key
+// JWKS 조회 실패로 키를 확인할 수 없으면 토큰 문제와 구분할 수 있도록 AuthUnavailable 을 반환합니다.
+func (p *JWKSProvider) Key(ctx context.Context, kid string) (crypto.PublicKey, error) {
+	p.mu.RLock()
+	key, ok := p.keys[kid]
+	fresh := time.Since(p.fetchedAt) < p.ttl
+	p.mu.RUnlock()
+
+	if ok && fresh {
+		return key, nil
+	}
+
+	if err := p.refresh(ctx); err != nil {
+		if ok {
+			logJWKSWarn("jwks refresh failed, using cached key: " + err.Error())
+			return key, nil
+		}
+		return nil, pkgError.WrapWithCode(err, pkgError.AuthUnavailable, "fail to fetch jwks")
+	}
+
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	if key, ok := p.keys[kid]; ok {
+		return key, nil
+	}
+
+	return nil, fmt.Errorf("unknown jwks kid: %s", kid)
+}
+
+func (p *JWKSProvider) refresh(ctx context.Context) error {
+	// 먼저 호출한 요청이 취소되어도 함께 기다리는 요청의 조회는 계속되도록 cancel 을 끊습니다. (client timeout 적용)
+	ctx = context.WithoutCancel(ctx)
+
+	_, err, _ := p.group.Do("refresh", func() (interface{}, error) {
+		p.mu.Lock()
+		// 다른 요청이 이미 갱신했거나, 최소 간격 이내에 재시도하는 경우
+		if time.Since(p.fetchedAt) < jwksMinRefreshInterval {
+			p.mu.Unlock()
+			return nil, nil
+		}
+		if time.Since(p.lastAttempt) < jwksMinRefreshInterval {
+			err := p.lastErr
+			p.mu.Unlock()
+			return nil, err
+		}
+		p.lastAttempt = time.Now()
+		p.mu.Unlock()
+
+		keys, err := p.fetch(ctx)
+
+		p.mu.Lock()
+		defer p.mu.Unlock()
+		p.lastErr = err
+		if err != nil {
+			return nil, err
+		}
+		p.keys = keys
+		p.fetchedAt = time.Now()
+		return nil, nil
+	})
+	return err
+}
+
+func (p *JWKSProvider) fetch(ctx context.Context) (map[string]crypto.PublicKey, error) {
+	raw, err := p.load(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var set jsonWebKeySet
+	if err := json.Unmarshal(raw, &set); err != nil {
+		return nil, fmt.Errorf("fail to parse jwks: %w", err)
+	}
+
+	keys := make(map[string]crypto.PublicKey, len(set.Keys))
+	for _, jwk := range set.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+
+		key, err := parseJSONWebKey(jwk)
+		if err != nil {
+			logJWKSWarn(fmt.Sprintf("skip jwks kid %s: %s", jwk.Kid, err.Error()))
+			continue
+		}
+		keys[jwk.Kid] = key
+	}
+
+	return keys, nil
+}
+
+func (p *JWKSProvider) load(ctx context.Context) ([]byte, error) {
+	if !strings.HasPrefix(p.source, "http://") && !strings.HasPrefix(p.source, "https://") {
+		return os.ReadFile(strings.TrimPrefix(p.source, "file://"))
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.source, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("fail to fetch jwks: status %d", resp.StatusCode)
+	}
+
+	return io.ReadAll(io.LimitReader(resp.Body, jwksMaxBodyBytes))
+}
+
+func parseJSONWebKey(jwk jsonWebKey) (crypto.PublicKey, error) {
+	switch jwk.Kty {
+	case "RSA":
+		n, err := base64.RawURLEncoding.DecodeString(jwk.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := base64.RawURLEncoding.DecodeString(jwk.E)
+		if err != nil {
+			return nil, err
+		}
+		return &rsa.PublicKey{
+			N: new(big.Int).SetBytes(n),
+			E: int(new(big.Int).SetBytes(e).Int64()),
+		}, nil
+	case "EC":
+		if jwk.Crv != "P-256" {
+			return nil, fmt.Errorf("unsupported curve: %s", jwk.Crv)
+		}
+		x, err := base64.RawURLEncoding.DecodeString(jwk.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := base64.RawURLEncoding.DecodeString(jwk.Y)
+		if err != nil {
+			return nil, err
+		}
+		if len(x) != 32 || len(y) != 32 {
+			return nil, fmt.Errorf("invalid P-256 coordinate length")
+		}
+		// uncompressed point: 0x04 || X || Y
+		return ecdsa.ParseUncompressedPublicKey(elliptic.P256(), append(append([]byte{4}, x...), y...))
+	default:
+		return nil, fmt.Errorf("unsupported key type: %s", jwk.Kty)
+	}
+}
+
+func logJWKSWarn(msg string) {
+	if pkgLogger.ZapLogger != nil {
+		pkgLogger.ZapLogger.Logger.Warn(msg)
+	}
+}
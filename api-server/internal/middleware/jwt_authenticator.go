@@ -0,0 +1,151 @@
+package middleware
+
+import (
+	"aitrics-vital-signs/api-server/domain/auth"
+	"aitrics-vital-signs/api-server/pkg/constant"
+	pkgError "aitrics-vital-signs/library/error"
+	"context"
+	"errors"
+	"slices"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+var jwtValidMethods = []string{jwt.SigningMethodRS256.Alg(), jwt.SigningMethodES256.Alg()}
+
+type JWTConfig struct {
+	Issuer    string
+	Audience  string
+	RoleClaim string // 중첩 claim 은 '.' 로 구분 (ex. realm_access.roles)
+}
+
+type jwtAuthenticator struct {
+	keys   *JWKSProvider
+	config JWTConfig
+}
+
+// Authenticate
+// RS256 / ES256 서명과 exp / iss / aud 를 검증하고, role claim 을 RolePermissions 에 따라 scope 로 변환합니다.
+func (j *jwtAuthenticator) Authenticate(ctx context.Context, token string) (*auth.Principal, error) {
+	options := []jwt.ParserOption{
+		jwt.WithValidMethods(jwtValidMethods),
+		jwt.WithExpirationRequired(),
+	}
+	if j.config.Issuer != "" {
+		options = append(options, jwt.WithIssuer(j.config.Issuer))
+	}
+	if j.config.Audience != "" {
+		options = append(options, jwt.WithAudience(j.config.Audience))
+	}
+
+	claims := jwt.MapClaims{}
+	_, err := jwt.ParseWithClaims(token, claims, func(t *jwt.Token) (interface{}, error) {
+		kid, _ := t.Header["kid"].(string)
+		return j.keys.Key(ctx, kid)
+	}, options...)
+	if err != nil {
+		switch {
+		case pkgError.CompareBusinessError(err, pkgError.AuthUnavailable):
+			return nil, pkgError.WrapWithCode(err, pkgError.AuthUnavailable)
+		case errors.Is(err, jwt.ErrTokenSignatureInvalid), errors.Is(err, jwt.ErrTokenUnverifiable):
+			return nil, pkgError.WrapWithCode(err, pkgError.InvalidTokenSignature)
+		case errors.Is(err, jwt.ErrTokenExpired):
+			return nil, pkgError.WrapWithCode(err, pkgError.TokenExpired)
+		default:
+			return nil, pkgError.WrapWithCode(err, pkgError.Unauthorized, "invalid token")
+		}
+	}
+
+	subject, _ := claims.GetSubject()
+	if subject == "" {
+		return nil, pkgError.WrapWithCode(pkgError.EmptyBusinessError(), pkgError.Unauthorized, "sub claim is required")
+	}
+
+	roles := make([]string, 0)
+	scopes := make([]string, 0)
+	for _, role := range extractRoles(claims, j.config.RoleClaim) {
+		permissions, ok := constant.RolePermissions[constant.Role(role)]
+		if !ok {
+			continue
+		}
+		roles = append(roles, role)
+		for _, scope := range permissions {
+			if !slices.Contains(scopes, scope.String()) {
+				scopes = append(scopes, scope.String())
+			}
+		}
+	}
+
+	if len(roles) == 0 {
+		return nil, pkgError.WrapWithCode(pkgError.EmptyBusinessError(), pkgError.MissingRole, "no known role in token")
+	}
+
+	name := subject
+	for _, key := range []string{"name", "preferred_username"} {
+		if v, ok := claims[key].(string); ok && v != "" {
+			name = v
+			break
+		}
+	}
+
+	return &auth.Principal{
+		ID:     subject,
+		Name:   name,
+		Type:   constant.PrincipalTypeJWT.String(),
+		Roles:  roles,
+		Scopes: scopes,
+	}, nil
+}
+
+// extractRoles role claim 은 문자열 배열 혹은 공백으로 구분된 문자열을 허용합니다.
+func extractRoles(claims jwt.MapClaims, claimPath string) []string {
+	var current interface{} = map[string]interface{}(claims)
+	for _, key := range strings.Split(claimPath, ".") {
+		m, ok := current.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		current = m[key]
+	}
+
+	switch v := current.(type) {
+	case string:
+		return strings.Fields(v)
+	case []interface{}:
+		roles := make([]string, 0, len(v))
+		for _, item := range v {
+			if s, ok := item.(string); ok {
+				roles = append(roles, s)
+			}
+		}
+		return roles
+	default:
+		return nil
+	}
+}
+
+func NewJWTAuthenticator(keys *JWKSProvider, config JWTConfig) auth.Authenticator {
+	if config.RoleClaim == "" {
+		config.RoleClaim = "roles"
+	}
+	return &jwtAuthenticator{keys: keys, config: config}
+}
+
+type bearerAuthenticator struct {
+	apiKey  auth.Authenticator
+	jwtAuth auth.Authenticator
+}
+
+// Authenticate JWT 형식(header.payload.signature)의 토큰은 JWT 로, 그 외에는 API Key 로 검증합니다.
+func (b *bearerAuthenticator) Authenticate(ctx context.Context, token string) (*auth.Principal, error) {
+	if b.jwtAuth != nil && strings.Count(token, ".") == 2 {
+		return b.jwtAuth.Authenticate(ctx, token)
+	}
+	return b.apiKey.Authenticate(ctx, token)
+}
+
+// NewBearerAuthenticator jwtAuth 가 nil 이면 API Key 만 사용합니다.
+func NewBearerAuthenticator(apiKey auth.Authenticator, jwtAuth auth.Authenticator) auth.Authenticator {
+	return &bearerAuthenticator{apiKey: apiKey, jwtAuth: jwtAuth}
+}
@@ -0,0 +1,253 @@
+package middleware
+
+import (
+	pkgError "aitrics-vital-signs/library/error"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/require"
+)
+
+type testSigner struct {
+	rsaKey *rsa.PrivateKey
+	ecKey  *ecdsa.PrivateKey
+}
+
+func newTestSigner(t *testing.T) *testSigner {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	return &testSigner{rsaKey: rsaKey, ecKey: ecKey}
+}
+
+func (s *testSigner) jwks(t *testing.T, rsaKid string) []byte {
+	ecBytes, err := s.ecKey.PublicKey.Bytes()
+	require.NoError(t, err)
+
+	raw, err := json.Marshal(jsonWebKeySet{Keys: []jsonWebKey{
+		{
+			Kty: "RSA",
+			Kid: rsaKid,
+			Use: "sig",
+			N:   base64.RawURLEncoding.EncodeToString(s.rsaKey.N.Bytes()),
+			E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(s.rsaKey.E)).Bytes()),
+		},
+		{
+			Kty: "EC",
+			Kid: "ec-1",
+			Crv: "P-256",
+			X:   base64.RawURLEncoding.EncodeToString(ecBytes[1:33]),
+			Y:   base64.RawURLEncoding.EncodeToString(ecBytes[33:]),
+		},
+	}})
+	require.NoError(t, err)
+	return raw
+}
+
+func (s *testSigner) sign(t *testing.T, method jwt.SigningMethod, kid string, claims jwt.MapClaims) string {
+	token := jwt.NewWithClaims(method, claims)
+	token.Header["kid"] = kid
+
+	var key interface{} = s.rsaKey
+	if method == jwt.SigningMethodES256 {
+		key = s.ecKey
+	}
+
+	signed, err := token.SignedString(key)
+	require.NoError(t, err)
+	return signed
+}
+
+func validClaims(roles interface{}) jwt.MapClaims {
+	return jwt.MapClaims{
+		"sub":   "user-1",
+		"name":  "김간호",
+		"iss":   "https://idp.hospital.local",
+		"aud":   "vital-signs",
+		"exp":   time.Now().Add(time.Hour).Unix(),
+		"roles": roles,
+	}
+}
+
+func Test_JWTAuthenticator(t *testing.T) {
+	signer := newTestSigner(t)
+	other := newTestSigner(t)
+
+	jwksPath := filepath.Join(t.TempDir(), "jwks.json")
+	require.NoError(t, os.WriteFile(jwksPath, signer.jwks(t, "rsa-1"), 0o600))
+
+	authenticator := NewJWTAuthenticator(NewJWKSProvider(jwksPath, time.Hour), JWTConfig{
+		Issuer:   "https://idp.hospital.local",
+		Audience: "vital-signs",
+	})
+
+	expired := validClaims([]interface{}{"nurse"})
+	expired["exp"] = time.Now().Add(-time.Minute).Unix()
+
+	tests := []struct {
+		name       string
+		token      string
+		wantCode   pkgError.Code
+		wantScopes []string
+	}{
+		{
+			name:       "성공 - RS256 nurse",
+			token:      signer.sign(t, jwt.SigningMethodRS256, "rsa-1", validClaims([]interface{}{"nurse"})),
+			wantScopes: []string{"patients:read", "vitals:read", "vitals:write", "inference:run"},
+		},
+		{
+			name:       "성공 - ES256 device (공백 구분 문자열)",
+			token:      signer.sign(t, jwt.SigningMethodES256, "ec-1", validClaims("device unknown")),
+			wantScopes: []string{"vitals:write"},
+		},
+		{
+			name:     "실패 - 다른 키로 서명",
+			token:    other.sign(t, jwt.SigningMethodRS256, "rsa-1", validClaims([]interface{}{"nurse"})),
+			wantCode: pkgError.InvalidTokenSignature,
+		},
+		{
+			name:     "실패 - 알 수 없는 kid",
+			token:    signer.sign(t, jwt.SigningMethodRS256, "rsa-unknown", validClaims([]interface{}{"nurse"})),
+			wantCode: pkgError.InvalidTokenSignature,
+		},
+		{
+			name: "실패 - HS256 은 허용하지 않음",
+			token: func() string {
+				signed, _ := jwt.NewWithClaims(jwt.SigningMethodHS256, validClaims([]interface{}{"admin"})).SignedString([]byte("secret"))
+				return signed
+			}(),
+			wantCode: pkgError.InvalidTokenSignature,
+		},
+		{
+			name:     "실패 - 만료된 토큰",
+			token:    signer.sign(t, jwt.SigningMethodRS256, "rsa-1", expired),
+			wantCode: pkgError.TokenExpired,
+		},
+		{
+			name:     "실패 - 알려진 role 없음",
+			token:    signer.sign(t, jwt.SigningMethodRS256, "rsa-1", validClaims([]interface{}{"visitor"})),
+			wantCode: pkgError.MissingRole,
+		},
+		{
+			name: "실패 - 다른 audience",
+			token: func() string {
+				claims := validClaims([]interface{}{"nurse"})
+				claims["aud"] = "other-service"
+				return signer.sign(t, jwt.SigningMethodRS256, "rsa-1", claims)
+			}(),
+			wantCode: pkgError.Unauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			principal, err := authenticator.Authenticate(context.Background(), tt.token)
+
+			if tt.wantCode != 0 {
+				require.Error(t, err)
+				require.True(t, pkgError.CompareBusinessError(err, tt.wantCode), err.Error())
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, "user-1", principal.ID)
+			require.Equal(t, "김간호", principal.Name)
+			require.ElementsMatch(t, tt.wantScopes, principal.Scopes)
+		})
+	}
+}
+
+func Test_JWKSProvider_Rotation(t *testing.T) {
+	first := newTestSigner(t)
+	second := newTestSigner(t)
+
+	current := first.jwks(t, "rsa-1")
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(current)
+	}))
+	defer server.Close()
+
+	provider := NewJWKSProvider(server.URL, time.Hour)
+	_, err := provider.Key(context.Background(), "rsa-1")
+	require.NoError(t, err)
+
+	// IdP 가 키를 교체한 뒤 최소 refresh 간격이 지나면 새 키를 읽어옵니다.
+	current = second.jwks(t, "rsa-2")
+	provider.fetchedAt = time.Now().Add(-jwksMinRefreshInterval)
+	provider.lastAttempt = time.Now().Add(-jwksMinRefreshInterval)
+
+	key, err := provider.Key(context.Background(), "rsa-2")
+	require.NoError(t, err)
+	require.True(t, second.rsaKey.PublicKey.Equal(key))
+}
+
+func Test_JWKSProvider_Unavailable(t *testing.T) {
+	signer := newTestSigner(t)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	authenticator := NewJWTAuthenticator(NewJWKSProvider(server.URL, time.Hour), JWTConfig{})
+	token := signer.sign(t, jwt.SigningMethodRS256, "rsa-1", validClaims([]interface{}{"nurse"}))
+
+	// IdP 장애는 토큰 서명 오류가 아닌 AuthUnavailable 로 응답하며, 최소 간격 이내 재요청도 같은 결과
+	for range 2 {
+		_, err := authenticator.Authenticate(context.Background(), token)
+		require.True(t, pkgError.CompareBusinessError(err, pkgError.AuthUnavailable), err.Error())
+	}
+}
+
+func Test_JWKSProvider_RefreshDoesNotBlockCachedKeys(t *testing.T) {
+	first := newTestSigner(t)
+
+	release := make(chan struct{})
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if requests.Add(1) > 1 {
+			<-release
+		}
+		_, _ = w.Write(first.jwks(t, "rsa-1"))
+	}))
+	defer server.Close()
+	defer close(release)
+
+	provider := NewJWKSProvider(server.URL, time.Hour)
+	_, err := provider.Key(context.Background(), "rsa-1")
+	require.NoError(t, err)
+
+	// 알 수 없는 kid 로 갱신이 진행되는 동안
+	provider.mu.Lock()
+	provider.fetchedAt = time.Now().Add(-jwksMinRefreshInterval)
+	provider.lastAttempt = time.Now().Add(-jwksMinRefreshInterval)
+	provider.mu.Unlock()
+	go func() { _, _ = provider.Key(context.Background(), "rsa-2") }()
+	require.Eventually(t, func() bool { return requests.Load() == 2 }, time.Second, 10*time.Millisecond)
+
+	// 캐시된 키 조회는 대기하지 않음
+	done := make(chan struct{})
+	go func() {
+		_, _ = provider.Key(context.Background(), "rsa-1")
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("cached key lookup blocked by jwks refresh")
+	}
+}
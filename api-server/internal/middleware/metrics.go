@@ -0,0 +1,32 @@
+package middleware
+
+import (
+	"aitrics-vital-signs/api-server/internal/metrics"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// unmatchedRoute 등록되지 않은 경로는 하나의 label 로 묶어 cardinality 증가를 막습니다.
+const unmatchedRoute = "unmatched"
+
+// MetricsMiddleware 요청 처리 시간을 method / route / status 별로 기록합니다.
+func MetricsMiddleware() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		start := time.Now()
+		metrics.HTTPRequestsInFlight.Inc()
+		defer metrics.HTTPRequestsInFlight.Dec()
+
+		ctx.Next()
+
+		route := ctx.FullPath()
+		if route == "" {
+			route = unmatchedRoute
+		}
+
+		metrics.HTTPRequestDuration.
+			WithLabelValues(ctx.Request.Method, route, strconv.Itoa(ctx.Writer.Status())).
+			Observe(time.Since(start).Seconds())
+	}
+}
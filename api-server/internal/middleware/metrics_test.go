@@ -0,0 +1,54 @@
+package middleware
+
+import (
+	"aitrics-vital-signs/api-server/internal/metrics"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_MetricsMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	engine := gin.New()
+	engine.Use(MetricsMiddleware())
+	engine.GET("/api/v1/patients/:patient_id", func(ctx *gin.Context) {
+		ctx.Status(http.StatusConflict)
+	})
+
+	countOf := func(route, status string) uint64 {
+		return sampleCount(t, metrics.HTTPRequestDuration.WithLabelValues(http.MethodGet, route, status))
+	}
+
+	tests := []struct {
+		name   string
+		path   string
+		route  string
+		status string
+	}{
+		{name: "성공 - path parameter 치환 전 route 로 기록", path: "/api/v1/patients/P00001", route: "/api/v1/patients/:patient_id", status: "409"},
+		{name: "성공 - 등록되지 않은 경로는 unmatched 로 기록", path: "/unknown/P00001", route: unmatchedRoute, status: "404"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			engine.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, tt.path, nil))
+			require.Equal(t, uint64(1), countOf(tt.route, tt.status))
+		})
+	}
+
+	require.Zero(t, testutil.ToFloat64(metrics.HTTPRequestsInFlight))
+}
+
+// sampleCount histogram 의 관측 횟수
+func sampleCount(t *testing.T, observer prometheus.Observer) uint64 {
+	var out dto.Metric
+	require.NoError(t, observer.(prometheus.Metric).Write(&out))
+	return out.GetHistogram().GetSampleCount()
+}
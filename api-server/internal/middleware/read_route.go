@@ -0,0 +1,22 @@
+package middleware
+
+import (
+	"aitrics-vital-signs/api-server/domain"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+// readYourWritesHeader 직전 요청에서 쓴 값을 바로 읽어야 하는 클라이언트가 "true" 로 전달합니다.
+const readYourWritesHeader = "X-Read-Your-Writes"
+
+// ReadRouteMiddleware
+// 요청마다 읽기 경로를 context 에 담아, 같은 요청에서 쓰기가 발생하면 이후 읽기를 primary 에서 처리합니다.
+// X-Read-Your-Writes: true 인 요청은 처음부터 primary 에서 읽습니다. (replica 미사용 시 영향 없음)
+func ReadRouteMiddleware() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		primary, _ := strconv.ParseBool(ctx.GetHeader(readYourWritesHeader))
+		ctx.Request = ctx.Request.WithContext(domain.WithReadRoute(ctx.Request.Context(), primary))
+		ctx.Next()
+	}
+}
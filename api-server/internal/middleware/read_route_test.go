@@ -0,0 +1,53 @@
+package middleware
+
+import (
+	"aitrics-vital-signs/api-server/domain"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ReadRouteMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name            string
+		header          string
+		write           bool
+		wantBeforeWrite bool
+		wantAfterWrite  bool
+	}{
+		{name: "성공 - 기본은 replica 에서 읽음", wantBeforeWrite: false, wantAfterWrite: false},
+		{name: "성공 - 같은 요청에서 쓰기 이후에는 primary 에서 읽음", write: true, wantBeforeWrite: false, wantAfterWrite: true},
+		{name: "성공 - X-Read-Your-Writes 요청은 처음부터 primary", header: "true", wantBeforeWrite: true, wantAfterWrite: true},
+		{name: "성공 - 잘못된 header 값은 무시", header: "yes", wantBeforeWrite: false, wantAfterWrite: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var beforeWrite, afterWrite bool
+			engine := gin.New()
+			engine.Use(ReadRouteMiddleware())
+			engine.GET("/api/v1/patients/:patient_id", func(ctx *gin.Context) {
+				beforeWrite = domain.ReadFromPrimary(ctx.Request.Context())
+				if tt.write {
+					domain.MarkWritten(ctx.Request.Context())
+				}
+				afterWrite = domain.ReadFromPrimary(ctx.Request.Context())
+				ctx.Status(http.StatusOK)
+			})
+
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/patients/P00001", nil)
+			if tt.header != "" {
+				req.Header.Set(readYourWritesHeader, tt.header)
+			}
+			engine.ServeHTTP(httptest.NewRecorder(), req)
+
+			require.Equal(t, tt.wantBeforeWrite, beforeWrite)
+			require.Equal(t, tt.wantAfterWrite, afterWrite)
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package middleware
+
+import (
+	pkgLogger "aitrics-vital-signs/library/logger"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+const (
+	requestIDHeader = "X-Request-Id"
+	// maxRequestIDLength 외부에서 전달된 값이 로그 / 감사 기록을 오염시키지 않도록 길이와 문자를 제한
+	maxRequestIDLength = 128
+)
+
+// RequestIDMiddleware
+// 요청 header 의 X-Request-Id 를 이어받거나 새로 생성해 응답 header 와 로그 context 에 설정합니다.
+// path 의 patient_id 도 함께 로그 field 로 추가합니다.
+func RequestIDMiddleware() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		requestID := ctx.GetHeader(requestIDHeader)
+		if !validRequestID(requestID) {
+			requestID = uuid.NewString()
+		}
+		ctx.Header(requestIDHeader, requestID)
+		ctx.Request = ctx.Request.WithContext(pkgLogger.NewContext(ctx.Request.Context(), requestID))
+
+		if patientID := ctx.Param("patient_id"); patientID != "" {
+			pkgLogger.SetPatientID(ctx.Request.Context(), patientID)
+		}
+
+		ctx.Next()
+	}
+}
+
+func validRequestID(requestID string) bool {
+	if requestID == "" || len(requestID) > maxRequestIDLength {
+		return false
+	}
+	for _, r := range requestID {
+		if r < '!' || r > '~' {
+			return false
+		}
+	}
+	return true
+}
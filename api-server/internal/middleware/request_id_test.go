@@ -0,0 +1,55 @@
+package middleware
+
+import (
+	pkgLogger "aitrics-vital-signs/library/logger"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_RequestIDMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name      string
+		requestID string
+		wantSame  bool
+	}{
+		{name: "성공 - 전달된 요청 ID 사용", requestID: "req-1", wantSame: true},
+		{name: "성공 - 요청 ID 가 없으면 생성", requestID: ""},
+		{name: "성공 - 허용되지 않는 문자가 포함되면 새로 생성", requestID: "req 1\n"},
+		{name: "성공 - 너무 긴 요청 ID 는 새로 생성", requestID: strings.Repeat("a", maxRequestIDLength+1)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotRequestID string
+			engine := gin.New()
+			engine.Use(RequestIDMiddleware())
+			engine.GET("/api/v1/patients/:patient_id", func(ctx *gin.Context) {
+				gotRequestID = pkgLogger.RequestIDFromContext(ctx.Request.Context())
+				ctx.Status(http.StatusOK)
+			})
+
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/patients/P00001", nil)
+			if tt.requestID != "" {
+				req.Header.Set(requestIDHeader, tt.requestID)
+			}
+			w := httptest.NewRecorder()
+			engine.ServeHTTP(w, req)
+
+			require.Equal(t, gotRequestID, w.Header().Get(requestIDHeader))
+			if tt.wantSame {
+				require.Equal(t, tt.requestID, gotRequestID)
+			} else {
+				_, err := uuid.Parse(gotRequestID)
+				require.NoError(t, err)
+			}
+		})
+	}
+}
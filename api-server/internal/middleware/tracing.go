@@ -0,0 +1,53 @@
+package middleware
+
+import (
+	"aitrics-vital-signs/api-server/internal/tracing"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.40.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// TracingMiddleware
+// 요청 header 의 W3C traceparent 를 이어받아 server span 을 생성하고 request context 에 담습니다.
+// 응답 header 에도 traceparent 를 추가해 클라이언트가 trace 를 조회할 수 있도록 합니다.
+// controller 가 *gin.Context 를 그대로 전달하므로 engine.ContextWithFallback 을 켜야 하위 span 이 연결됩니다.
+func TracingMiddleware() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		propagator := otel.GetTextMapPropagator()
+		parent := propagator.Extract(ctx.Request.Context(), propagation.HeaderCarrier(ctx.Request.Header))
+
+		route := ctx.FullPath()
+		if route == "" {
+			route = unmatchedRoute
+		}
+
+		spanCtx, span := tracing.Start(parent, ctx.Request.Method+" "+route,
+			trace.WithSpanKind(trace.SpanKindServer),
+			trace.WithAttributes(
+				semconv.HTTPRequestMethodKey.String(ctx.Request.Method),
+				semconv.HTTPRoute(route),
+				semconv.URLPath(ctx.Request.URL.Path),
+			),
+		)
+		defer span.End()
+
+		ctx.Request = ctx.Request.WithContext(spanCtx)
+		propagator.Inject(spanCtx, propagation.HeaderCarrier(ctx.Writer.Header()))
+
+		ctx.Next()
+
+		status := ctx.Writer.Status()
+		span.SetAttributes(semconv.HTTPResponseStatusCode(status))
+		if err := ctx.Errors.Last(); err != nil {
+			span.RecordError(err.Err)
+		}
+		if status >= http.StatusInternalServerError {
+			span.SetStatus(codes.Error, http.StatusText(status))
+		}
+	}
+}
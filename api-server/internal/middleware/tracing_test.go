@@ -0,0 +1,55 @@
+package middleware
+
+import (
+	"aitrics-vital-signs/api-server/internal/tracing"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func Test_TracingMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	recorder := tracetest.NewSpanRecorder()
+	provider := tracing.NewTracerProvider(sdktrace.WithSpanProcessor(recorder), tracing.Config{ServiceName: "test", SampleRatio: 1})
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	defer provider.Shutdown(context.Background())
+
+	engine := gin.New()
+	engine.ContextWithFallback = true
+	engine.Use(TracingMiddleware())
+	engine.GET("/api/v1/patients/:patient_id", func(ctx *gin.Context) {
+		// service 와 같이 *gin.Context 를 그대로 context 로 사용
+		_, span := tracing.Start(ctx, "PatientService.GetPatient")
+		span.End()
+		ctx.Status(http.StatusInternalServerError)
+	})
+
+	const traceID = "4bf92f3577b34da6a3ce929d0e0e4736"
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/patients/P00001", nil)
+	req.Header.Set("traceparent", "00-"+traceID+"-00f067aa0ba902b7-01")
+	rec := httptest.NewRecorder()
+	engine.ServeHTTP(rec, req)
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 2)
+	child, server := spans[0], spans[1]
+
+	require.Equal(t, "GET /api/v1/patients/:patient_id", server.Name())
+	require.Equal(t, traceID, server.SpanContext().TraceID().String())
+	require.Equal(t, "00f067aa0ba902b7", server.Parent().SpanID().String())
+	require.Equal(t, codes.Error, server.Status().Code)
+
+	require.Equal(t, server.SpanContext().SpanID(), child.Parent().SpanID())
+	require.Contains(t, rec.Header().Get("traceparent"), traceID)
+}
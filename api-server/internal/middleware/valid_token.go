@@ -1,40 +1,62 @@
 package middleware
 
 import (
-	"aitrics-vital-signs/library/envs"
-	"net/http"
+	"aitrics-vital-signs/api-server/domain/auth"
+	"aitrics-vital-signs/api-server/internal/output"
+	"aitrics-vital-signs/api-server/pkg/constant"
+	pkgError "aitrics-vital-signs/library/error"
+	pkgLogger "aitrics-vital-signs/library/logger"
+	"fmt"
 	"strings"
 
 	"github.com/gin-gonic/gin"
 )
 
-func ValidTokenMiddleware() gin.HandlerFunc {
+// ValidTokenMiddleware
+// Bearer 토큰을 authenticator 로 검증하고, 확인된 Principal 을 gin context 에 저장합니다.
+func ValidTokenMiddleware(authenticator auth.Authenticator) gin.HandlerFunc {
 	return func(ctx *gin.Context) {
 		authHeader := ctx.GetHeader("Authorization")
 		if authHeader == "" {
-			ctx.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{
-				"error": "authorization header missing",
-			})
+			output.AppendErrorContext(ctx, pkgError.WrapWithCode(pkgError.EmptyBusinessError(), pkgError.Unauthorized, "authorization header missing"), nil)
 			return
 		}
 
 		parts := strings.SplitN(authHeader, " ", 2)
-		if len(parts) != 2 || !strings.EqualFold(parts[0], "Bearer") {
-			ctx.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{
-				"error": "invalid authorization header format",
-			})
+		if len(parts) != 2 || !strings.EqualFold(parts[0], "Bearer") || parts[1] == "" {
+			output.AppendErrorContext(ctx, pkgError.WrapWithCode(pkgError.EmptyBusinessError(), pkgError.Unauthorized, "invalid authorization header format"), nil)
 			return
 		}
 
-		token := parts[1]
+		principal, err := authenticator.Authenticate(ctx, parts[1])
+		if err != nil {
+			output.AppendErrorContext(ctx, pkgError.Wrap(err), nil)
+			return
+		}
 
-		if token != envs.Token {
-			ctx.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{
-				"error": "token is expired",
-			})
+		ctx.Request = ctx.Request.WithContext(auth.WithPrincipal(ctx.Request.Context(), principal))
+		pkgLogger.SetPrincipal(ctx.Request.Context(), principal.ID)
+		ctx.Next()
+	}
+}
+
+// RequireScope
+// ValidTokenMiddleware 이후에 사용하며, Principal 이 모든 scope 를 가지고 있어야 합니다.
+func RequireScope(scopes ...constant.Scope) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		principal, ok := auth.PrincipalFromContext(ctx.Request.Context())
+		if !ok {
+			output.AppendErrorContext(ctx, pkgError.WrapWithCode(pkgError.EmptyBusinessError(), pkgError.Unauthorized, "principal not found"), nil)
 			return
 		}
 
+		for _, scope := range scopes {
+			if !principal.HasScope(scope) {
+				output.AppendErrorContext(ctx, pkgError.WrapWithCode(pkgError.EmptyBusinessError(), pkgError.Forbidden, fmt.Sprintf("missing scope: %s", scope)), nil)
+				return
+			}
+		}
+
 		ctx.Next()
 	}
 }
@@ -0,0 +1,404 @@
+package migration
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io/fs"
+	"regexp"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+)
+
+const (
+	// 동시에 여러 인스턴스가 migration 을 실행하지 않도록 사용하는 MySQL named lock
+	lockName = "aitrics_schema_migrations"
+
+	// MySQL ER_NO_SUCH_TABLE (migration 을 한 번도 실행하지 않은 DB)
+	errNoSuchTable = 1146
+
+	createSchemaMigrationsSQL = "CREATE TABLE IF NOT EXISTS `schema_migrations` (" +
+		"`version` bigint unsigned NOT NULL COMMENT '버전', " +
+		"`name` varchar(255) NOT NULL COMMENT '이름', " +
+		"`dirty` tinyint(1) NOT NULL DEFAULT '0' COMMENT '적용 중 실패 여부', " +
+		"`applied_at` datetime(3) NOT NULL COMMENT '적용일', " +
+		"PRIMARY KEY (`version`)" +
+		") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci"
+)
+
+var fileNamePattern = regexp.MustCompile(`^(\d+)_([a-z0-9_]+)\.(up|down)\.sql$`)
+
+type Migration struct {
+	Version uint64
+	Name    string
+	Up      string
+	Down    string
+}
+
+type Status struct {
+	Version   uint64
+	Name      string
+	AppliedAt *time.Time
+	Dirty     bool
+}
+
+// Load fsys 루트의 {version}_{name}.up.sql / .down.sql 파일을 버전 순으로 읽어옵니다.
+func Load(fsys fs.FS) ([]Migration, error) {
+	entries, err := fs.ReadDir(fsys, ".")
+	if err != nil {
+		return nil, fmt.Errorf("read migrations: %w", err)
+	}
+
+	byVersion := map[uint64]*Migration{}
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+
+		matches := fileNamePattern.FindStringSubmatch(entry.Name())
+		if matches == nil {
+			return nil, fmt.Errorf("invalid migration file name: %s", entry.Name())
+		}
+
+		version, err := strconv.ParseUint(matches[1], 10, 64)
+		if err != nil || version == 0 {
+			return nil, fmt.Errorf("invalid migration version: %s", entry.Name())
+		}
+
+		body, err := fs.ReadFile(fsys, entry.Name())
+		if err != nil {
+			return nil, fmt.Errorf("read migration %s: %w", entry.Name(), err)
+		}
+
+		m, ok := byVersion[version]
+		if !ok {
+			m = &Migration{Version: version, Name: matches[2]}
+			byVersion[version] = m
+		}
+		if m.Name != matches[2] {
+			return nil, fmt.Errorf("migration %d has different names: %s, %s", version, m.Name, matches[2])
+		}
+
+		if matches[3] == "up" {
+			m.Up = string(body)
+		} else {
+			m.Down = string(body)
+		}
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, m := range byVersion {
+		if m.Up == "" {
+			return nil, fmt.Errorf("migration %d_%s has no up file", m.Version, m.Name)
+		}
+		migrations = append(migrations, *m)
+	}
+
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].Version < migrations[j].Version
+	})
+
+	return migrations, nil
+}
+
+// Migrator
+// schema_migrations 테이블에 적용 이력을 기록하며 migration 을 적용 / 롤백합니다.
+// MySQL DDL 은 트랜잭션으로 묶이지 않으므로 적용 전 dirty 로 기록하고, 실패 시 dirty 상태로 남겨 수동 확인 후 force 로 복구합니다.
+// db 는 한 파일에 여러 statement 를 실행할 수 있도록 multiStatements 옵션이 켜진 연결이어야 합니다.
+type Migrator struct {
+	db          *sql.DB
+	migrations  []Migration
+	lockTimeout time.Duration
+}
+
+func NewMigrator(db *sql.DB, migrations []Migration, lockTimeout time.Duration) *Migrator {
+	return &Migrator{db: db, migrations: migrations, lockTimeout: lockTimeout}
+}
+
+// Latest 파일 기준 최신 버전 (migration 이 없으면 0)
+func (m *Migrator) Latest() uint64 {
+	if len(m.migrations) == 0 {
+		return 0
+	}
+	return m.migrations[len(m.migrations)-1].Version
+}
+
+// Up 적용되지 않은 migration 을 모두 적용합니다.
+func (m *Migrator) Up(ctx context.Context) ([]Migration, error) {
+	return m.To(ctx, m.Latest())
+}
+
+// Down 가장 최근에 적용된 migration 부터 steps 개를 롤백합니다.
+func (m *Migrator) Down(ctx context.Context, steps int) ([]Migration, error) {
+	var executed []Migration
+	err := m.withLock(ctx, false, func(conn *sql.Conn) error {
+		applied, err := m.applied(ctx, conn)
+		if err != nil {
+			return err
+		}
+
+		for idx := len(m.migrations) - 1; idx >= 0 && len(executed) < steps; idx-- {
+			if _, ok := applied[m.migrations[idx].Version]; !ok {
+				continue
+			}
+			if err := m.down(ctx, conn, m.migrations[idx]); err != nil {
+				return err
+			}
+			executed = append(executed, m.migrations[idx])
+		}
+		return nil
+	})
+	return executed, err
+}
+
+// To target 버전까지 적용(target 이 더 높으면) 하거나 롤백(target 이 더 낮으면) 합니다. target 0 은 전체 롤백입니다.
+func (m *Migrator) To(ctx context.Context, target uint64) ([]Migration, error) {
+	if target != 0 && m.find(target) == nil {
+		return nil, fmt.Errorf("unknown migration version: %d", target)
+	}
+
+	var executed []Migration
+	err := m.withLock(ctx, false, func(conn *sql.Conn) error {
+		applied, err := m.applied(ctx, conn)
+		if err != nil {
+			return err
+		}
+
+		// 롤백은 최신 버전부터
+		for idx := len(m.migrations) - 1; idx >= 0; idx-- {
+			migration := m.migrations[idx]
+			if _, ok := applied[migration.Version]; !ok || migration.Version <= target {
+				continue
+			}
+			if err := m.down(ctx, conn, migration); err != nil {
+				return err
+			}
+			executed = append(executed, migration)
+		}
+
+		for _, migration := range m.migrations {
+			if _, ok := applied[migration.Version]; ok || migration.Version > target {
+				continue
+			}
+			if err := m.up(ctx, conn, migration); err != nil {
+				return err
+			}
+			executed = append(executed, migration)
+		}
+		return nil
+	})
+	return executed, err
+}
+
+// Force SQL 을 실행하지 않고 version 이하를 적용 완료, 초과분을 미적용으로 기록합니다.
+// dirty 상태를 수동으로 복구했거나, AutoMigrate 로 생성된 기존 DB 를 migration 관리로 전환할 때 사용합니다.
+func (m *Migrator) Force(ctx context.Context, version uint64) error {
+	if version != 0 && m.find(version) == nil {
+		return fmt.Errorf("unknown migration version: %d", version)
+	}
+
+	return m.withLock(ctx, true, func(conn *sql.Conn) error {
+		if _, err := conn.ExecContext(ctx, "DELETE FROM `schema_migrations`"); err != nil {
+			return fmt.Errorf("reset schema_migrations: %w", err)
+		}
+
+		now := time.Now().UTC()
+		for _, migration := range m.migrations {
+			if migration.Version > version {
+				break
+			}
+			if _, err := conn.ExecContext(ctx, "INSERT INTO `schema_migrations` (`version`, `name`, `dirty`, `applied_at`) VALUES (?, ?, 0, ?)",
+				migration.Version, migration.Name, now); err != nil {
+				return fmt.Errorf("force migration %d: %w", migration.Version, err)
+			}
+		}
+		return nil
+	})
+}
+
+// Status 파일 기준 전체 migration 의 적용 여부
+func (m *Migrator) Status(ctx context.Context) ([]Status, error) {
+	conn, err := m.db.Conn(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get connection: %w", err)
+	}
+	defer conn.Close()
+
+	if err := m.ensureTable(ctx, conn); err != nil {
+		return nil, err
+	}
+
+	applied, err := m.applied(ctx, conn)
+	if err != nil {
+		return nil, err
+	}
+	return m.statuses(applied), nil
+}
+
+// Pending 적용되지 않았거나 dirty 상태인 migration
+// readiness probe 등 주기적으로 호출되므로 schema_migrations 를 생성하지 않고 조회만 하며, 테이블이 없으면 모두 미적용으로 봅니다.
+func (m *Migrator) Pending(ctx context.Context) ([]Migration, error) {
+	conn, err := m.db.Conn(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get connection: %w", err)
+	}
+	defer conn.Close()
+
+	applied, err := m.applied(ctx, conn)
+	var mysqlErr *mysql.MySQLError
+	if errors.As(err, &mysqlErr) && mysqlErr.Number == errNoSuchTable {
+		applied, err = map[uint64]appliedRecord{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var pending []Migration
+	for idx, status := range m.statuses(applied) {
+		if status.AppliedAt == nil || status.Dirty {
+			pending = append(pending, m.migrations[idx])
+		}
+	}
+	return pending, nil
+}
+
+// statuses 파일 기준 전체 migration 에 적용 이력을 합칩니다.
+func (m *Migrator) statuses(applied map[uint64]appliedRecord) []Status {
+	statuses := make([]Status, 0, len(m.migrations))
+	for _, migration := range m.migrations {
+		status := Status{Version: migration.Version, Name: migration.Name}
+		if record, ok := applied[migration.Version]; ok {
+			appliedAt := record.appliedAt
+			status.AppliedAt = &appliedAt
+			status.Dirty = record.dirty
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses
+}
+
+type appliedRecord struct {
+	appliedAt time.Time
+	dirty     bool
+}
+
+// withLock skipDirtyCheck 는 dirty 상태를 복구하는 Force 에서만 사용합니다.
+func (m *Migrator) withLock(ctx context.Context, skipDirtyCheck bool, fn func(conn *sql.Conn) error) error {
+	// GET_LOCK 은 세션 단위이므로 하나의 connection 에서 lock 획득 / migration / 해제를 모두 수행
+	conn, err := m.db.Conn(ctx)
+	if err != nil {
+		return fmt.Errorf("get connection: %w", err)
+	}
+	defer conn.Close()
+
+	var acquired sql.NullInt64
+	if err := conn.QueryRowContext(ctx, "SELECT GET_LOCK(?, ?)", lockName, int(m.lockTimeout.Seconds())).Scan(&acquired); err != nil {
+		return fmt.Errorf("acquire migration lock: %w", err)
+	}
+	if !acquired.Valid || acquired.Int64 != 1 {
+		return fmt.Errorf("another migration is running (lock %s not acquired within %s)", lockName, m.lockTimeout)
+	}
+	defer func() {
+		_, _ = conn.ExecContext(context.Background(), "SELECT RELEASE_LOCK(?)", lockName)
+	}()
+
+	if err := m.ensureTable(ctx, conn); err != nil {
+		return err
+	}
+
+	if !skipDirtyCheck {
+		if err := m.checkDirty(ctx, conn); err != nil {
+			return err
+		}
+	}
+
+	return fn(conn)
+}
+
+func (m *Migrator) ensureTable(ctx context.Context, conn *sql.Conn) error {
+	if _, err := conn.ExecContext(ctx, createSchemaMigrationsSQL); err != nil {
+		return fmt.Errorf("create schema_migrations: %w", err)
+	}
+	return nil
+}
+
+func (m *Migrator) checkDirty(ctx context.Context, conn *sql.Conn) error {
+	var version uint64
+	err := conn.QueryRowContext(ctx, "SELECT `version` FROM `schema_migrations` WHERE `dirty` = 1 ORDER BY `version` LIMIT 1").Scan(&version)
+	if err == sql.ErrNoRows {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("check dirty migration: %w", err)
+	}
+	return fmt.Errorf("migration %d is dirty: fix the schema manually and run force", version)
+}
+
+func (m *Migrator) applied(ctx context.Context, conn *sql.Conn) (map[uint64]appliedRecord, error) {
+	rows, err := conn.QueryContext(ctx, "SELECT `version`, `dirty`, `applied_at` FROM `schema_migrations`")
+	if err != nil {
+		return nil, fmt.Errorf("read schema_migrations: %w", err)
+	}
+	defer rows.Close()
+
+	applied := map[uint64]appliedRecord{}
+	for rows.Next() {
+		var (
+			version uint64
+			record  appliedRecord
+		)
+		if err := rows.Scan(&version, &record.dirty, &record.appliedAt); err != nil {
+			return nil, fmt.Errorf("read schema_migrations: %w", err)
+		}
+		applied[version] = record
+	}
+	return applied, rows.Err()
+}
+
+func (m *Migrator) up(ctx context.Context, conn *sql.Conn, migration Migration) error {
+	if _, err := conn.ExecContext(ctx, "INSERT INTO `schema_migrations` (`version`, `name`, `dirty`, `applied_at`) VALUES (?, ?, 1, ?)",
+		migration.Version, migration.Name, time.Now().UTC()); err != nil {
+		return fmt.Errorf("record migration %d: %w", migration.Version, err)
+	}
+
+	if _, err := conn.ExecContext(ctx, migration.Up); err != nil {
+		return fmt.Errorf("apply migration %d_%s: %w", migration.Version, migration.Name, err)
+	}
+
+	if _, err := conn.ExecContext(ctx, "UPDATE `schema_migrations` SET `dirty` = 0 WHERE `version` = ?", migration.Version); err != nil {
+		return fmt.Errorf("record migration %d: %w", migration.Version, err)
+	}
+	return nil
+}
+
+func (m *Migrator) down(ctx context.Context, conn *sql.Conn, migration Migration) error {
+	if migration.Down == "" {
+		return fmt.Errorf("migration %d_%s has no down file", migration.Version, migration.Name)
+	}
+
+	if _, err := conn.ExecContext(ctx, "UPDATE `schema_migrations` SET `dirty` = 1 WHERE `version` = ?", migration.Version); err != nil {
+		return fmt.Errorf("record migration %d: %w", migration.Version, err)
+	}
+
+	if _, err := conn.ExecContext(ctx, migration.Down); err != nil {
+		return fmt.Errorf("rollback migration %d_%s: %w", migration.Version, migration.Name, err)
+	}
+
+	if _, err := conn.ExecContext(ctx, "DELETE FROM `schema_migrations` WHERE `version` = ?", migration.Version); err != nil {
+		return fmt.Errorf("record migration %d: %w", migration.Version, err)
+	}
+	return nil
+}
+
+func (m *Migrator) find(version uint64) *Migration {
+	for idx := range m.migrations {
+		if m.migrations[idx].Version == version {
+			return &m.migrations[idx]
+		}
+	}
+	return nil
+}
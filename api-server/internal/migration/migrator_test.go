@@ -0,0 +1,232 @@
+package migration
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-sql-driver/mysql"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Load(t *testing.T) {
+	tests := []struct {
+		name         string
+		fsys         fstest.MapFS
+		wantVersions []uint64
+		wantErr      bool
+	}{
+		{
+			name: "성공 - 버전 순 정렬",
+			fsys: fstest.MapFS{
+				"000002_add_index.up.sql":     {Data: []byte("CREATE INDEX ...")},
+				"000002_add_index.down.sql":   {Data: []byte("DROP INDEX ...")},
+				"000001_init_schema.up.sql":   {Data: []byte("CREATE TABLE ...")},
+				"000001_init_schema.down.sql": {Data: []byte("DROP TABLE ...")},
+			},
+			wantVersions: []uint64{1, 2},
+		},
+		{
+			name: "실패 - up 파일 누락",
+			fsys: fstest.MapFS{
+				"000001_init_schema.down.sql": {Data: []byte("DROP TABLE ...")},
+			},
+			wantErr: true,
+		},
+		{
+			name: "실패 - 잘못된 파일명",
+			fsys: fstest.MapFS{
+				"init_schema.sql": {Data: []byte("CREATE TABLE ...")},
+			},
+			wantErr: true,
+		},
+		{
+			name: "실패 - 같은 버전의 다른 이름",
+			fsys: fstest.MapFS{
+				"000001_init_schema.up.sql": {Data: []byte("CREATE TABLE ...")},
+				"000001_other.down.sql":     {Data: []byte("DROP TABLE ...")},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			migrations, err := Load(tt.fsys)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			versions := make([]uint64, 0, len(migrations))
+			for _, m := range migrations {
+				versions = append(versions, m.Version)
+			}
+			require.Equal(t, tt.wantVersions, versions)
+		})
+	}
+}
+
+func Test_Migrator_Up(t *testing.T) {
+	migrations := []Migration{
+		{Version: 1, Name: "init_schema", Up: "CREATE TABLE a (id int)", Down: "DROP TABLE a"},
+		{Version: 2, Name: "add_b", Up: "CREATE TABLE b (id int)", Down: "DROP TABLE b"},
+	}
+
+	tests := []struct {
+		name         string
+		setupMock    func(mockSQL sqlmock.Sqlmock)
+		wantExecuted []uint64
+		wantErr      bool
+	}{
+		{
+			name: "성공 - 미적용 migration 만 적용",
+			setupMock: func(mockSQL sqlmock.Sqlmock) {
+				mockSQL.ExpectQuery("SELECT GET_LOCK").WithArgs(lockName, 30).WillReturnRows(sqlmock.NewRows([]string{"lock"}).AddRow(1))
+				mockSQL.ExpectExec("CREATE TABLE IF NOT EXISTS `schema_migrations`").WillReturnResult(sqlmock.NewResult(0, 0))
+				mockSQL.ExpectQuery("WHERE `dirty` = 1").WillReturnRows(sqlmock.NewRows([]string{"version"}))
+				mockSQL.ExpectQuery("SELECT `version`, `dirty`, `applied_at` FROM `schema_migrations`").
+					WillReturnRows(sqlmock.NewRows([]string{"version", "dirty", "applied_at"}).AddRow(1, false, time.Now()))
+				mockSQL.ExpectExec("INSERT INTO `schema_migrations`").WithArgs(2, "add_b", sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(0, 1))
+				mockSQL.ExpectExec(regexp.QuoteMeta("CREATE TABLE b (id int)")).WillReturnResult(sqlmock.NewResult(0, 0))
+				mockSQL.ExpectExec("UPDATE `schema_migrations` SET `dirty` = 0").WithArgs(2).WillReturnResult(sqlmock.NewResult(0, 1))
+				mockSQL.ExpectExec("SELECT RELEASE_LOCK").WithArgs(lockName).WillReturnResult(sqlmock.NewResult(0, 0))
+			},
+			wantExecuted: []uint64{2},
+		},
+		{
+			name: "실패 - 다른 인스턴스가 lock 보유",
+			setupMock: func(mockSQL sqlmock.Sqlmock) {
+				mockSQL.ExpectQuery("SELECT GET_LOCK").WithArgs(lockName, 30).WillReturnRows(sqlmock.NewRows([]string{"lock"}).AddRow(0))
+			},
+			wantErr: true,
+		},
+		{
+			name: "실패 - dirty migration 존재",
+			setupMock: func(mockSQL sqlmock.Sqlmock) {
+				mockSQL.ExpectQuery("SELECT GET_LOCK").WithArgs(lockName, 30).WillReturnRows(sqlmock.NewRows([]string{"lock"}).AddRow(1))
+				mockSQL.ExpectExec("CREATE TABLE IF NOT EXISTS `schema_migrations`").WillReturnResult(sqlmock.NewResult(0, 0))
+				mockSQL.ExpectQuery("WHERE `dirty` = 1").WillReturnRows(sqlmock.NewRows([]string{"version"}).AddRow(2))
+				mockSQL.ExpectExec("SELECT RELEASE_LOCK").WithArgs(lockName).WillReturnResult(sqlmock.NewResult(0, 0))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mockSQL, err := sqlmock.New()
+			require.NoError(t, err)
+			defer db.Close()
+			tt.setupMock(mockSQL)
+
+			executed, err := NewMigrator(db, migrations, 30*time.Second).Up(context.Background())
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				versions := make([]uint64, 0, len(executed))
+				for _, m := range executed {
+					versions = append(versions, m.Version)
+				}
+				require.Equal(t, tt.wantExecuted, versions)
+			}
+			require.NoError(t, mockSQL.ExpectationsWereMet())
+		})
+	}
+}
+
+func Test_Migrator_To_Rollback(t *testing.T) {
+	migrations := []Migration{
+		{Version: 1, Name: "init_schema", Up: "CREATE TABLE a (id int)", Down: "DROP TABLE a"},
+		{Version: 2, Name: "add_b", Up: "CREATE TABLE b (id int)", Down: "DROP TABLE b"},
+	}
+
+	db, mockSQL, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	mockSQL.ExpectQuery("SELECT GET_LOCK").WillReturnRows(sqlmock.NewRows([]string{"lock"}).AddRow(1))
+	mockSQL.ExpectExec("CREATE TABLE IF NOT EXISTS `schema_migrations`").WillReturnResult(sqlmock.NewResult(0, 0))
+	mockSQL.ExpectQuery("WHERE `dirty` = 1").WillReturnRows(sqlmock.NewRows([]string{"version"}))
+	mockSQL.ExpectQuery("SELECT `version`, `dirty`, `applied_at` FROM `schema_migrations`").
+		WillReturnRows(sqlmock.NewRows([]string{"version", "dirty", "applied_at"}).AddRow(1, false, time.Now()).AddRow(2, false, time.Now()))
+	mockSQL.ExpectExec("UPDATE `schema_migrations` SET `dirty` = 1").WithArgs(2).WillReturnResult(sqlmock.NewResult(0, 1))
+	mockSQL.ExpectExec(regexp.QuoteMeta("DROP TABLE b")).WillReturnResult(sqlmock.NewResult(0, 0))
+	mockSQL.ExpectExec("DELETE FROM `schema_migrations` WHERE `version` = ?").WithArgs(2).WillReturnResult(sqlmock.NewResult(0, 1))
+	mockSQL.ExpectExec("SELECT RELEASE_LOCK").WillReturnResult(sqlmock.NewResult(0, 0))
+
+	executed, err := NewMigrator(db, migrations, 30*time.Second).To(context.Background(), 1)
+	require.NoError(t, err)
+	require.Len(t, executed, 1)
+	require.Equal(t, uint64(2), executed[0].Version)
+	require.NoError(t, mockSQL.ExpectationsWereMet())
+}
+
+func Test_Migrator_Pending(t *testing.T) {
+	migrations := []Migration{
+		{Version: 1, Name: "init_schema", Up: "CREATE TABLE a (id int)", Down: "DROP TABLE a"},
+		{Version: 2, Name: "add_b", Up: "CREATE TABLE b (id int)", Down: "DROP TABLE b"},
+		{Version: 3, Name: "add_c", Up: "CREATE TABLE c (id int)", Down: "DROP TABLE c"},
+	}
+
+	// schema_migrations 를 생성하지 않고 조회만 수행 (sqlmock 은 기대하지 않은 CREATE TABLE 을 실패 처리)
+	tests := []struct {
+		name        string
+		setupMock   func(mockSQL sqlmock.Sqlmock)
+		wantPending []uint64
+		wantErr     bool
+	}{
+		{
+			name: "성공 - 미적용 / dirty migration",
+			setupMock: func(mockSQL sqlmock.Sqlmock) {
+				mockSQL.ExpectQuery("SELECT `version`, `dirty`, `applied_at` FROM `schema_migrations`").
+					WillReturnRows(sqlmock.NewRows([]string{"version", "dirty", "applied_at"}).AddRow(1, false, time.Now()).AddRow(2, true, time.Now()))
+			},
+			wantPending: []uint64{2, 3},
+		},
+		{
+			name: "성공 - schema_migrations 가 없으면 전체 미적용",
+			setupMock: func(mockSQL sqlmock.Sqlmock) {
+				mockSQL.ExpectQuery("SELECT `version`, `dirty`, `applied_at` FROM `schema_migrations`").
+					WillReturnError(&mysql.MySQLError{Number: errNoSuchTable, Message: "Table 'aitrics_db.schema_migrations' doesn't exist"})
+			},
+			wantPending: []uint64{1, 2, 3},
+		},
+		{
+			name: "실패 - 조회 실패",
+			setupMock: func(mockSQL sqlmock.Sqlmock) {
+				mockSQL.ExpectQuery("SELECT `version`, `dirty`, `applied_at` FROM `schema_migrations`").
+					WillReturnError(errors.New("connection refused"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mockSQL, err := sqlmock.New()
+			require.NoError(t, err)
+			defer db.Close()
+			tt.setupMock(mockSQL)
+
+			pending, err := NewMigrator(db, migrations, 0).Pending(context.Background())
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				versions := make([]uint64, 0, len(pending))
+				for _, m := range pending {
+					versions = append(versions, m.Version)
+				}
+				require.Equal(t, tt.wantPending, versions)
+			}
+			require.NoError(t, mockSQL.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,98 @@
+package output
+
+import (
+	pkgError "aitrics-vital-signs/library/error"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	headerETag        = "ETag"
+	headerIfMatch     = "If-Match"
+	headerIfNoneMatch = "If-None-Match"
+
+	weakETagPrefix = "W/"
+)
+
+// VersionETag optimistic lock version 을 strong ETag 로 변환합니다. ("3")
+func VersionETag(version int) string {
+	return strconv.Quote(strconv.Itoa(version))
+}
+
+// CollectionETag 목록 응답의 weak ETag 를 항목의 key / version 으로 계산합니다. (parts 는 순서가 보장되어야 함)
+func CollectionETag(parts ...string) string {
+	hash := sha256.New()
+	for _, part := range parts {
+		hash.Write([]byte(part))
+		hash.Write([]byte{0})
+	}
+	return weakETagPrefix + strconv.Quote(hex.EncodeToString(hash.Sum(nil))[:32])
+}
+
+// NotModified ETag 헤더를 설정하고, If-None-Match 와 일치하면 304 로 응답합니다. (true 인 경우 응답 완료)
+func NotModified(ctx *gin.Context, etag string) bool {
+	ctx.Header(headerETag, etag)
+
+	ifNoneMatch := ctx.GetHeader(headerIfNoneMatch)
+	if ifNoneMatch == "" {
+		return false
+	}
+
+	// If-None-Match 는 weak 비교
+	for _, candidate := range strings.Split(ifNoneMatch, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || strings.TrimPrefix(candidate, weakETagPrefix) == strings.TrimPrefix(etag, weakETagPrefix) {
+			ctx.AbortWithStatus(http.StatusNotModified)
+			return true
+		}
+	}
+
+	return false
+}
+
+// ResolveVersion If-Match 헤더 또는 body 의 version 중 사용할 optimistic lock version 을 결정합니다.
+// If-Match 를 사용한 경우 fromHeader 가 true 이며, version 불일치는 PreconditionFailed(412) 로 응답해야 합니다.
+func ResolveVersion(ctx *gin.Context, bodyVersion int) (version int, fromHeader bool, err error) {
+	ifMatch := strings.TrimSpace(ctx.GetHeader(headerIfMatch))
+	if ifMatch == "" {
+		if bodyVersion < 1 {
+			return 0, false, pkgError.WrapWithCode(pkgError.EmptyBusinessError(), pkgError.WrongParam, "version or If-Match header is required")
+		}
+		return bodyVersion, false, nil
+	}
+
+	// 비교 대상 version 이 하나여야 하므로 목록, wildcard, weak ETag 는 허용하지 않습니다.
+	unquoted, err := strconv.Unquote(ifMatch)
+	if err != nil || strings.HasPrefix(ifMatch, weakETagPrefix) {
+		return 0, false, pkgError.WrapWithCode(pkgError.EmptyBusinessError(), pkgError.WrongParam, fmt.Sprintf("If-Match must be a single strong ETag: %s", ifMatch))
+	}
+	version, err = strconv.Atoi(unquoted)
+	if err != nil || version < 1 {
+		return 0, false, pkgError.WrapWithCode(pkgError.EmptyBusinessError(), pkgError.WrongParam, fmt.Sprintf("If-Match must be a version ETag: %s", ifMatch))
+	}
+
+	if bodyVersion != 0 && bodyVersion != version {
+		return 0, false, pkgError.WrapWithCode(pkgError.EmptyBusinessError(), pkgError.WrongParam, "version and If-Match header mismatch")
+	}
+
+	return version, true, nil
+}
+
+// HasIfMatch If-Match 헤더가 전달되었는지 확인합니다.
+func HasIfMatch(ctx *gin.Context) bool {
+	return strings.TrimSpace(ctx.GetHeader(headerIfMatch)) != ""
+}
+
+// PreconditionError If-Match 를 사용한 요청의 version 충돌(Conflict)을 PreconditionFailed 로 변환합니다.
+func PreconditionError(err error, fromHeader bool) error {
+	if !fromHeader || !pkgError.CompareBusinessError(err, pkgError.Conflict) {
+		return err
+	}
+	return pkgError.WrapWithCode(err, pkgError.PreconditionFailed, "If-Match version mismatch")
+}
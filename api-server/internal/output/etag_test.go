@@ -0,0 +1,72 @@
+package output
+
+import (
+	pkgError "aitrics-vital-signs/library/error"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ResolveVersion(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		ifMatch        string
+		bodyVersion    int
+		wantVersion    int
+		wantFromHeader bool
+		wantErr        bool
+	}{
+		{name: "성공 - body version 사용", bodyVersion: 2, wantVersion: 2},
+		{name: "성공 - If-Match 사용", ifMatch: `"3"`, wantVersion: 3, wantFromHeader: true},
+		{name: "성공 - If-Match 와 body version 이 같음", ifMatch: `"3"`, bodyVersion: 3, wantVersion: 3, wantFromHeader: true},
+		{name: "실패 - version 없음", wantErr: true},
+		{name: "실패 - If-Match 와 body version 이 다름", ifMatch: `"3"`, bodyVersion: 2, wantErr: true},
+		{name: "실패 - 따옴표 없는 If-Match", ifMatch: `3`, wantErr: true},
+		{name: "실패 - weak ETag", ifMatch: `W/"3"`, wantErr: true},
+		{name: "실패 - wildcard", ifMatch: `*`, wantErr: true},
+		{name: "실패 - version 이 아닌 ETag", ifMatch: `"abc"`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+			ctx.Request = httptest.NewRequest(http.MethodPut, "/", nil)
+			if tt.ifMatch != "" {
+				ctx.Request.Header.Set("If-Match", tt.ifMatch)
+			}
+
+			version, fromHeader, err := ResolveVersion(ctx, tt.bodyVersion)
+			if tt.wantErr {
+				require.True(t, pkgError.CompareBusinessError(err, pkgError.WrongParam))
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.wantVersion, version)
+			require.Equal(t, tt.wantFromHeader, fromHeader)
+		})
+	}
+}
+
+func Test_PreconditionError(t *testing.T) {
+	conflict := pkgError.WrapWithCode(pkgError.EmptyBusinessError(), pkgError.Conflict, "version mismatch")
+	notFound := pkgError.WrapWithCode(pkgError.EmptyBusinessError(), pkgError.NotFound)
+
+	require.True(t, pkgError.CompareBusinessError(PreconditionError(conflict, true), pkgError.PreconditionFailed))
+	require.True(t, pkgError.CompareBusinessError(PreconditionError(conflict, false), pkgError.Conflict))
+	require.True(t, pkgError.CompareBusinessError(PreconditionError(notFound, true), pkgError.NotFound))
+}
+
+func Test_CollectionETag(t *testing.T) {
+	etag := CollectionETag("P00001", "HR|2025-12-01T10:00:00Z|1")
+
+	require.Equal(t, etag, CollectionETag("P00001", "HR|2025-12-01T10:00:00Z|1"))
+	require.NotEqual(t, etag, CollectionETag("P00001", "HR|2025-12-01T10:00:00Z|2"))
+	// 구분자가 포함되므로 part 경계가 달라지면 다른 ETag
+	require.NotEqual(t, CollectionETag("ab", "c"), CollectionETag("a", "bc"))
+	require.Contains(t, etag, `W/"`)
+}
@@ -0,0 +1,295 @@
+package output
+
+import (
+	pkgError "aitrics-vital-signs/library/error"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/xitongsys/parquet-go/writer"
+)
+
+type Format string
+
+const (
+	FormatJSON    Format = "json"
+	FormatCSV     Format = "csv"
+	FormatNDJSON  Format = "ndjson"
+	FormatParquet Format = "parquet"
+)
+
+var formatContentTypes = map[Format]string{
+	FormatJSON:    "application/json",
+	FormatCSV:     "text/csv",
+	FormatNDJSON:  "application/x-ndjson",
+	FormatParquet: "application/vnd.apache.parquet",
+}
+
+func (f Format) ContentType() string {
+	return formatContentTypes[f]
+}
+
+func (f Format) String() string {
+	return string(f)
+}
+
+// 스트리밍 중 일정 row 마다 클라이언트로 flush 합니다.
+const flushEveryRows = 500
+
+// parquet writer 내부 병렬 처리 수
+const parquetWriterParallel = 1
+
+type ColumnKind int
+
+const (
+	ColumnString ColumnKind = iota
+	ColumnInt64
+	ColumnFloat64
+	ColumnTime
+)
+
+type Column struct {
+	Name string
+	Kind ColumnKind
+}
+
+// Record
+// export 가능한 한 행을 표현합니다. Values 는 Column 순서와 일치해야 하며, NDJSON 은 Record 자체를 json 으로 직렬화합니다.
+type Record interface {
+	Values() []interface{}
+}
+
+type RecordWriter interface {
+	Write(rec Record) error
+	Close() error
+}
+
+// NegotiateFormat
+// format query 를 우선으로, 없으면 Accept 헤더를 기준으로 offered 중 응답 포맷을 결정합니다. (offered[0] 이 기본값)
+func NegotiateFormat(ctx *gin.Context, offered ...Format) (Format, error) {
+	if query := ctx.Query("format"); query != "" {
+		for _, f := range offered {
+			if string(f) == query {
+				return f, nil
+			}
+		}
+		return "", pkgError.WrapWithCode(pkgError.EmptyBusinessError(), pkgError.WrongParam, fmt.Sprintf("unsupported format: %s", query))
+	}
+
+	contentTypes := make([]string, 0, len(offered))
+	for _, f := range offered {
+		contentTypes = append(contentTypes, f.ContentType())
+	}
+
+	negotiated := ctx.NegotiateFormat(contentTypes...)
+	for _, f := range offered {
+		if f.ContentType() == negotiated {
+			return f, nil
+		}
+	}
+
+	return offered[0], nil
+}
+
+func NewRecordWriter(w io.Writer, format Format, columns []Column) (RecordWriter, error) {
+	switch format {
+	case FormatCSV:
+		return newCSVRecordWriter(w, columns)
+	case FormatNDJSON:
+		return &ndjsonRecordWriter{encoder: json.NewEncoder(w)}, nil
+	case FormatParquet:
+		return newParquetRecordWriter(w, columns)
+	default:
+		return nil, pkgError.WrapWithCode(pkgError.EmptyBusinessError(), pkgError.WrongParam, fmt.Sprintf("unsupported record format: %s", format))
+	}
+}
+
+// Stream
+// producer 가 전달하는 Record 를 DB cursor 에서 읽는 즉시 응답 body 로 기록합니다.
+// 첫 Record 가 기록되기 전에 실패하면 일반 에러 응답을, 이후에 실패하면 응답을 중단하고 에러만 기록합니다.
+func Stream(ctx *gin.Context, format Format, filename string, columns []Column, producer func(write func(Record) error) error) {
+	var (
+		rw      RecordWriter
+		written int
+	)
+
+	begin := func() error {
+		ctx.Header("Content-Type", format.ContentType())
+		ctx.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s.%s"`, filename, format))
+		ctx.Status(http.StatusOK)
+
+		var err error
+		rw, err = NewRecordWriter(ctx.Writer, format, columns)
+		return err
+	}
+
+	err := producer(func(rec Record) error {
+		if rw == nil {
+			if err := begin(); err != nil {
+				return err
+			}
+		}
+
+		if err := rw.Write(rec); err != nil {
+			return err
+		}
+
+		written++
+		if written%flushEveryRows == 0 {
+			ctx.Writer.Flush()
+		}
+		return nil
+	})
+
+	if err != nil {
+		if rw == nil {
+			AppendErrorContext(ctx, err, nil)
+			return
+		}
+		_ = ctx.Error(err)
+		ctx.Abort()
+		return
+	}
+
+	// 결과가 없어도 header 만 있는 파일을 내려줍니다.
+	if rw == nil {
+		if err := begin(); err != nil {
+			AppendErrorContext(ctx, err, nil)
+			return
+		}
+	}
+
+	if err := rw.Close(); err != nil {
+		_ = ctx.Error(pkgError.Wrap(err))
+	}
+
+	ctx.Abort()
+}
+
+type csvRecordWriter struct {
+	writer  *csv.Writer
+	columns []Column
+}
+
+func newCSVRecordWriter(w io.Writer, columns []Column) (*csvRecordWriter, error) {
+	cw := csv.NewWriter(w)
+
+	header := make([]string, 0, len(columns))
+	for _, c := range columns {
+		header = append(header, c.Name)
+	}
+	if err := cw.Write(header); err != nil {
+		return nil, pkgError.Wrap(err)
+	}
+
+	return &csvRecordWriter{writer: cw, columns: columns}, nil
+}
+
+func (c *csvRecordWriter) Write(rec Record) error {
+	values := rec.Values()
+	fields := make([]string, 0, len(values))
+	for idx, value := range values {
+		fields = append(fields, formatCSVValue(c.columns[idx].Kind, value))
+	}
+
+	if err := c.writer.Write(fields); err != nil {
+		return pkgError.Wrap(err)
+	}
+
+	return nil
+}
+
+func (c *csvRecordWriter) Close() error {
+	c.writer.Flush()
+	return c.writer.Error()
+}
+
+func formatCSVValue(kind ColumnKind, value interface{}) string {
+	switch kind {
+	case ColumnTime:
+		if t, ok := value.(time.Time); ok {
+			return t.UTC().Format(time.RFC3339Nano)
+		}
+	case ColumnFloat64:
+		if f, ok := value.(float64); ok {
+			return strconv.FormatFloat(f, 'f', -1, 64)
+		}
+	}
+	return fmt.Sprint(value)
+}
+
+type ndjsonRecordWriter struct {
+	encoder *json.Encoder
+}
+
+func (n *ndjsonRecordWriter) Write(rec Record) error {
+	return pkgError.Wrap(n.encoder.Encode(rec))
+}
+
+func (n *ndjsonRecordWriter) Close() error {
+	return nil
+}
+
+type parquetRecordWriter struct {
+	writer  *writer.CSVWriter
+	columns []Column
+}
+
+func newParquetRecordWriter(w io.Writer, columns []Column) (*parquetRecordWriter, error) {
+	metadata := make([]string, 0, len(columns))
+	for _, c := range columns {
+		metadata = append(metadata, parquetColumnMetadata(c))
+	}
+
+	pw, err := writer.NewCSVWriterFromWriter(metadata, w, parquetWriterParallel)
+	if err != nil {
+		return nil, pkgError.Wrap(err)
+	}
+
+	return &parquetRecordWriter{writer: pw, columns: columns}, nil
+}
+
+func parquetColumnMetadata(c Column) string {
+	switch c.Kind {
+	case ColumnInt64:
+		return fmt.Sprintf("name=%s, type=INT64", c.Name)
+	case ColumnFloat64:
+		return fmt.Sprintf("name=%s, type=DOUBLE", c.Name)
+	case ColumnTime:
+		return fmt.Sprintf("name=%s, type=INT64, convertedtype=TIMESTAMP_MILLIS", c.Name)
+	default:
+		return fmt.Sprintf("name=%s, type=BYTE_ARRAY, convertedtype=UTF8", c.Name)
+	}
+}
+
+func (p *parquetRecordWriter) Write(rec Record) error {
+	values := rec.Values()
+	row := make([]interface{}, 0, len(values))
+	for idx, value := range values {
+		switch p.columns[idx].Kind {
+		case ColumnTime:
+			t, _ := value.(time.Time)
+			row = append(row, t.UnixMilli())
+		case ColumnInt64:
+			switch n := value.(type) {
+			case int:
+				row = append(row, int64(n))
+			default:
+				row = append(row, value)
+			}
+		default:
+			row = append(row, value)
+		}
+	}
+
+	return pkgError.Wrap(p.writer.Write(row))
+}
+
+func (p *parquetRecordWriter) Close() error {
+	return pkgError.Wrap(p.writer.WriteStop())
+}
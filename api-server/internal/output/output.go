@@ -1,6 +1,7 @@
 package output
 
 import (
+	"aitrics-vital-signs/api-server/internal/i18n"
 	pkgError "aitrics-vital-signs/library/error"
 	"net/http"
 
@@ -8,8 +9,9 @@ import (
 )
 
 type Output struct {
-	Code int         `json:"code"`
-	Data interface{} `json:"data"`
+	Code    int         `json:"code"`
+	Message string      `json:"message,omitempty"` // 에러 응답 시 Accept-Language 에 맞춘 메시지
+	Data    interface{} `json:"data"`
 }
 
 func Send(ctx *gin.Context, respData interface{}) {
@@ -22,14 +24,29 @@ func Send(ctx *gin.Context, respData interface{}) {
 	ctx.Abort()
 }
 
+// language Accept-Language 로 에러 메시지 언어를 선택하고 Content-Language 로 알립니다.
+func language(ctx *gin.Context) i18n.Language {
+	lang := i18n.Negotiate(ctx.GetHeader("Accept-Language"))
+	ctx.Header("Content-Language", lang.String())
+	return lang
+}
+
 func AppendErrorContext(ctx *gin.Context, err error, respData interface{}) {
 	if err != nil {
 		_ = ctx.Error(err)
+		if wantsProblem(ctx) {
+			writeProblem(ctx, err)
+			ctx.Abort()
+			return
+		}
+
+		lang := language(ctx)
 		castedErr, ok := pkgError.CastBusinessError(err)
 		if !ok {
 			ctx.JSON(http.StatusInternalServerError, Output{
-				Code: int(pkgError.None),
-				Data: nil,
+				Code:    int(pkgError.None),
+				Message: i18n.Message(lang, pkgError.None),
+				Data:    nil,
 			})
 			return
 		}
@@ -39,8 +56,9 @@ func AppendErrorContext(ctx *gin.Context, err error, respData interface{}) {
 		}
 
 		ctx.JSON(castedErr.Status.HttpStatusCode, Output{
-			Code: castedErr.Status.Code,
-			Data: respData,
+			Code:    castedErr.Status.Code,
+			Message: i18n.Message(lang, pkgError.Code(castedErr.Status.Code)),
+			Data:    respData,
 		})
 	}
 
@@ -0,0 +1,180 @@
+package output
+
+import (
+	"aitrics-vital-signs/api-server/internal/i18n"
+	pkgError "aitrics-vital-signs/library/error"
+	pkgLogger "aitrics-vital-signs/library/logger"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/go-playground/validator/v10"
+)
+
+const (
+	ProblemContentType = "application/problem+json"
+	ErrorCatalogPath   = "/api/v1/errors"
+)
+
+// Problem RFC 7807 Problem Details 응답
+// type 은 error catalog 의 해당 code 를 가리키며, code / details / invalid_params / data 는 확장 field 입니다.
+type Problem struct {
+	Type          string         `json:"type"`
+	Title         string         `json:"title"`
+	Status        int            `json:"status"`
+	Detail        string         `json:"detail,omitempty"`
+	Instance      string         `json:"instance,omitempty"`
+	Code          int            `json:"code"`
+	RequestID     string         `json:"request_id,omitempty"`
+	Details       []string       `json:"details,omitempty"`
+	InvalidParams []InvalidParam `json:"invalid_params,omitempty"`
+	Data          interface{}    `json:"data,omitempty"` // 기본 응답의 data 와 같은 값 (예: Conflict 시 이미 존재하는 resource)
+}
+
+// InvalidParam 요청 field 단위 검증 실패 사유
+type InvalidParam struct {
+	Name   string `json:"name"`
+	Rule   string `json:"rule"`
+	Param  string `json:"param,omitempty"`
+	Reason string `json:"reason"`
+}
+
+type ErrorCatalogItem struct {
+	Code           int    `json:"code"`
+	HttpStatusCode int    `json:"http_status_code"`
+	Title          string `json:"title"`
+	Type           string `json:"type"`
+}
+
+type ErrorCatalogResponse struct {
+	Items []ErrorCatalogItem `json:"items"`
+}
+
+func init() {
+	// 검증 실패 field 를 struct field 명 대신 요청에서 사용하는 json / form key 로 표시
+	if v, ok := binding.Validator.Engine().(*validator.Validate); ok {
+		v.RegisterTagNameFunc(requestFieldName)
+	}
+}
+
+func requestFieldName(field reflect.StructField) string {
+	for _, tag := range []string{"json", "form", "uri"} {
+		name := strings.SplitN(field.Tag.Get(tag), ",", 2)[0]
+		if name == "-" {
+			return ""
+		}
+		if name != "" {
+			return name
+		}
+	}
+	return field.Name
+}
+
+// wantsProblem Accept 헤더에 application/problem+json 이 우선하는 경우에만 Problem 으로 응답합니다. (기본값은 Output)
+func wantsProblem(ctx *gin.Context) bool {
+	return ctx.NegotiateFormat(gin.MIMEJSON, ProblemContentType) == ProblemContentType
+}
+
+func problemType(code int) string {
+	return fmt.Sprintf("%s#%d", ErrorCatalogPath, code)
+}
+
+// NewProblem error 를 lang 언어의 Problem 으로 변환합니다. business error 가 아니면 None(500) 으로 응답합니다.
+func NewProblem(ctx *gin.Context, lang i18n.Language, err error) Problem {
+	castedErr, ok := pkgError.CastBusinessError(err)
+	base := pkgError.LookupStatus(pkgError.None)
+	if ok {
+		base = *castedErr.Status
+	}
+
+	problem := Problem{
+		Type:      problemType(base.Code),
+		Title:     i18n.Message(lang, pkgError.Code(base.Code)),
+		Status:    base.HttpStatusCode,
+		Instance:  ctx.Request.URL.Path,
+		Code:      base.Code,
+		RequestID: pkgLogger.RequestIDFromContext(ctx.Request.Context()),
+	}
+
+	// 내부 오류는 원인을 노출하지 않습니다.
+	if !ok {
+		return problem
+	}
+
+	problem.Details = base.Detail
+	problem.Data = base.Data
+	problem.InvalidParams = invalidParams(lang, err)
+
+	switch {
+	case len(problem.InvalidParams) > 0:
+		problem.Detail = fmt.Sprintf("%d invalid parameter(s)", len(problem.InvalidParams))
+	case len(base.Detail) > 0:
+		problem.Detail = base.Detail[0]
+	}
+
+	return problem
+}
+
+func writeProblem(ctx *gin.Context, err error) {
+	problem := NewProblem(ctx, language(ctx), err)
+	ctx.Header("Content-Type", ProblemContentType)
+	ctx.JSON(problem.Status, problem)
+}
+
+// invalidParams gin binding 의 validator / json decode 오류를 field 단위로 변환합니다.
+func invalidParams(lang i18n.Language, err error) []InvalidParam {
+	var validationErrs validator.ValidationErrors
+	if errors.As(err, &validationErrs) {
+		params := make([]InvalidParam, 0, len(validationErrs))
+		for _, fe := range validationErrs {
+			params = append(params, InvalidParam{
+				Name:   fieldPath(fe.Namespace()),
+				Rule:   fe.Tag(),
+				Param:  fe.Param(),
+				Reason: i18n.ValidationReason(lang, fe.Tag(), fe.Param()),
+			})
+		}
+		return params
+	}
+
+	var typeErr *json.UnmarshalTypeError
+	if errors.As(err, &typeErr) && typeErr.Field != "" {
+		return []InvalidParam{{
+			Name:   typeErr.Field,
+			Rule:   i18n.TypeTag,
+			Param:  typeErr.Type.String(),
+			Reason: i18n.ValidationReason(lang, i18n.TypeTag, typeErr.Type.String()),
+		}}
+	}
+
+	return nil
+}
+
+// fieldPath namespace 에서 최상위 struct 명을 제외합니다. (UpsertVitalRequest.patient_id -> patient_id)
+func fieldPath(namespace string) string {
+	if idx := strings.Index(namespace, "."); idx >= 0 {
+		return namespace[idx+1:]
+	}
+	return namespace
+}
+
+// ErrorCatalog 등록된 business code 와 HTTP status, 의미를 Accept-Language 에 맞춰 응답합니다.
+func ErrorCatalog(ctx *gin.Context) {
+	lang := language(ctx)
+	catalog := pkgError.Catalog()
+	items := make([]ErrorCatalogItem, 0, len(catalog))
+	for _, s := range catalog {
+		items = append(items, ErrorCatalogItem{
+			Code:           s.Code,
+			HttpStatusCode: s.HttpStatusCode,
+			Title:          i18n.Message(lang, pkgError.Code(s.Code)),
+			Type:           problemType(s.Code),
+		})
+	}
+
+	Send(ctx, ErrorCatalogResponse{Items: items})
+}
@@ -0,0 +1,179 @@
+package output
+
+import (
+	pkgError "aitrics-vital-signs/library/error"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+)
+
+type problemTestRequest struct {
+	PatientID string  `json:"patient_id" binding:"required"`
+	Gender    string  `json:"gender" binding:"required,oneof=M F"`
+	Value     float64 `json:"value"`
+}
+
+func Test_AppendErrorContext_Problem(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name              string
+		accept            string
+		acceptLanguage    string
+		body              string
+		err               error
+		wantStatus        int
+		wantContentType   string
+		wantCode          int
+		wantTitle         string
+		wantInvalidParams []InvalidParam
+	}{
+		{
+			name:            "성공 - Accept 가 없으면 기존 Output 응답",
+			err:             pkgError.WrapWithCode(pkgError.EmptyBusinessError(), pkgError.NotFound, "patient not found"),
+			wantStatus:      http.StatusNotFound,
+			wantContentType: gin.MIMEJSON,
+			wantCode:        int(pkgError.NotFound),
+		},
+		{
+			name:            "성공 - business error 를 Problem 으로 응답",
+			accept:          ProblemContentType,
+			err:             pkgError.WrapWithCode(pkgError.EmptyBusinessError(), pkgError.NotFound, "patient not found"),
+			wantStatus:      http.StatusNotFound,
+			wantContentType: ProblemContentType,
+			wantCode:        int(pkgError.NotFound),
+		},
+		{
+			name:            "성공 - validator 오류를 field 단위로 변환",
+			accept:          ProblemContentType + ", " + gin.MIMEJSON,
+			body:            `{"gender":"X"}`,
+			wantStatus:      http.StatusBadRequest,
+			wantContentType: ProblemContentType,
+			wantCode:        int(pkgError.WrongParam),
+			wantInvalidParams: []InvalidParam{
+				{Name: "patient_id", Rule: "required", Reason: "is required"},
+				{Name: "gender", Rule: "oneof", Param: "M F", Reason: "must be one of [M, F]"},
+			},
+		},
+		{
+			name:            "성공 - Accept-Language 에 맞춘 메시지",
+			accept:          ProblemContentType,
+			acceptLanguage:  "ko-KR,ko;q=0.9,en;q=0.8",
+			body:            `{"gender":"M"}`,
+			wantStatus:      http.StatusBadRequest,
+			wantContentType: ProblemContentType,
+			wantCode:        int(pkgError.WrongParam),
+			wantTitle:       "요청 값이 올바르지 않습니다",
+			wantInvalidParams: []InvalidParam{
+				{Name: "patient_id", Rule: "required", Reason: "필수 값입니다"},
+			},
+		},
+		{
+			name:            "성공 - json type 오류를 field 단위로 변환",
+			accept:          ProblemContentType,
+			body:            `{"patient_id":"P00001","gender":"M","value":"high"}`,
+			wantStatus:      http.StatusBadRequest,
+			wantContentType: ProblemContentType,
+			wantCode:        int(pkgError.WrongParam),
+			wantInvalidParams: []InvalidParam{
+				{Name: "value", Rule: "type", Param: "float64", Reason: "must be float64"},
+			},
+		},
+		{
+			name:            "성공 - business error 가 아니면 None 으로 응답",
+			accept:          ProblemContentType,
+			err:             errors.New("unexpected"),
+			wantStatus:      http.StatusInternalServerError,
+			wantContentType: ProblemContentType,
+			wantCode:        int(pkgError.None),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			engine := gin.New()
+			engine.POST("/api/v1/vitals", func(ctx *gin.Context) {
+				err := tt.err
+				if err == nil {
+					var reqBody problemTestRequest
+					bindErr := ctx.ShouldBindJSON(&reqBody)
+					require.Error(t, bindErr)
+					err = pkgError.WrapWithCode(bindErr, pkgError.WrongParam, bindErr.Error(), "fail to parse request parameter")
+				}
+				AppendErrorContext(ctx, err, nil)
+			})
+
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/vitals", strings.NewReader(tt.body))
+			if tt.accept != "" {
+				req.Header.Set("Accept", tt.accept)
+			}
+			if tt.acceptLanguage != "" {
+				req.Header.Set("Accept-Language", tt.acceptLanguage)
+			}
+			w := httptest.NewRecorder()
+			engine.ServeHTTP(w, req)
+
+			require.Equal(t, tt.wantStatus, w.Code)
+			require.True(t, strings.HasPrefix(w.Header().Get("Content-Type"), tt.wantContentType))
+
+			if tt.wantContentType != ProblemContentType {
+				var out Output
+				require.NoError(t, json.Unmarshal(w.Body.Bytes(), &out))
+				require.Equal(t, tt.wantCode, out.Code)
+				require.NotEmpty(t, out.Message)
+				return
+			}
+
+			var problem Problem
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &problem))
+			require.Equal(t, tt.wantCode, problem.Code)
+			require.Equal(t, tt.wantStatus, problem.Status)
+			require.Equal(t, problemType(tt.wantCode), problem.Type)
+			require.Equal(t, "/api/v1/vitals", problem.Instance)
+			require.NotEmpty(t, problem.Title)
+			if tt.wantTitle != "" {
+				require.Equal(t, tt.wantTitle, problem.Title)
+			}
+			require.Equal(t, tt.wantInvalidParams, problem.InvalidParams)
+			if tt.wantCode == int(pkgError.None) {
+				require.Empty(t, problem.Details)
+			}
+		})
+	}
+}
+
+func Test_ErrorCatalog(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	engine := gin.New()
+	engine.GET(ErrorCatalogPath, ErrorCatalog)
+
+	w := httptest.NewRecorder()
+	engine.ServeHTTP(w, httptest.NewRequest(http.MethodGet, ErrorCatalogPath, nil))
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var out struct {
+		Code int                  `json:"code"`
+		Data ErrorCatalogResponse `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &out))
+	require.Len(t, out.Data.Items, len(pkgError.Catalog()))
+
+	found := false
+	for i, item := range out.Data.Items {
+		if i > 0 {
+			require.Less(t, out.Data.Items[i-1].Code, item.Code)
+		}
+		if item.Code == int(pkgError.Conflict) {
+			found = true
+			require.Equal(t, http.StatusConflict, item.HttpStatusCode)
+		}
+	}
+	require.True(t, found)
+}
@@ -0,0 +1,77 @@
+package tracing
+
+import (
+	pkgError "aitrics-vital-signs/library/error"
+	"context"
+	"net/url"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.40.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const instrumentationName = "aitrics-vital-signs/api-server"
+
+// defaultTracesPath OTLP/HTTP collector 의 기본 trace 수신 경로
+const defaultTracesPath = "/v1/traces"
+
+type Config struct {
+	Endpoint    string // OTLP/HTTP collector 주소, 비어 있으면 span 을 내보내지 않음
+	ServiceName string
+	Environment string
+	SampleRatio float64
+}
+
+// Init
+// 전역 propagator(W3C traceparent / baggage)와 TracerProvider 를 설정합니다.
+// 반환된 shutdown 은 남은 span 을 모두 내보낸 뒤 종료하므로 서버 종료 시 호출합니다.
+func Init(ctx context.Context, config Config) (func(context.Context) error, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+
+	// exporter 가 없어도 수신한 traceparent 는 그대로 전달되어 로그의 trace_id 로 사용됩니다.
+	if config.Endpoint == "" {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	endpoint, err := url.Parse(config.Endpoint)
+	if err != nil {
+		return nil, pkgError.Wrap(err)
+	}
+	if endpoint.Path == "" || endpoint.Path == "/" {
+		endpoint.Path = defaultTracesPath
+	}
+
+	exporter, err := otlptracehttp.New(ctx, otlptracehttp.WithEndpointURL(endpoint.String()))
+	if err != nil {
+		return nil, pkgError.Wrap(err)
+	}
+
+	provider := NewTracerProvider(sdktrace.WithBatcher(exporter), config)
+	otel.SetTracerProvider(provider)
+
+	return provider.Shutdown, nil
+}
+
+// NewTracerProvider 상위 요청의 sampling 결정을 따르고, 새로 시작하는 trace 에만 SampleRatio 를 적용합니다.
+func NewTracerProvider(processor sdktrace.TracerProviderOption, config Config) *sdktrace.TracerProvider {
+	return sdktrace.NewTracerProvider(
+		processor,
+		sdktrace.WithResource(resource.NewWithAttributes(
+			semconv.SchemaURL,
+			semconv.ServiceName(config.ServiceName),
+			semconv.DeploymentEnvironmentName(config.Environment),
+		)),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(config.SampleRatio))),
+	)
+}
+
+// Start
+// 전역 TracerProvider 로 span 을 시작합니다.
+// provider 가 설정되지 않았으면 기록하지 않는 span 을 반환하므로 호출부에서 별도 분기가 필요 없습니다.
+func Start(ctx context.Context, name string, opts ...trace.SpanStartOption) (context.Context, trace.Span) {
+	return otel.Tracer(instrumentationName).Start(ctx, name, opts...)
+}
@@ -0,0 +1,88 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+)
+
+type testRow struct {
+	ID string
+}
+
+func (testRow) TableName() string {
+	return "test_rows"
+}
+
+func newRecorder(t *testing.T) *tracetest.SpanRecorder {
+	recorder := tracetest.NewSpanRecorder()
+	provider := NewTracerProvider(sdktrace.WithSpanProcessor(recorder), Config{ServiceName: "test", SampleRatio: 1})
+	otel.SetTracerProvider(provider)
+	t.Cleanup(func() { _ = provider.Shutdown(context.Background()) })
+	return recorder
+}
+
+func attributeOf(span sdktrace.ReadOnlySpan, key attribute.Key) attribute.Value {
+	for _, kv := range span.Attributes() {
+		if kv.Key == key {
+			return kv.Value
+		}
+	}
+	return attribute.Value{}
+}
+
+func Test_GormPlugin(t *testing.T) {
+	recorder := newRecorder(t)
+
+	sqlDB, sqlMock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer sqlDB.Close()
+
+	db, err := gorm.Open(mysql.New(mysql.Config{Conn: sqlDB, SkipInitializeWithVersion: true}), &gorm.Config{SkipDefaultTransaction: true})
+	require.NoError(t, err)
+	require.NoError(t, db.Use(GormPlugin{}))
+
+	ctx, parent := Start(context.Background(), "VitalService.UpsertVital")
+
+	t.Run("성공 - 상위 span 아래에 SQL 기록 (parameter 값 제외)", func(t *testing.T) {
+		sqlMock.ExpectQuery("SELECT \\* FROM `test_rows` WHERE id = \\?").
+			WithArgs("P00001", 1).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+		var row testRow
+		require.ErrorIs(t, db.WithContext(ctx).Where("id = ?", "P00001").First(&row).Error, gorm.ErrRecordNotFound)
+
+		spans := recorder.Ended()
+		require.Len(t, spans, 1)
+		span := spans[0]
+		require.Equal(t, "gorm.query", span.Name())
+		require.Equal(t, parent.SpanContext().SpanID(), span.Parent().SpanID())
+		require.Equal(t, "test_rows", attributeOf(span, "db.collection.name").AsString())
+		require.Equal(t, "SELECT * FROM `test_rows` WHERE id = ? ORDER BY `test_rows`.`id` LIMIT ?", attributeOf(span, "db.query.text").AsString())
+		require.NotEqual(t, codes.Error, span.Status().Code)
+	})
+
+	t.Run("실패 - query 에러는 span status 로 기록", func(t *testing.T) {
+		sqlMock.ExpectExec("DELETE FROM `test_rows`").WillReturnError(errors.New("lock wait timeout"))
+
+		require.Error(t, db.WithContext(ctx).Where("id = ?", "P00001").Delete(&testRow{}).Error)
+
+		spans := recorder.Ended()
+		require.Len(t, spans, 2)
+		require.Equal(t, "gorm.delete", spans[1].Name())
+		require.Equal(t, codes.Error, spans[1].Status().Code)
+		require.Equal(t, "lock wait timeout", spans[1].Status().Description)
+	})
+
+	require.NoError(t, sqlMock.ExpectationsWereMet())
+}
@@ -1,6 +1,9 @@
 package vital
 
-import "aitrics-vital-signs/api-server/pkg/constant"
+import (
+	"aitrics-vital-signs/api-server/pkg/constant"
+	"fmt"
+)
 
 type Comparator string
 
@@ -49,3 +52,31 @@ func EvaluateRule(value float64, rule RiskRule) bool {
 		return false
 	}
 }
+
+// RuleName 응답 / metric 에 사용하는 rule 이름 (예: HR > 120)
+func RuleName(rule RiskRule) string {
+	return fmt.Sprintf("%s %s %.0f", rule.VitalType, rule.Comparator, rule.Threshold)
+}
+
+// Evaluate vital type 별 값에 RiskRules 를 적용해 위험도와 충족한 rule 을 반환합니다. (metric 은 기록하지 않음)
+func Evaluate(values map[string]float64) (constant.RiskLevel, []string) {
+	triggeredRules := make([]string, 0, len(RiskRules))
+	for _, rule := range RiskRules {
+		value, exists := values[rule.VitalType]
+		if !exists {
+			continue
+		}
+		if EvaluateRule(value, rule) {
+			triggeredRules = append(triggeredRules, RuleName(rule))
+		}
+	}
+
+	switch {
+	case len(triggeredRules) >= 3:
+		return constant.RiskLevelHigh, triggeredRules
+	case len(triggeredRules) >= 1:
+		return constant.RiskLevelMedium, triggeredRules
+	default:
+		return constant.RiskLevelLow, triggeredRules
+	}
+}
@@ -0,0 +1,29 @@
+package constant
+
+// AuditAction 감사 기록 행위 유형
+type AuditAction string
+
+const (
+	AuditActionRead  AuditAction = "READ"
+	AuditActionWrite AuditAction = "WRITE"
+)
+
+func (a AuditAction) String() string {
+	return string(a)
+}
+
+// AuditOutcome 감사 기록 처리 결과
+type AuditOutcome string
+
+const (
+	AuditOutcomeSuccess AuditOutcome = "SUCCESS"
+	AuditOutcomeDenied  AuditOutcome = "DENIED" // 인증 / 권한 실패
+	AuditOutcomeFailure AuditOutcome = "FAILURE"
+)
+
+func (a AuditOutcome) String() string {
+	return string(a)
+}
+
+// AnonymousPrincipalID 인증 전 실패한 요청의 principal_id
+const AnonymousPrincipalID = "anonymous"
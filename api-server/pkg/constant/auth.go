@@ -0,0 +1,54 @@
+package constant
+
+// Scope API 권한 범위
+type Scope string
+
+const (
+	ScopePatientsRead  Scope = "patients:read"
+	ScopePatientsWrite Scope = "patients:write"
+	ScopeVitalsRead    Scope = "vitals:read"
+	ScopeVitalsWrite   Scope = "vitals:write"
+	ScopeInferenceRun  Scope = "inference:run"
+	ScopeAdmin         Scope = "admin" // 모든 scope 포함
+)
+
+func (s Scope) String() string {
+	return string(s)
+}
+
+// PrincipalType 인증 주체 유형
+type PrincipalType string
+
+const (
+	PrincipalTypeAPIKey      PrincipalType = "api_key"
+	PrincipalTypeLegacyToken PrincipalType = "legacy_token"
+	PrincipalTypeJWT         PrincipalType = "jwt"
+)
+
+func (p PrincipalType) String() string {
+	return string(p)
+}
+
+// Role IdP(JWT) 에서 전달되는 사용자 역할
+type Role string
+
+const (
+	RoleNurse      Role = "nurse"
+	RolePhysician  Role = "physician"
+	RoleResearcher Role = "researcher"
+	RoleDevice     Role = "device"
+	RoleAdmin      Role = "admin"
+)
+
+func (r Role) String() string {
+	return string(r)
+}
+
+// RolePermissions 역할별 허용 scope (role-to-permission matrix)
+var RolePermissions = map[Role][]Scope{
+	RoleNurse:      {ScopePatientsRead, ScopeVitalsRead, ScopeVitalsWrite, ScopeInferenceRun},
+	RolePhysician:  {ScopePatientsRead, ScopePatientsWrite, ScopeVitalsRead, ScopeVitalsWrite, ScopeInferenceRun},
+	RoleResearcher: {ScopePatientsRead, ScopeVitalsRead},
+	RoleDevice:     {ScopeVitalsWrite},
+	RoleAdmin:      {ScopeAdmin},
+}
@@ -0,0 +1,14 @@
+package constant
+
+// EncounterType 내원 유형
+type EncounterType string
+
+const (
+	EncounterTypeInpatient   EncounterType = "INPATIENT"
+	EncounterTypeEmergency   EncounterType = "EMERGENCY"
+	EncounterTypeObservation EncounterType = "OBSERVATION"
+)
+
+func (e EncounterType) String() string {
+	return string(e)
+}
@@ -0,0 +1,27 @@
+package constant
+
+// MergeCollisionPolicy 환자 병합 시 source / target 에 같은 vital key (recorded_at, vital_type) 가 있는 경우의 처리 정책
+type MergeCollisionPolicy string
+
+const (
+	MergeCollisionPolicyReject     MergeCollisionPolicy = "reject"      // 충돌이 있으면 병합하지 않음 (Conflict)
+	MergeCollisionPolicyKeepTarget MergeCollisionPolicy = "keep_target" // target 값을 유지하고 source 값은 삭제
+	MergeCollisionPolicyKeepSource MergeCollisionPolicy = "keep_source" // target 값을 source 값으로 교체
+)
+
+func (m MergeCollisionPolicy) String() string {
+	return string(m)
+}
+
+// MergeVitalAction 병합 시 source vital 의 처리 결과 (되돌리기에 사용)
+type MergeVitalAction string
+
+const (
+	MergeVitalActionMoved      MergeVitalAction = "moved"       // target 으로 이동
+	MergeVitalActionKeptTarget MergeVitalAction = "kept_target" // 충돌, target 값 유지 (source 삭제)
+	MergeVitalActionReplaced   MergeVitalAction = "replaced"    // 충돌, target 값을 source 값으로 교체 (source 삭제)
+)
+
+func (m MergeVitalAction) String() string {
+	return string(m)
+}
@@ -22,8 +22,39 @@ const (
 	RiskLevelLow    RiskLevel = "LOW"
 	RiskLevelMedium RiskLevel = "MEDIUM"
 	RiskLevelHigh   RiskLevel = "HIGH"
+	// RiskLevelUnknown 위험도를 계산할 수 없는 경우 (병동 조회에서 환자 정보를 찾지 못한 재원 환자 등)
+	RiskLevelUnknown RiskLevel = "UNKNOWN"
 )
 
 func (r RiskLevel) String() string {
 	return string(r)
 }
+
+// Severity 정렬용 위험도 (높을수록 위급)
+func (r RiskLevel) Severity() int {
+	switch r {
+	case RiskLevelHigh:
+		return 3
+	case RiskLevelMedium:
+		return 2
+	case RiskLevelLow:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// ConflictPolicy vital 저장 시 기존 데이터와 충돌한 경우의 처리 정책
+type ConflictPolicy string
+
+const (
+	ConflictPolicyStrict        ConflictPolicy = "strict"          // version 이 일치해야 저장 (불일치 시 Conflict)
+	ConflictPolicyLastWriteWins ConflictPolicy = "last_write_wins" // 항상 요청 값으로 덮어씀
+	ConflictPolicyKeepFirst     ConflictPolicy = "keep_first"      // 기존 값을 유지
+	ConflictPolicyMaxOf         ConflictPolicy = "max_of"          // 더 큰 값을 유지
+	ConflictPolicyMinOf         ConflictPolicy = "min_of"          // 더 작은 값을 유지
+)
+
+func (c ConflictPolicy) String() string {
+	return string(c)
+}
@@ -0,0 +1,14 @@
+package constant
+
+// BedAssignmentReason 병상 배정 / 해제 사유
+type BedAssignmentReason string
+
+const (
+	BedAssignmentReasonAdmit     BedAssignmentReason = "ADMIT"
+	BedAssignmentReasonTransfer  BedAssignmentReason = "TRANSFER"
+	BedAssignmentReasonDischarge BedAssignmentReason = "DISCHARGE"
+)
+
+func (b BedAssignmentReason) String() string {
+	return string(b)
+}
@@ -0,0 +1,403 @@
+package envs
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/url"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pelletier/go-toml/v2"
+	"gopkg.in/yaml.v3"
+)
+
+const redactedValue = "******"
+
+// Config
+// 설정 우선순위: 기본값 < 설정 파일(YAML / TOML) < 환경 변수 < flag(--set key=value)
+// key 는 yaml tag 를 '.' 로 연결한 경로(예: db.host), 환경 변수는 env tag, secret tag 가 붙은 값은 출력 시 마스킹됩니다.
+type Config struct {
+	Server      ServerConfig      `yaml:"server" toml:"server" json:"server"`
+	Log         LogConfig         `yaml:"log" toml:"log" json:"log"`
+	DB          DBConfig          `yaml:"db" toml:"db" json:"db"`
+	Auth        AuthConfig        `yaml:"auth" toml:"auth" json:"auth"`
+	Patient     PatientConfig     `yaml:"patient" toml:"patient" json:"patient"`
+	Vital       VitalConfig       `yaml:"vital" toml:"vital" json:"vital"`
+	Audit       AuditConfig       `yaml:"audit" toml:"audit" json:"audit"`
+	Tracing     TracingConfig     `yaml:"tracing" toml:"tracing" json:"tracing"`
+	Idempotency IdempotencyConfig `yaml:"idempotency" toml:"idempotency" json:"idempotency"`
+}
+
+type ServerConfig struct {
+	Name        string `yaml:"name" toml:"name" json:"name" env:"SERVER_NAME"`
+	ServiceType string `yaml:"service_type" toml:"service_type" json:"service_type" env:"SERVICE_TYPE"` // prd / stg / dev
+	Port        int    `yaml:"port" toml:"port" json:"port" env:"SERVER_PORT"`
+	// ShutdownDelaySeconds 종료 신호 후 readiness 를 not_ready 로 전환하고 연결 종료 전까지 대기하는 시간 (로드밸런서 제외 대기)
+	ShutdownDelaySeconds int `yaml:"shutdown_delay_seconds" toml:"shutdown_delay_seconds" json:"shutdown_delay_seconds" env:"SHUTDOWN_DELAY_SECONDS"`
+}
+
+type LogConfig struct {
+	Level string `yaml:"level" toml:"level" json:"level" env:"LOG_LEVEL"` // debug | info | warn | error | fatal
+	// Redaction 로그의 PHI 치환 여부: auto(prd / stg 만 적용) | on | off
+	Redaction string `yaml:"redaction" toml:"redaction" json:"redaction" env:"LOG_REDACTION"`
+	// RedactionKey 환자 ID hash key, 비어 있으면 프로세스마다 임의로 생성되어 인스턴스 간 hash 가 일치하지 않음
+	RedactionKey string `yaml:"redaction_key" toml:"redaction_key" json:"redaction_key" env:"LOG_REDACTION_KEY" secret:"true"`
+}
+
+const (
+	RedactionAuto = "auto"
+	RedactionOn   = "on"
+	RedactionOff  = "off"
+)
+
+// RedactionEnabled auto 이면 개발 환경에서만 원본 값을 기록합니다.
+func (c Config) RedactionEnabled() bool {
+	switch c.Log.Redaction {
+	case RedactionOn:
+		return true
+	case RedactionOff:
+		return false
+	default:
+		return c.Server.ServiceType != DevType
+	}
+}
+
+type DBConfig struct {
+	Host                        string `yaml:"host" toml:"host" json:"host" env:"DB_HOST"`
+	Port                        int    `yaml:"port" toml:"port" json:"port" env:"DB_PORT"`
+	Name                        string `yaml:"name" toml:"name" json:"name" env:"DB_NAME"`
+	User                        string `yaml:"user" toml:"user" json:"user" env:"DB_USER"`
+	Password                    string `yaml:"password" toml:"password" json:"password" env:"DB_PASSWORD" secret:"true"`
+	AutoMigrate                 bool   `yaml:"auto_migrate" toml:"auto_migrate" json:"auto_migrate" env:"DB_AUTO_MIGRATE"` // 개발 환경 전용: 기동 시 gorm AutoMigrate 실행
+	MigrationLockTimeoutSeconds int    `yaml:"migration_lock_timeout_seconds" toml:"migration_lock_timeout_seconds" json:"migration_lock_timeout_seconds" env:"MIGRATION_LOCK_TIMEOUT_SECONDS"`
+	TransactionIsolation        string `yaml:"transaction_isolation" toml:"transaction_isolation" json:"transaction_isolation" env:"DB_TRANSACTION_ISOLATION"` // 비어 있으면 DB 기본값 사용
+
+	// connection pool (replica 에도 동일하게 적용)
+	MaxOpenConns           int `yaml:"max_open_conns" toml:"max_open_conns" json:"max_open_conns" env:"DB_MAX_OPEN_CONNS"`
+	MaxIdleConns           int `yaml:"max_idle_conns" toml:"max_idle_conns" json:"max_idle_conns" env:"DB_MAX_IDLE_CONNS"`
+	ConnMaxLifetimeSeconds int `yaml:"conn_max_lifetime_seconds" toml:"conn_max_lifetime_seconds" json:"conn_max_lifetime_seconds" env:"DB_CONN_MAX_LIFETIME_SECONDS"`     // 0 이면 제한 없음
+	ConnMaxIdleTimeSeconds int `yaml:"conn_max_idle_time_seconds" toml:"conn_max_idle_time_seconds" json:"conn_max_idle_time_seconds" env:"DB_CONN_MAX_IDLE_TIME_SECONDS"` // 0 이면 제한 없음
+
+	// read replica (replica 별로 읽기 전용 계정을 사용할 수 있도록 DSN 전체를 설정)
+	Replicas                          string `yaml:"replicas" toml:"replicas" json:"replicas" env:"DB_REPLICAS" secret:"true"` // "user:password@tcp(host:port)/dbname" 목록 (쉼표 구분)
+	ReplicaHealthCheckIntervalSeconds int    `yaml:"replica_health_check_interval_seconds" toml:"replica_health_check_interval_seconds" json:"replica_health_check_interval_seconds" env:"DB_REPLICA_HEALTH_CHECK_INTERVAL_SECONDS"`
+}
+
+// replicaDSNPattern go-sql-driver DSN 중 tcp 연결 형식 (password 에 '@' 가 있을 수 있어 마지막 '@' 기준)
+var replicaDSNPattern = regexp.MustCompile(`^.+@tcp\(([^()]+)\)/[^/?]+(\?.*)?$`)
+
+// ReplicaDSNs replicas 설정을 DSN 목록으로 반환합니다. (빈 항목 제외)
+func (c DBConfig) ReplicaDSNs() []string {
+	var dsns []string
+	for _, dsn := range strings.Split(c.Replicas, ",") {
+		if dsn = strings.TrimSpace(dsn); dsn != "" {
+			dsns = append(dsns, dsn)
+		}
+	}
+	return dsns
+}
+
+type AuthConfig struct {
+	Token               string `yaml:"token" toml:"token" json:"token" env:"TOKEN" secret:"true"`
+	JWKSSource          string `yaml:"jwks_source" toml:"jwks_source" json:"jwks_source" env:"JWKS_SOURCE"` // JWKS URL(http/https) 혹은 로컬 파일 경로
+	JWKSCacheTTLMinutes int    `yaml:"jwks_cache_ttl_minutes" toml:"jwks_cache_ttl_minutes" json:"jwks_cache_ttl_minutes" env:"JWKS_CACHE_TTL_MINUTES"`
+	JWTIssuer           string `yaml:"jwt_issuer" toml:"jwt_issuer" json:"jwt_issuer" env:"JWT_ISSUER"`
+	JWTAudience         string `yaml:"jwt_audience" toml:"jwt_audience" json:"jwt_audience" env:"JWT_AUDIENCE"`
+	JWTRoleClaim        string `yaml:"jwt_role_claim" toml:"jwt_role_claim" json:"jwt_role_claim" env:"JWT_ROLE_CLAIM"` // 중첩 claim 은 realm_access.roles 처럼 '.' 로 구분
+}
+
+type PatientConfig struct {
+	// ReplaceOnPut version / If-Match 가 없는 PUT /patients/{patient_id} 를 등록 또는 교체로 처리 (EHR 동기화용 interface engine)
+	ReplaceOnPut bool `yaml:"replace_on_put" toml:"replace_on_put" json:"replace_on_put" env:"PATIENT_REPLACE_ON_PUT"`
+}
+
+type VitalConfig struct {
+	RiskTimeWindowHours int `yaml:"risk_time_window_hours" toml:"risk_time_window_hours" json:"risk_time_window_hours" env:"VITAL_RISK_TIME_WINDOW_HOURS"`
+}
+
+type AuditConfig struct {
+	BufferSize      int `yaml:"buffer_size" toml:"buffer_size" json:"buffer_size" env:"AUDIT_BUFFER_SIZE"`
+	BatchSize       int `yaml:"batch_size" toml:"batch_size" json:"batch_size" env:"AUDIT_BATCH_SIZE"`
+	FlushIntervalMs int `yaml:"flush_interval_ms" toml:"flush_interval_ms" json:"flush_interval_ms" env:"AUDIT_FLUSH_INTERVAL_MS"`
+}
+
+type TracingConfig struct {
+	// Endpoint OTLP/HTTP collector 주소 (예: http://otel-collector:4318), 비어 있으면 span 을 내보내지 않음
+	Endpoint    string  `yaml:"endpoint" toml:"endpoint" json:"endpoint" env:"OTEL_EXPORTER_OTLP_ENDPOINT"`
+	SampleRatio float64 `yaml:"sample_ratio" toml:"sample_ratio" json:"sample_ratio" env:"TRACING_SAMPLE_RATIO"` // 상위 요청에 sampling 결정이 없을 때 적용 (0 ~ 1)
+}
+
+type IdempotencyConfig struct {
+	// TTLHours Idempotency-Key 와 저장된 응답의 보관 시간 (이후 같은 key 는 새 요청으로 처리)
+	TTLHours int `yaml:"ttl_hours" toml:"ttl_hours" json:"ttl_hours" env:"IDEMPOTENCY_TTL_HOURS"`
+}
+
+type LoadOptions struct {
+	File      string            // 설정 파일 경로 (비어 있으면 CONFIG_FILE 환경 변수, 둘 다 없으면 생략)
+	Overrides map[string]string // key 경로 → 값 (flag 로 전달된 값)
+}
+
+// ValidationError 발견된 설정 문제를 한 번에 보고합니다.
+type ValidationError struct {
+	Problems []string
+}
+
+func (e *ValidationError) Error() string {
+	return "invalid config:\n  - " + strings.Join(e.Problems, "\n  - ")
+}
+
+func Default() Config {
+	return Config{
+		Server:      ServerConfig{Name: "aitrics-vital-signs", ServiceType: DevType, Port: 8080},
+		Log:         LogConfig{Level: "debug", Redaction: RedactionAuto},
+		DB:          DBConfig{Port: 3306, MigrationLockTimeoutSeconds: 30, MaxOpenConns: 25, MaxIdleConns: 20, ConnMaxLifetimeSeconds: 300, ReplicaHealthCheckIntervalSeconds: 5},
+		Auth:        AuthConfig{JWKSCacheTTLMinutes: 60, JWTRoleClaim: "roles"},
+		Vital:       VitalConfig{RiskTimeWindowHours: 24},
+		Audit:       AuditConfig{BufferSize: 1024, BatchSize: 100, FlushIntervalMs: 1000},
+		Tracing:     TracingConfig{SampleRatio: 1},
+		Idempotency: IdempotencyConfig{TTLHours: 24},
+	}
+}
+
+// Load
+// 기본값에 설정 파일, 환경 변수, overrides 를 차례로 적용한 뒤 검증합니다.
+// 파싱 / 검증 문제는 모두 모아 *ValidationError 로 반환합니다.
+func Load(options LoadOptions) (*Config, error) {
+	config := Default()
+	var problems []string
+
+	file := options.File
+	if file == "" {
+		file = os.Getenv("CONFIG_FILE")
+	}
+	if file != "" {
+		if err := loadFile(file, &config); err != nil {
+			problems = append(problems, err.Error())
+		}
+	}
+
+	for _, field := range configFields(&config) {
+		if value, ok := os.LookupEnv(field.env); ok && value != "" {
+			if err := field.set(value); err != nil {
+				problems = append(problems, fmt.Sprintf("%s: %s", field.env, err.Error()))
+			}
+		}
+	}
+
+	fields := make(map[string]configField)
+	for _, field := range configFields(&config) {
+		fields[field.key] = field
+	}
+	keys := make([]string, 0, len(options.Overrides))
+	for key := range options.Overrides {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		value := options.Overrides[key]
+		field, ok := fields[key]
+		if !ok {
+			problems = append(problems, fmt.Sprintf("unknown config key: %s", key))
+			continue
+		}
+		if err := field.set(value); err != nil {
+			problems = append(problems, fmt.Sprintf("%s: %s", key, err.Error()))
+		}
+	}
+
+	problems = append(problems, config.problems()...)
+	if len(problems) > 0 {
+		return nil, &ValidationError{Problems: problems}
+	}
+
+	return &config, nil
+}
+
+// Validate 설정 값을 검증하고 모든 문제를 반환합니다.
+func (c *Config) Validate() error {
+	if problems := c.problems(); len(problems) > 0 {
+		return &ValidationError{Problems: problems}
+	}
+	return nil
+}
+
+func (c *Config) problems() []string {
+	var problems []string
+	addIf := func(invalid bool, format string, args ...interface{}) {
+		if invalid {
+			problems = append(problems, fmt.Sprintf(format, args...))
+		}
+	}
+
+	switch c.Server.ServiceType {
+	case PrdType, StageType, DevType:
+	default:
+		problems = append(problems, fmt.Sprintf("server.service_type must be one of prd, stg, dev: %q", c.Server.ServiceType))
+	}
+	addIf(c.Server.Port < 1 || c.Server.Port > 65535, "server.port must be 1-65535: %d", c.Server.Port)
+	addIf(c.Server.ShutdownDelaySeconds < 0, "server.shutdown_delay_seconds must not be negative: %d", c.Server.ShutdownDelaySeconds)
+
+	switch strings.ToLower(c.Log.Level) {
+	case "debug", "info", "warn", "error", "fatal":
+	default:
+		problems = append(problems, fmt.Sprintf("log.level must be one of debug, info, warn, error, fatal: %q", c.Log.Level))
+	}
+	switch c.Log.Redaction {
+	case RedactionAuto, RedactionOn, RedactionOff:
+	default:
+		problems = append(problems, fmt.Sprintf("log.redaction must be one of auto, on, off: %q", c.Log.Redaction))
+	}
+	addIf(c.Log.Redaction == RedactionOff && c.Server.ServiceType == PrdType, "log.redaction must not be off in prd")
+
+	addIf(c.DB.Host == "", "db.host is required")
+	addIf(c.DB.Port < 1 || c.DB.Port > 65535, "db.port must be 1-65535: %d", c.DB.Port)
+	addIf(c.DB.Name == "", "db.name is required")
+	addIf(c.DB.User == "", "db.user is required")
+	addIf(c.DB.MigrationLockTimeoutSeconds < 1, "db.migration_lock_timeout_seconds must be positive: %d", c.DB.MigrationLockTimeoutSeconds)
+	addIf(c.DB.AutoMigrate && c.Server.ServiceType == PrdType, "db.auto_migrate must not be enabled in prd")
+	switch strings.ToUpper(c.DB.TransactionIsolation) {
+	case "", "READ UNCOMMITTED", "READ COMMITTED", "REPEATABLE READ", "SERIALIZABLE":
+	default:
+		problems = append(problems, fmt.Sprintf("db.transaction_isolation must be one of READ UNCOMMITTED, READ COMMITTED, REPEATABLE READ, SERIALIZABLE: %q", c.DB.TransactionIsolation))
+	}
+	addIf(c.DB.MaxOpenConns < 1, "db.max_open_conns must be positive: %d", c.DB.MaxOpenConns)
+	addIf(c.DB.MaxIdleConns < 0 || c.DB.MaxIdleConns > c.DB.MaxOpenConns, "db.max_idle_conns must be 0-%d (db.max_open_conns): %d", c.DB.MaxOpenConns, c.DB.MaxIdleConns)
+	addIf(c.DB.ConnMaxLifetimeSeconds < 0, "db.conn_max_lifetime_seconds must not be negative: %d", c.DB.ConnMaxLifetimeSeconds)
+	addIf(c.DB.ConnMaxIdleTimeSeconds < 0, "db.conn_max_idle_time_seconds must not be negative: %d", c.DB.ConnMaxIdleTimeSeconds)
+	// DSN 에는 password 가 포함되므로 값 대신 순번으로 알립니다.
+	for idx, dsn := range c.DB.ReplicaDSNs() {
+		valid := false
+		if match := replicaDSNPattern.FindStringSubmatch(dsn); match != nil {
+			host, port, err := net.SplitHostPort(match[1])
+			p, _ := strconv.Atoi(port)
+			valid = err == nil && host != "" && p >= 1 && p <= 65535
+		}
+		addIf(!valid, "db.replicas[%d] must be user:password@tcp(host:port)/dbname", idx)
+	}
+	addIf(c.DB.ReplicaHealthCheckIntervalSeconds < 1, "db.replica_health_check_interval_seconds must be positive: %d", c.DB.ReplicaHealthCheckIntervalSeconds)
+
+	addIf(c.Auth.JWKSCacheTTLMinutes < 1, "auth.jwks_cache_ttl_minutes must be positive: %d", c.Auth.JWKSCacheTTLMinutes)
+	addIf(c.Auth.JWKSSource != "" && c.Auth.JWTRoleClaim == "", "auth.jwt_role_claim is required when auth.jwks_source is set")
+
+	addIf(c.Vital.RiskTimeWindowHours < 1, "vital.risk_time_window_hours must be positive: %d", c.Vital.RiskTimeWindowHours)
+
+	addIf(c.Audit.BufferSize < 1, "audit.buffer_size must be positive: %d", c.Audit.BufferSize)
+	addIf(c.Audit.BatchSize < 1, "audit.batch_size must be positive: %d", c.Audit.BatchSize)
+	addIf(c.Audit.FlushIntervalMs < 1, "audit.flush_interval_ms must be positive: %d", c.Audit.FlushIntervalMs)
+	addIf(c.Idempotency.TTLHours < 1, "idempotency.ttl_hours must be positive: %d", c.Idempotency.TTLHours)
+
+	if c.Tracing.Endpoint != "" {
+		u, err := url.Parse(c.Tracing.Endpoint)
+		addIf(err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "", "tracing.endpoint must be an http(s) URL: %q", c.Tracing.Endpoint)
+	}
+	addIf(c.Tracing.SampleRatio < 0 || c.Tracing.SampleRatio > 1, "tracing.sample_ratio must be 0-1: %v", c.Tracing.SampleRatio)
+
+	return problems
+}
+
+// Redacted secret 값을 마스킹한 사본을 반환합니다. (비어 있는 값은 설정 여부를 알 수 있도록 그대로 둡니다)
+func (c Config) Redacted() Config {
+	for _, field := range configFields(&c) {
+		if field.secret && field.value.String() != "" {
+			field.value.SetString(redactedValue)
+		}
+	}
+	return c
+}
+
+// String fmt / 로그 출력 시에도 secret 이 노출되지 않도록 마스킹된 JSON 으로 출력합니다.
+func (c Config) String() string {
+	b, _ := json.Marshal(c.Redacted())
+	return string(b)
+}
+
+func loadFile(path string, config *Config) error {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("config file: %w", err)
+	}
+
+	switch ext := strings.ToLower(filepath.Ext(path)); ext {
+	case ".yaml", ".yml":
+		decoder := yaml.NewDecoder(bytes.NewReader(b))
+		decoder.KnownFields(true)
+		if err := decoder.Decode(config); err != nil && !errors.Is(err, io.EOF) {
+			return fmt.Errorf("config file %s: %w", path, err)
+		}
+	case ".toml":
+		decoder := toml.NewDecoder(bytes.NewReader(b))
+		decoder.DisallowUnknownFields()
+		if err := decoder.Decode(config); err != nil {
+			return fmt.Errorf("config file %s: %w", path, err)
+		}
+	default:
+		return fmt.Errorf("config file %s: unsupported extension %q (yaml, yml, toml)", path, ext)
+	}
+
+	return nil
+}
+
+type configField struct {
+	key    string
+	env    string
+	secret bool
+	value  reflect.Value
+}
+
+func (f configField) set(raw string) error {
+	raw = strings.TrimSpace(raw)
+	switch f.value.Kind() {
+	case reflect.String:
+		f.value.SetString(raw)
+	case reflect.Int:
+		v, err := strconv.Atoi(raw)
+		if err != nil {
+			return fmt.Errorf("invalid integer %q", raw)
+		}
+		f.value.SetInt(int64(v))
+	case reflect.Float64:
+		v, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			return fmt.Errorf("invalid number %q", raw)
+		}
+		f.value.SetFloat(v)
+	case reflect.Bool:
+		v, err := strconv.ParseBool(raw)
+		if err != nil {
+			return fmt.Errorf("invalid boolean %q", raw)
+		}
+		f.value.SetBool(v)
+	default:
+		return fmt.Errorf("unsupported type %s", f.value.Kind())
+	}
+	return nil
+}
+
+// configFields Config 의 leaf field 목록 (section.key 순서대로)
+func configFields(config *Config) []configField {
+	var fields []configField
+	root := reflect.ValueOf(config).Elem()
+	for i := 0; i < root.NumField(); i++ {
+		section := root.Field(i)
+		sectionKey := root.Type().Field(i).Tag.Get("yaml")
+		for j := 0; j < section.NumField(); j++ {
+			field := section.Type().Field(j)
+			fields = append(fields, configField{
+				key:    sectionKey + "." + field.Tag.Get("yaml"),
+				env:    field.Tag.Get("env"),
+				secret: field.Tag.Get("secret") == "true",
+				value:  section.Field(j),
+			})
+		}
+	}
+	return fields
+}
@@ -0,0 +1,219 @@
+package envs
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func writeFile(t *testing.T, name, content string) string {
+	path := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+func setRequiredDBEnv(t *testing.T) {
+	t.Setenv("DB_HOST", "localhost")
+	t.Setenv("DB_NAME", "aitrics_db")
+	t.Setenv("DB_USER", "aitrics")
+}
+
+func Test_Load(t *testing.T) {
+	t.Run("성공 - 기본값 < 파일 < 환경 변수 < override", func(t *testing.T) {
+		file := writeFile(t, "config.yaml", `
+server:
+  port: 9090
+  service_type: stg
+db:
+  host: db.internal
+  name: aitrics_db
+  user: aitrics
+  password: from-file
+vital:
+  risk_time_window_hours: 12
+`)
+		t.Setenv("DB_HOST", "db.env")
+		t.Setenv("VITAL_RISK_TIME_WINDOW_HOURS", "6")
+
+		config, err := Load(LoadOptions{File: file, Overrides: map[string]string{"vital.risk_time_window_hours": "3"}})
+		require.NoError(t, err)
+		require.Equal(t, 9090, config.Server.Port)
+		require.Equal(t, StageType, config.Server.ServiceType)
+		require.Equal(t, "db.env", config.DB.Host)
+		require.Equal(t, "from-file", config.DB.Password)
+		require.Equal(t, 3, config.Vital.RiskTimeWindowHours)
+		require.Equal(t, 1024, config.Audit.BufferSize)
+	})
+
+	t.Run("성공 - toml 파일", func(t *testing.T) {
+		file := writeFile(t, "config.toml", `
+[db]
+host = "db.internal"
+name = "aitrics_db"
+user = "aitrics"
+
+[audit]
+batch_size = 50
+`)
+
+		config, err := Load(LoadOptions{File: file})
+		require.NoError(t, err)
+		require.Equal(t, "db.internal", config.DB.Host)
+		require.Equal(t, 50, config.Audit.BatchSize)
+	})
+
+	t.Run("성공 - CONFIG_FILE 환경 변수", func(t *testing.T) {
+		t.Setenv("CONFIG_FILE", writeFile(t, "config.yml", "db:\n  host: a\n  name: b\n  user: c\n"))
+
+		config, err := Load(LoadOptions{})
+		require.NoError(t, err)
+		require.Equal(t, "a", config.DB.Host)
+	})
+
+	t.Run("실패 - 모든 문제를 한 번에 보고", func(t *testing.T) {
+		t.Setenv("SERVER_PORT", "abc")
+		t.Setenv("VITAL_RISK_TIME_WINDOW_HOURS", "0")
+		t.Setenv("SERVICE_TYPE", "qa")
+		t.Setenv("DB_TRANSACTION_ISOLATION", "SNAPSHOT")
+
+		_, err := Load(LoadOptions{Overrides: map[string]string{"db.unknown": "x", "db.auto_migrate": "maybe"}})
+		require.Error(t, err)
+
+		var validationErr *ValidationError
+		require.True(t, errors.As(err, &validationErr))
+		require.ElementsMatch(t, []string{
+			`SERVER_PORT: invalid integer "abc"`,
+			`db.auto_migrate: invalid boolean "maybe"`,
+			"unknown config key: db.unknown",
+			`server.service_type must be one of prd, stg, dev: "qa"`,
+			"db.host is required",
+			"db.name is required",
+			"db.user is required",
+			`db.transaction_isolation must be one of READ UNCOMMITTED, READ COMMITTED, REPEATABLE READ, SERIALIZABLE: "SNAPSHOT"`,
+			"vital.risk_time_window_hours must be positive: 0",
+		}, validationErr.Problems)
+	})
+
+	t.Run("실패 - 파일의 알 수 없는 key", func(t *testing.T) {
+		setRequiredDBEnv(t)
+		file := writeFile(t, "config.yaml", "server:\n  prot: 9090\n")
+
+		_, err := Load(LoadOptions{File: file})
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "prot")
+	})
+
+	t.Run("실패 - 지원하지 않는 확장자", func(t *testing.T) {
+		setRequiredDBEnv(t)
+		file := writeFile(t, "config.json", "{}")
+
+		_, err := Load(LoadOptions{File: file})
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "unsupported extension")
+	})
+}
+
+func Test_Redacted(t *testing.T) {
+	config := Default()
+	config.DB.Password = "secret-password"
+	config.Auth.Token = ""
+
+	redacted := config.Redacted()
+	require.Equal(t, redactedValue, redacted.DB.Password)
+	require.Equal(t, "", redacted.Auth.Token)
+	require.Equal(t, "secret-password", config.DB.Password)
+
+	require.False(t, strings.Contains(config.String(), "secret-password"))
+	require.Contains(t, config.String(), `"password":"******"`)
+}
+
+func Test_RedactionEnabled(t *testing.T) {
+	tests := []struct {
+		name        string
+		serviceType string
+		redaction   string
+		want        bool
+	}{
+		{name: "성공 - auto 는 dev 에서 원본 기록", serviceType: DevType, redaction: RedactionAuto, want: false},
+		{name: "성공 - auto 는 stg 에서 치환", serviceType: StageType, redaction: RedactionAuto, want: true},
+		{name: "성공 - auto 는 prd 에서 치환", serviceType: PrdType, redaction: RedactionAuto, want: true},
+		{name: "성공 - dev 에서도 on 이면 치환", serviceType: DevType, redaction: RedactionOn, want: true},
+		{name: "성공 - stg 에서 off", serviceType: StageType, redaction: RedactionOff, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := Default()
+			config.Server.ServiceType = tt.serviceType
+			config.Log.Redaction = tt.redaction
+			require.Equal(t, tt.want, config.RedactionEnabled())
+		})
+	}
+
+	t.Run("실패 - prd 에서 off", func(t *testing.T) {
+		config := Default()
+		config.Server.ServiceType = PrdType
+		config.Log.Redaction = RedactionOff
+		config.DB = DBConfig{Host: "a", Port: 3306, Name: "b", User: "c", MigrationLockTimeoutSeconds: 1}
+
+		err := config.Validate()
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "log.redaction must not be off in prd")
+	})
+}
+
+func Test_Apply(t *testing.T) {
+	defer Apply(Default())
+
+	config := Default()
+	config.Server.Port = 9090
+	config.DB.Port = 3307
+	config.Vital.RiskTimeWindowHours = 6
+	config.Server.ServiceType = StageType
+	Apply(config)
+
+	require.Equal(t, "9090", ServerPort)
+	require.Equal(t, "3307", DBPort)
+	require.Equal(t, 6, VitalRiskTimeWindowHours)
+	require.True(t, LogRedaction)
+	require.Equal(t, config, Current())
+}
+
+func Test_DBReplicas(t *testing.T) {
+	t.Run("성공 - 쉼표로 구분된 replica DSN 목록", func(t *testing.T) {
+		setRequiredDBEnv(t)
+		t.Setenv("DB_REPLICAS", " reader:p@ss@tcp(replica-1:3306)/aitrics_db, ,reader:secret@tcp(replica-2:3307)/aitrics_db?timeout=3s ")
+
+		config, err := Load(LoadOptions{})
+		require.NoError(t, err)
+		require.Equal(t, []string{
+			"reader:p@ss@tcp(replica-1:3306)/aitrics_db",
+			"reader:secret@tcp(replica-2:3307)/aitrics_db?timeout=3s",
+		}, config.DB.ReplicaDSNs())
+
+		// replica DSN 의 password 는 출력 시 마스킹
+		require.NotContains(t, config.String(), "secret@tcp")
+	})
+
+	t.Run("실패 - 잘못된 replica 주소와 pool 설정", func(t *testing.T) {
+		setRequiredDBEnv(t)
+		t.Setenv("DB_REPLICAS", "reader:secret@tcp(replica-1:3306)/aitrics_db,reader:secret@tcp(replica-2)/aitrics_db")
+		t.Setenv("DB_MAX_OPEN_CONNS", "10")
+		t.Setenv("DB_MAX_IDLE_CONNS", "20")
+
+		_, err := Load(LoadOptions{})
+		require.Error(t, err)
+
+		var validationErr *ValidationError
+		require.True(t, errors.As(err, &validationErr))
+		require.ElementsMatch(t, []string{
+			"db.max_idle_conns must be 0-10 (db.max_open_conns): 20",
+			"db.replicas[1] must be user:password@tcp(host:port)/dbname",
+		}, validationErr.Problems)
+		require.NotContains(t, err.Error(), "secret")
+	})
+}
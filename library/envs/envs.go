@@ -1,9 +1,6 @@
 package envs
 
-import (
-	"os"
-	"strconv"
-)
+import "strconv"
 
 const (
 	PrdType   = "prd"
@@ -11,39 +8,115 @@ const (
 	DevType   = "dev"
 )
 
+// 패키지 변수는 Apply 로 적용된 설정 값입니다. (import 시점에는 기본값, 실행 시 Load 결과로 갱신)
 var (
-	ServerName  = getEnv("SERVER_NAME", "aitrics-vital-signs")
-	ServiceType = getEnv("SERVICE_TYPE", "dev") // prd / stg / dev
-	ServerPort  = getEnv("SERVER_PORT", "8080")
+	ServerName  string
+	ServiceType string // prd / stg / dev
+	ServerPort  string
+
+	ShutdownDelaySeconds int
+
+	LogLevel string // debug | info | warn | error |
+
+	LogRedaction    bool // 로그의 PHI 치환 여부 (log.redaction 과 service type 으로 결정)
+	LogRedactionKey string
+
+	DBHost     string
+	DBPort     string
+	DBName     string
+	DBUser     string
+	DBPassword string
+
+	DBAutoMigrate               bool // 개발 환경 전용: 기동 시 gorm AutoMigrate 실행
+	MigrationLockTimeoutSeconds int
+	DBTransactionIsolation      string // 비어 있으면 DB 기본값 사용
 
-	LogLevel = getEnv("LOG_LEVEL", "debug") // debug | info | warn | error |
+	DBMaxOpenConns           int
+	DBMaxIdleConns           int
+	DBConnMaxLifetimeSeconds int // 0 이면 제한 없음
+	DBConnMaxIdleTimeSeconds int // 0 이면 제한 없음
 
-	DBHost     = getEnv("DB_HOST", "")
-	DBPort     = getEnv("DB_PORT", "")
-	DBName     = getEnv("DB_NAME", "")
-	DBUser     = getEnv("DB_USER", "")
-	DBPassword = getEnv("DB_PASSWORD", "")
+	DBReplicas                          []string // replica DSN 목록, 비어 있으면 모든 요청을 primary 로 처리
+	DBReplicaHealthCheckIntervalSeconds int
 
-	Token = getEnv("TOKEN", "")
+	Token string
 
-	VitalRiskTimeWindowHours = getEnvAsInt("VITAL_RISK_TIME_WINDOW_HOURS", 24)
+	JWKSSource          string // JWKS URL(http/https) 혹은 로컬 파일 경로
+	JWKSCacheTTLMinutes int
+	JWTIssuer           string
+	JWTAudience         string
+	JWTRoleClaim        string // 중첩 claim 은 realm_access.roles 처럼 '.' 로 구분
+
+	PatientReplaceOnPut bool // version 없는 PUT /patients/{patient_id} 를 등록 / 교체로 처리
+
+	VitalRiskTimeWindowHours int
+
+	AuditBufferSize      int
+	AuditBatchSize       int
+	AuditFlushIntervalMs int
+
+	TracingEndpoint    string // 비어 있으면 span 을 내보내지 않음
+	TracingSampleRatio float64
+
+	IdempotencyTTLHours int
 )
 
-func getEnv(envName, defaultVal string) string {
-	envVal := os.Getenv(envName)
-	if envVal == "" {
-		envVal = defaultVal
-	}
-	return envVal
+var current Config
+
+func init() {
+	Apply(Default())
+}
+
+// Apply 설정을 패키지 변수에 반영합니다. 요청 처리 시작 전(기동 시) 한 번만 호출합니다.
+func Apply(config Config) {
+	current = config
+
+	ServerName = config.Server.Name
+	ServiceType = config.Server.ServiceType
+	ServerPort = strconv.Itoa(config.Server.Port)
+	ShutdownDelaySeconds = config.Server.ShutdownDelaySeconds
+
+	LogLevel = config.Log.Level
+	LogRedaction = config.RedactionEnabled()
+	LogRedactionKey = config.Log.RedactionKey
+
+	DBHost = config.DB.Host
+	DBPort = strconv.Itoa(config.DB.Port)
+	DBName = config.DB.Name
+	DBUser = config.DB.User
+	DBPassword = config.DB.Password
+	DBAutoMigrate = config.DB.AutoMigrate
+	MigrationLockTimeoutSeconds = config.DB.MigrationLockTimeoutSeconds
+	DBTransactionIsolation = config.DB.TransactionIsolation
+	DBMaxOpenConns = config.DB.MaxOpenConns
+	DBMaxIdleConns = config.DB.MaxIdleConns
+	DBConnMaxLifetimeSeconds = config.DB.ConnMaxLifetimeSeconds
+	DBConnMaxIdleTimeSeconds = config.DB.ConnMaxIdleTimeSeconds
+	DBReplicas = config.DB.ReplicaDSNs()
+	DBReplicaHealthCheckIntervalSeconds = config.DB.ReplicaHealthCheckIntervalSeconds
+
+	Token = config.Auth.Token
+	JWKSSource = config.Auth.JWKSSource
+	JWKSCacheTTLMinutes = config.Auth.JWKSCacheTTLMinutes
+	JWTIssuer = config.Auth.JWTIssuer
+	JWTAudience = config.Auth.JWTAudience
+	JWTRoleClaim = config.Auth.JWTRoleClaim
+
+	PatientReplaceOnPut = config.Patient.ReplaceOnPut
+
+	VitalRiskTimeWindowHours = config.Vital.RiskTimeWindowHours
+
+	AuditBufferSize = config.Audit.BufferSize
+	AuditBatchSize = config.Audit.BatchSize
+	AuditFlushIntervalMs = config.Audit.FlushIntervalMs
+
+	TracingEndpoint = config.Tracing.Endpoint
+	TracingSampleRatio = config.Tracing.SampleRatio
+
+	IdempotencyTTLHours = config.Idempotency.TTLHours
 }
 
-func getEnvAsInt(envName string, defaultVal int) int {
-	envVal := os.Getenv(envName)
-	if envVal == "" {
-		return defaultVal
-	}
-	if intVal, err := strconv.Atoi(envVal); err == nil && intVal > 0 {
-		return intVal
-	}
-	return defaultVal
+// Current 현재 적용된 설정 (secret 포함, 외부 노출 시 Redacted 사용)
+func Current() Config {
+	return current
 }
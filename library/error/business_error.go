@@ -63,6 +63,14 @@ func WrapWithCode(err error, code Code, details ...string) error {
 	return newBusinessError(err, base)
 }
 
+// WithData err 의 business error 응답 data 를 설정합니다. (예: Conflict 시 이미 존재하는 resource)
+func WithData(err error, data interface{}) error {
+	if be, ok := CastBusinessError(err); ok {
+		be.Status.Data = data
+	}
+	return err
+}
+
 func CastBusinessError(err error) (*BusinessError, bool) {
 	for err != nil {
 		var be *BusinessError
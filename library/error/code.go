@@ -2,6 +2,7 @@ package error
 
 import (
 	"net/http"
+	"sort"
 )
 
 type Code int
@@ -9,25 +10,63 @@ type Code int
 const (
 	None Code = 0
 
-	Create Code = 100001
-	Update Code = 100002
-	Delete Code = 100003
-	Upsert Code = 100004
-	Get    Code = 100005
+	Create          Code = 100001
+	Update          Code = 100002
+	Delete          Code = 100003
+	Upsert          Code = 100004
+	Get             Code = 100005
+	Transaction     Code = 100006
+	AuthUnavailable Code = 100007
 
 	WrongParam Code = 400001
 	Conflict   Code = 400002
 	NotFound   Code = 400003
+
+	Unauthorized          Code = 400004
+	Forbidden             Code = 400005
+	TokenExpired          Code = 400006
+	InvalidTokenSignature Code = 400007
+	MissingRole           Code = 400008
+	PreconditionFailed    Code = 400009
+	IdempotencyKeyReused  Code = 400010
 )
 
 var businessCodeMap = map[Code]Status{
-	None:       {int(None), http.StatusInternalServerError, "not exists error", nil, nil},
-	Create:     {int(Create), http.StatusInternalServerError, "fail to create data", nil, nil},
-	Update:     {int(Update), http.StatusInternalServerError, "fail to update data", nil, nil},
-	Delete:     {int(Delete), http.StatusInternalServerError, "fail to delete data", nil, nil},
-	Upsert:     {int(Upsert), http.StatusInternalServerError, "fail to upsert data", nil, nil},
-	Get:        {int(Get), http.StatusInternalServerError, "fail to get data", nil, nil},
-	WrongParam: {int(WrongParam), http.StatusBadRequest, "wrong parameter", nil, nil},
-	Conflict:   {int(Conflict), http.StatusConflict, "conflict data", nil, nil},
-	NotFound:   {int(NotFound), http.StatusNotFound, "not found data", nil, nil},
+	None:            {int(None), http.StatusInternalServerError, "not exists error", nil, nil},
+	Create:          {int(Create), http.StatusInternalServerError, "fail to create data", nil, nil},
+	Update:          {int(Update), http.StatusInternalServerError, "fail to update data", nil, nil},
+	Delete:          {int(Delete), http.StatusInternalServerError, "fail to delete data", nil, nil},
+	Upsert:          {int(Upsert), http.StatusInternalServerError, "fail to upsert data", nil, nil},
+	Get:             {int(Get), http.StatusInternalServerError, "fail to get data", nil, nil},
+	Transaction:     {int(Transaction), http.StatusInternalServerError, "fail to process transaction", nil, nil},
+	AuthUnavailable: {int(AuthUnavailable), http.StatusServiceUnavailable, "authentication key set is unavailable", nil, nil},
+	WrongParam:      {int(WrongParam), http.StatusBadRequest, "wrong parameter", nil, nil},
+	Conflict:        {int(Conflict), http.StatusConflict, "conflict data", nil, nil},
+	NotFound:        {int(NotFound), http.StatusNotFound, "not found data", nil, nil},
+
+	Unauthorized:          {int(Unauthorized), http.StatusUnauthorized, "unauthorized", nil, nil},
+	Forbidden:             {int(Forbidden), http.StatusForbidden, "forbidden", nil, nil},
+	TokenExpired:          {int(TokenExpired), http.StatusUnauthorized, "token is expired", nil, nil},
+	InvalidTokenSignature: {int(InvalidTokenSignature), http.StatusUnauthorized, "invalid token signature", nil, nil},
+	MissingRole:           {int(MissingRole), http.StatusForbidden, "missing role", nil, nil},
+	PreconditionFailed:    {int(PreconditionFailed), http.StatusPreconditionFailed, "precondition failed", nil, nil},
+	IdempotencyKeyReused:  {int(IdempotencyKeyReused), http.StatusUnprocessableEntity, "idempotency key reused with different request", nil, nil},
+}
+
+// Catalog 등록된 모든 business code 를 code 순으로 반환합니다.
+func Catalog() []Status {
+	catalog := make([]Status, 0, len(businessCodeMap))
+	for _, s := range businessCodeMap {
+		catalog = append(catalog, s)
+	}
+	sort.Slice(catalog, func(i, j int) bool { return catalog[i].Code < catalog[j].Code })
+	return catalog
+}
+
+// LookupStatus code 에 해당하는 Status 를 반환하며, 등록되지 않은 code 는 None 을 반환합니다.
+func LookupStatus(code Code) Status {
+	if s, ok := businessCodeMap[code]; ok {
+		return s
+	}
+	return businessCodeMap[None]
 }
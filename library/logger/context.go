@@ -0,0 +1,95 @@
+package logger
+
+import (
+	"context"
+	"sync"
+
+	"go.uber.org/zap"
+)
+
+type contextKey struct{}
+
+// requestFields
+// 요청 단위로 모든 로그에 포함할 field 입니다.
+// 인증 주체 / 대상 환자는 요청 처리 중에 확인되므로 context 를 교체하지 않고 값을 갱신할 수 있도록 포인터로 보관합니다.
+type requestFields struct {
+	mu          sync.RWMutex
+	requestID   string
+	principalID string
+	patientID   string
+}
+
+// NewContext 요청 ID 를 가진 log field 를 ctx 에 추가합니다. (요청 시작 시 한 번 호출)
+func NewContext(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, contextKey{}, &requestFields{requestID: requestID})
+}
+
+func fieldsFromContext(ctx context.Context) *requestFields {
+	if ctx == nil {
+		return nil
+	}
+	fields, _ := ctx.Value(contextKey{}).(*requestFields)
+	return fields
+}
+
+func RequestIDFromContext(ctx context.Context) string {
+	fields := fieldsFromContext(ctx)
+	if fields == nil {
+		return ""
+	}
+	fields.mu.RLock()
+	defer fields.mu.RUnlock()
+	return fields.requestID
+}
+
+// SetPrincipal 인증된 주체 ID 를 이후 로그에 포함합니다. (NewContext 로 생성된 ctx 가 아니면 무시)
+func SetPrincipal(ctx context.Context, principalID string) {
+	if fields := fieldsFromContext(ctx); fields != nil {
+		fields.mu.Lock()
+		fields.principalID = principalID
+		fields.mu.Unlock()
+	}
+}
+
+// SetPatientID 요청 대상 환자 ID 를 이후 로그에 포함합니다. (NewContext 로 생성된 ctx 가 아니면 무시)
+func SetPatientID(ctx context.Context, patientID string) {
+	if fields := fieldsFromContext(ctx); fields != nil {
+		fields.mu.Lock()
+		fields.patientID = patientID
+		fields.mu.Unlock()
+	}
+}
+
+// ContextFields request_id / principal_id / patient_id 와 trace_id / span_id field
+func ContextFields(ctx context.Context) []zap.Field {
+	var result []zap.Field
+	if fields := fieldsFromContext(ctx); fields != nil {
+		fields.mu.RLock()
+		for _, field := range []struct{ key, value string }{
+			{"request_id", fields.requestID},
+			{"principal_id", fields.principalID},
+			{"patient_id", fields.patientID},
+		} {
+			if field.value != "" {
+				result = append(result, zap.String(field.key, field.value))
+			}
+		}
+		fields.mu.RUnlock()
+	}
+	return append(result, TraceFields(ctx)...)
+}
+
+// FromContext 요청 단위 field 를 포함한 logger 를 반환합니다. (logger 초기화 전에는 아무것도 기록하지 않음)
+func FromContext(ctx context.Context) *zap.Logger {
+	if ZapLogger == nil {
+		return zap.NewNop()
+	}
+	return withContext(ctx, ZapLogger.Logger)
+}
+
+func withContext(ctx context.Context, l *zap.Logger) *zap.Logger {
+	if fields := ContextFields(ctx); len(fields) > 0 {
+		return l.With(fields...)
+	}
+	return l
+}
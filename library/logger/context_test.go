@@ -0,0 +1,59 @@
+package logger
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func Test_FromContext(t *testing.T) {
+	core, logs := observer.New(zapcore.DebugLevel)
+	original := ZapLogger
+	ZapLogger = &logger{Logger: zap.New(core)}
+	defer func() { ZapLogger = original }()
+
+	t.Run("성공 - 요청 중 갱신된 field 포함", func(t *testing.T) {
+		ctx := NewContext(context.Background(), "req-1")
+		SetPrincipal(ctx, "key-1")
+		SetPatientID(ctx, "P00001")
+
+		spanContext := trace.NewSpanContext(trace.SpanContextConfig{
+			TraceID: trace.TraceID{0x4b, 0xf9},
+			SpanID:  trace.SpanID{0x01},
+		})
+		FromContext(trace.ContextWithSpanContext(ctx, spanContext)).Info("hello")
+
+		entry := logs.TakeAll()[0]
+		require.Equal(t, map[string]interface{}{
+			"request_id":   "req-1",
+			"principal_id": "key-1",
+			"patient_id":   "P00001",
+			"trace_id":     spanContext.TraceID().String(),
+			"span_id":      spanContext.SpanID().String(),
+		}, entry.ContextMap())
+		require.Equal(t, "req-1", RequestIDFromContext(ctx))
+	})
+
+	t.Run("성공 - 요청 context 가 아니면 field 없이 기록", func(t *testing.T) {
+		ctx := context.Background()
+		SetPatientID(ctx, "P00001")
+
+		FromContext(ctx).Info("hello")
+		require.Empty(t, logs.TakeAll()[0].ContextMap())
+		require.Empty(t, RequestIDFromContext(ctx))
+	})
+
+	t.Run("성공 - SQL 로그에도 요청 field 포함", func(t *testing.T) {
+		sqlLogger := &gormLogger{Logger: ZapLogger.Logger}
+		sqlLogger.Trace(NewContext(context.Background(), "req-2"), time.Now(), func() (string, int64) { return "SELECT 1", 1 }, nil)
+
+		entry := logs.TakeAll()[0]
+		require.Equal(t, "req-2", entry.ContextMap()["request_id"])
+	})
+}
@@ -14,36 +14,47 @@ type gormLogger struct {
 	*zap.Logger
 	SlowThreshold         time.Duration
 	SkipErrRecordNotFound bool
+	// ParameterizedQueries SQL 로그에 parameter 값(환자 정보 등)을 포함하지 않고 placeholder 로 남깁니다.
+	ParameterizedQueries bool
 }
 
 func (l *gormLogger) LogMode(gormLog.LogLevel) gormLog.Interface {
 	return l
 }
 
+// ParamsFilter gorm 이 SQL 로그를 만들 때 호출합니다. (gorm.ParamsFilter)
+func (l *gormLogger) ParamsFilter(ctx context.Context, sql string, params ...interface{}) (string, []interface{}) {
+	if l.ParameterizedQueries {
+		return sql, nil
+	}
+	return sql, params
+}
+
 func (l *gormLogger) Info(ctx context.Context, msg string, args ...interface{}) {
-	l.Logger.Sugar().Infof(msg, args...)
+	withContext(ctx, l.Logger).Sugar().Infof(msg, args...)
 }
 
 func (l *gormLogger) Warn(ctx context.Context, msg string, args ...interface{}) {
-	l.Logger.Sugar().Warnf(msg, args...)
+	withContext(ctx, l.Logger).Sugar().Warnf(msg, args...)
 }
 
 func (l *gormLogger) Error(ctx context.Context, msg string, args ...interface{}) {
-	l.Logger.Sugar().Errorf(msg, args...)
+	withContext(ctx, l.Logger).Sugar().Errorf(msg, args...)
 }
 
 func (l *gormLogger) Trace(ctx context.Context, begin time.Time, fc func() (sql string, rowsAffected int64), err error) {
 	elapsed := time.Since(begin)
 	sql, _ := fc()
+	sugar := withContext(ctx, l.Logger).Sugar()
 	if err != nil && !(errors.Is(err, gorm.ErrRecordNotFound) && l.SkipErrRecordNotFound) {
-		l.Logger.Sugar().Errorf("%s [%s]", sql, elapsed)
+		sugar.Errorf("%s [%s]", sql, elapsed)
 		return
 	}
 
 	if l.SlowThreshold != 0 && elapsed > l.SlowThreshold {
-		l.Logger.Sugar().Warnf("%s [%s]", sql, elapsed)
+		sugar.Warnf("%s [%s]", sql, elapsed)
 		return
 	}
 
-	l.Logger.Sugar().Debugf("%s [%s]", sql, elapsed)
+	sugar.Debugf("%s [%s]", sql, elapsed)
 }
@@ -18,6 +18,16 @@ type logger struct {
 var ZapLogger *logger
 
 func MustInitZapLogger() {
+	mustInitZapLogger(os.Stdout)
+}
+
+// MustInitStderrZapLogger
+// CLI 명령의 stdout 은 결과 출력 전용으로 사용하도록 모든 레벨의 로그를 stderr 로 기록합니다.
+func MustInitStderrZapLogger() {
+	mustInitZapLogger(os.Stderr)
+}
+
+func mustInitZapLogger(infoOutput *os.File) {
 	// Define custom encoder configuration
 	encoderConfig := zapcore.EncoderConfig{
 		TimeKey:        "timestamp",
@@ -58,7 +68,7 @@ func MustInitZapLogger() {
 	})
 
 	stderrSyncer := zapcore.Lock(os.Stderr)
-	stdoutSyncer := zapcore.Lock(os.Stdout)
+	stdoutSyncer := zapcore.Lock(infoOutput)
 
 	core := zapcore.NewTee(
 		zapcore.NewCore(
@@ -73,10 +83,16 @@ func MustInitZapLogger() {
 		),
 	)
 
+	// 운영 환경에서는 환자 정보가 로그에 남지 않도록 메시지 / field / SQL parameter 를 치환
+	if envs.LogRedaction {
+		core = NewRedactingCore(core, NewRedactor(envs.LogRedactionKey))
+	}
+
 	named := fmt.Sprintf("%s-%s", envs.ServerName, envs.ServiceType)
 	l := zap.New(core).Named(named)
 	ZapLogger = &logger{Logger: l, GormLogger: &gormLogger{
 		Logger:                l,
 		SkipErrRecordNotFound: true,
+		ParameterizedQueries:  envs.LogRedaction,
 	}}
 }
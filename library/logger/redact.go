@@ -0,0 +1,223 @@
+package logger
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// 도메인 struct 의 `phi` tag 값
+//
+//	phi:"mask" 값을 알 수 없도록 가림 (이름, 생년월일 등)
+//	phi:"hash" 같은 값끼리 로그를 연결할 수 있도록 keyed hash 로 치환 (환자 ID 등)
+const (
+	phiTag  = "phi"
+	PHIMask = "mask"
+	PHIHash = "hash"
+
+	maskedValue = "******"
+)
+
+// phiFieldKeys 로그 field key 로 PHI 여부를 판단합니다.
+var phiFieldKeys = map[string]string{
+	"patient_id":   PHIHash,
+	"patient_name": PHIMask,
+	"birth_date":   PHIMask,
+}
+
+// phiPatterns 에러 메시지 등 자유 형식 문자열에 포함되는 알려진 PHI 패턴 (두 번째 group 을 가림)
+var phiPatterns = []*regexp.Regexp{
+	regexp.MustCompile(`(Duplicate entry ')([^']*)(')`), // MySQL unique key 충돌 (환자 ID 등)
+	regexp.MustCompile(`(parsing time ")([^"]*)(")`),    // 날짜 형식 오류 (생년월일 등)
+}
+
+// datePattern 시각이 없는 날짜(생년월일 등)만 가리고, 측정 시각 등 시각이 포함된 값은 유지합니다.
+var datePattern = regexp.MustCompile(`\b(?:19|20)\d{2}-\d{2}-\d{2}(?:[T ]\d{2}:\d{2})?`)
+
+// Redactor 로그에 기록되는 PHI 를 가리거나 hash 로 치환합니다.
+type Redactor struct {
+	key []byte
+}
+
+// NewRedactor key 가 비어 있으면 임의의 key 를 사용하므로 hash 는 같은 프로세스 안에서만 일치합니다.
+func NewRedactor(key string) *Redactor {
+	if key == "" {
+		random := make([]byte, 32)
+		_, _ = rand.Read(random)
+		return &Redactor{key: random}
+	}
+	return &Redactor{key: []byte(key)}
+}
+
+func (r *Redactor) Hash(value string) string {
+	if value == "" {
+		return ""
+	}
+	mac := hmac.New(sha256.New, r.key)
+	mac.Write([]byte(value))
+	return "phi:" + hex.EncodeToString(mac.Sum(nil))[:16]
+}
+
+// String 알려진 패턴에 해당하는 부분을 가립니다.
+func (r *Redactor) String(value string) string {
+	for _, pattern := range phiPatterns {
+		value = pattern.ReplaceAllString(value, "${1}"+maskedValue+"${3}")
+	}
+	return datePattern.ReplaceAllStringFunc(value, func(match string) string {
+		if len(match) > len(time.DateOnly) {
+			return match
+		}
+		return maskedValue
+	})
+}
+
+// Value phi tag 가 있는 field 를 치환한 사본(map)을 반환합니다. struct 가 아니면 그대로 반환합니다.
+func (r *Redactor) Value(value interface{}) interface{} {
+	if value == nil {
+		return nil
+	}
+	if redacted, found := r.value(reflect.ValueOf(value)); found {
+		return redacted
+	}
+	return value
+}
+
+// value 반환값의 bool 은 phi field 가 포함되었는지 여부입니다.
+func (r *Redactor) value(v reflect.Value) (interface{}, bool) {
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		if v.IsNil() {
+			return nil, false
+		}
+		return r.value(v.Elem())
+	case reflect.Slice, reflect.Array:
+		items := make([]interface{}, v.Len())
+		found := false
+		for i := range items {
+			var ok bool
+			items[i], ok = r.value(v.Index(i))
+			found = found || ok
+		}
+		if !found {
+			return v.Interface(), false
+		}
+		return items, true
+	case reflect.Struct:
+		if _, ok := v.Interface().(time.Time); ok {
+			return v.Interface(), false
+		}
+	default:
+		if v.CanInterface() {
+			return v.Interface(), false
+		}
+		return nil, false
+	}
+
+	result := make(map[string]interface{}, v.NumField())
+	found := false
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Type().Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		name := strings.Split(field.Tag.Get("json"), ",")[0]
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+
+		switch field.Tag.Get(phiTag) {
+		case PHIMask:
+			result[name] = maskedValue
+			found = true
+		case PHIHash:
+			if fieldValue := reflect.Indirect(v.Field(i)); fieldValue.IsValid() {
+				result[name] = r.Hash(fmt.Sprint(fieldValue.Interface()))
+			} else {
+				result[name] = nil
+			}
+			found = true
+		default:
+			child, ok := r.value(v.Field(i))
+			if !ok {
+				child = v.Field(i).Interface()
+			}
+			result[name] = child
+			found = found || ok
+		}
+	}
+	if !found {
+		return v.Interface(), false
+	}
+	return result, true
+}
+
+// Fields field key, 문자열 / 에러 값의 패턴, phi tag 가 있는 struct 를 치환합니다.
+func (r *Redactor) Fields(fields []zapcore.Field) []zapcore.Field {
+	redacted := make([]zapcore.Field, len(fields))
+	for i, field := range fields {
+		redacted[i] = r.field(field)
+	}
+	return redacted
+}
+
+func (r *Redactor) field(field zapcore.Field) zapcore.Field {
+	if kind, ok := phiFieldKeys[field.Key]; ok && field.Type == zapcore.StringType {
+		if kind == PHIHash {
+			return zap.String(field.Key, r.Hash(field.String))
+		}
+		return zap.String(field.Key, maskedValue)
+	}
+
+	switch field.Type {
+	case zapcore.StringType:
+		return zap.String(field.Key, r.String(field.String))
+	case zapcore.ErrorType:
+		if err, ok := field.Interface.(error); ok {
+			return zap.String(field.Key, r.String(err.Error()))
+		}
+	case zapcore.ReflectType, zapcore.StringerType:
+		if redacted, found := r.value(reflect.ValueOf(field.Interface)); found {
+			return zap.Any(field.Key, redacted)
+		}
+	}
+	return field
+}
+
+// redactingCore 기록 직전 메시지와 field 의 PHI 를 치환하는 zapcore.Core
+type redactingCore struct {
+	zapcore.Core
+	redactor *Redactor
+}
+
+func NewRedactingCore(core zapcore.Core, redactor *Redactor) zapcore.Core {
+	return &redactingCore{Core: core, redactor: redactor}
+}
+
+func (c *redactingCore) With(fields []zapcore.Field) zapcore.Core {
+	return &redactingCore{Core: c.Core.With(c.redactor.Fields(fields)), redactor: c.redactor}
+}
+
+func (c *redactingCore) Check(entry zapcore.Entry, checked *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if c.Enabled(entry.Level) {
+		return checked.AddCore(entry, c)
+	}
+	return checked
+}
+
+func (c *redactingCore) Write(entry zapcore.Entry, fields []zapcore.Field) error {
+	entry.Message = c.redactor.String(entry.Message)
+	return c.Core.Write(entry, c.redactor.Fields(fields))
+}
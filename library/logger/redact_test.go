@@ -0,0 +1,119 @@
+package logger
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+type testPatient struct {
+	PatientID string    `json:"patient_id" phi:"hash"`
+	Name      string    `json:"name" phi:"mask"`
+	BirthDate time.Time `json:"birth_date" phi:"mask"`
+	Gender    string    `json:"gender"`
+	Encounter *string   `json:"encounter_patient_id" phi:"hash"`
+}
+
+type testWard struct {
+	WardID   string        `json:"ward_id"`
+	Patients []testPatient `json:"patients"`
+}
+
+func Test_Redactor_String(t *testing.T) {
+	redactor := NewRedactor("key")
+
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{
+			name:  "성공 - MySQL unique key 충돌 값",
+			input: "Error 1062 (23000): Duplicate entry 'P00001' for key 'patients.patient_id'",
+			want:  "Error 1062 (23000): Duplicate entry '******' for key 'patients.patient_id'",
+		},
+		{
+			name:  "성공 - 날짜 형식 오류 값",
+			input: `parsing time "1990-13-01" as "2006-01-02": month out of range`,
+			want:  `parsing time "******" as "******": month out of range`,
+		},
+		{
+			name:  "성공 - 시각 없는 날짜만 치환",
+			input: "birth 1990-01-01, recorded 2025-12-01T10:15:00Z, 2025-12-01 10:15:00",
+			want:  "birth ******, recorded 2025-12-01T10:15:00Z, 2025-12-01 10:15:00",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, redactor.String(tt.input))
+		})
+	}
+}
+
+func Test_Redactor_Value(t *testing.T) {
+	redactor := NewRedactor("key")
+	patient := testPatient{PatientID: "P00001", Name: "김민준", BirthDate: time.Date(1990, 1, 1, 0, 0, 0, 0, time.UTC), Gender: "M"}
+
+	got := redactor.Value(testWard{WardID: "W1", Patients: []testPatient{patient}})
+	require.Equal(t, map[string]interface{}{
+		"ward_id": "W1",
+		"patients": []interface{}{map[string]interface{}{
+			"patient_id":           redactor.Hash("P00001"),
+			"name":                 maskedValue,
+			"birth_date":           maskedValue,
+			"gender":               "M",
+			"encounter_patient_id": nil,
+		}},
+	}, got)
+
+	t.Run("성공 - 같은 key 는 같은 hash", func(t *testing.T) {
+		require.Equal(t, NewRedactor("key").Hash("P00001"), redactor.Hash("P00001"))
+		require.NotEqual(t, NewRedactor("other").Hash("P00001"), redactor.Hash("P00001"))
+	})
+
+	t.Run("성공 - phi tag 가 없으면 그대로", func(t *testing.T) {
+		require.Equal(t, "W1", redactor.Value("W1"))
+		require.Equal(t, struct{ ID string }{ID: "W1"}, redactor.Value(struct{ ID string }{ID: "W1"}))
+	})
+}
+
+func Test_RedactingCore(t *testing.T) {
+	observed, logs := observer.New(zapcore.DebugLevel)
+	redactor := NewRedactor("key")
+	l := zap.New(NewRedactingCore(observed, redactor)).With(zap.String("patient_id", "P00001"))
+
+	l.Error("Duplicate entry 'P00001' for key 'patients.patient_id'",
+		zap.Error(errors.New(`parsing time "1990-13-01"`)),
+		zap.Any("patient", &testPatient{PatientID: "P00001", Name: "김민준"}),
+		zap.String("patient_name", "김민준"),
+		zap.Int("line", 3),
+	)
+
+	entry := logs.TakeAll()[0]
+	require.Equal(t, "Duplicate entry '******' for key 'patients.patient_id'", entry.Message)
+
+	fields := entry.ContextMap()
+	require.Equal(t, redactor.Hash("P00001"), fields["patient_id"])
+	require.Equal(t, `parsing time "******"`, fields["error"])
+	require.Equal(t, maskedValue, fields["patient"].(map[string]interface{})["name"])
+	require.Equal(t, maskedValue, fields["patient_name"])
+	require.Equal(t, int64(3), fields["line"])
+}
+
+func Test_GormLogger_ParameterizedQueries(t *testing.T) {
+	params := []interface{}{"P00001"}
+
+	sql, vars := (&gormLogger{ParameterizedQueries: true}).ParamsFilter(context.Background(), "SELECT * FROM patients WHERE patient_id = ?", params...)
+	require.Equal(t, "SELECT * FROM patients WHERE patient_id = ?", sql)
+	require.Nil(t, vars)
+
+	_, vars = (&gormLogger{}).ParamsFilter(context.Background(), "SELECT * FROM patients WHERE patient_id = ?", params...)
+	require.Equal(t, params, vars)
+}